@@ -0,0 +1,4651 @@
+// Code generated by genAccessors; DO NOT EDIT.
+
+package keycloak
+
+// GetBaseURL returns the BaseURL field if it's non-nil, zero value otherwise.
+func (a *AccountApplication) GetBaseURL() string {
+	if a == nil || a.BaseURL == nil {
+		return ""
+	}
+	return *a.BaseURL
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (a *AccountApplication) GetClientID() string {
+	if a == nil || a.ClientID == nil {
+		return ""
+	}
+	return *a.ClientID
+}
+
+// GetClientName returns the ClientName field if it's non-nil, zero value otherwise.
+func (a *AccountApplication) GetClientName() string {
+	if a == nil || a.ClientName == nil {
+		return ""
+	}
+	return *a.ClientName
+}
+
+// GetConsent returns the Consent field.
+func (a *AccountApplication) GetConsent() *AccountConsent {
+	if a == nil {
+		return nil
+	}
+	return a.Consent
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (a *AccountApplication) GetDescription() string {
+	if a == nil || a.Description == nil {
+		return ""
+	}
+	return *a.Description
+}
+
+// GetEffectiveURL returns the EffectiveURL field if it's non-nil, zero value otherwise.
+func (a *AccountApplication) GetEffectiveURL() string {
+	if a == nil || a.EffectiveURL == nil {
+		return ""
+	}
+	return *a.EffectiveURL
+}
+
+// GetInUse returns the InUse field if it's non-nil, zero value otherwise.
+func (a *AccountApplication) GetInUse() bool {
+	if a == nil || a.InUse == nil {
+		return false
+	}
+	return *a.InUse
+}
+
+// GetLogoURI returns the LogoURI field if it's non-nil, zero value otherwise.
+func (a *AccountApplication) GetLogoURI() string {
+	if a == nil || a.LogoURI == nil {
+		return ""
+	}
+	return *a.LogoURI
+}
+
+// GetOfflineAccess returns the OfflineAccess field if it's non-nil, zero value otherwise.
+func (a *AccountApplication) GetOfflineAccess() bool {
+	if a == nil || a.OfflineAccess == nil {
+		return false
+	}
+	return *a.OfflineAccess
+}
+
+// GetPolicyURI returns the PolicyURI field if it's non-nil, zero value otherwise.
+func (a *AccountApplication) GetPolicyURI() string {
+	if a == nil || a.PolicyURI == nil {
+		return ""
+	}
+	return *a.PolicyURI
+}
+
+// GetRootURL returns the RootURL field if it's non-nil, zero value otherwise.
+func (a *AccountApplication) GetRootURL() string {
+	if a == nil || a.RootURL == nil {
+		return ""
+	}
+	return *a.RootURL
+}
+
+// GetTosURI returns the TosURI field if it's non-nil, zero value otherwise.
+func (a *AccountApplication) GetTosURI() string {
+	if a == nil || a.TosURI == nil {
+		return ""
+	}
+	return *a.TosURI
+}
+
+// GetUserConsentRequired returns the UserConsentRequired field if it's non-nil, zero value otherwise.
+func (a *AccountApplication) GetUserConsentRequired() bool {
+	if a == nil || a.UserConsentRequired == nil {
+		return false
+	}
+	return *a.UserConsentRequired
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (a *AccountConsent) GetCreatedDate() int64 {
+	if a == nil || a.CreatedDate == nil {
+		return 0
+	}
+	return *a.CreatedDate
+}
+
+// GetLastUpdatedDate returns the LastUpdatedDate field if it's non-nil, zero value otherwise.
+func (a *AccountConsent) GetLastUpdatedDate() int64 {
+	if a == nil || a.LastUpdatedDate == nil {
+		return 0
+	}
+	return *a.LastUpdatedDate
+}
+
+// GetDisplayText returns the DisplayText field if it's non-nil, zero value otherwise.
+func (a *AccountConsentScope) GetDisplayText() string {
+	if a == nil || a.DisplayText == nil {
+		return ""
+	}
+	return *a.DisplayText
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *AccountConsentScope) GetID() string {
+	if a == nil || a.ID == nil {
+		return ""
+	}
+	return *a.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *AccountConsentScope) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
+	}
+	return *a.Name
+}
+
+// GetUserCredentials returns the UserCredentials field if it's non-nil, zero value otherwise.
+func (a *AccountCredentialListOptions) GetUserCredentials() bool {
+	if a == nil || a.UserCredentials == nil {
+		return false
+	}
+	return *a.UserCredentials
+}
+
+// GetCredential returns the Credential field.
+func (a *AccountCredentialMetadata) GetCredential() *Credential {
+	if a == nil {
+		return nil
+	}
+	return a.Credential
+}
+
+// GetCategory returns the Category field if it's non-nil, zero value otherwise.
+func (a *AccountCredentials) GetCategory() string {
+	if a == nil || a.Category == nil {
+		return ""
+	}
+	return *a.Category
+}
+
+// GetCreateAction returns the CreateAction field if it's non-nil, zero value otherwise.
+func (a *AccountCredentials) GetCreateAction() string {
+	if a == nil || a.CreateAction == nil {
+		return ""
+	}
+	return *a.CreateAction
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (a *AccountCredentials) GetDisplayName() string {
+	if a == nil || a.DisplayName == nil {
+		return ""
+	}
+	return *a.DisplayName
+}
+
+// GetHelpText returns the HelpText field if it's non-nil, zero value otherwise.
+func (a *AccountCredentials) GetHelpText() string {
+	if a == nil || a.HelpText == nil {
+		return ""
+	}
+	return *a.HelpText
+}
+
+// GetIconCSSClass returns the IconCSSClass field if it's non-nil, zero value otherwise.
+func (a *AccountCredentials) GetIconCSSClass() string {
+	if a == nil || a.IconCSSClass == nil {
+		return ""
+	}
+	return *a.IconCSSClass
+}
+
+// GetRemoveable returns the Removeable field if it's non-nil, zero value otherwise.
+func (a *AccountCredentials) GetRemoveable() bool {
+	if a == nil || a.Removeable == nil {
+		return false
+	}
+	return *a.Removeable
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (a *AccountCredentials) GetType() string {
+	if a == nil || a.Type == nil {
+		return ""
+	}
+	return *a.Type
+}
+
+// GetUpdateAction returns the UpdateAction field if it's non-nil, zero value otherwise.
+func (a *AccountCredentials) GetUpdateAction() string {
+	if a == nil || a.UpdateAction == nil {
+		return ""
+	}
+	return *a.UpdateAction
+}
+
+// GetBrowser returns the Browser field if it's non-nil, zero value otherwise.
+func (a *AccountDevice) GetBrowser() string {
+	if a == nil || a.Browser == nil {
+		return ""
+	}
+	return *a.Browser
+}
+
+// GetCurrent returns the Current field if it's non-nil, zero value otherwise.
+func (a *AccountDevice) GetCurrent() bool {
+	if a == nil || a.Current == nil {
+		return false
+	}
+	return *a.Current
+}
+
+// GetDevice returns the Device field if it's non-nil, zero value otherwise.
+func (a *AccountDevice) GetDevice() string {
+	if a == nil || a.Device == nil {
+		return ""
+	}
+	return *a.Device
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *AccountDevice) GetID() string {
+	if a == nil || a.ID == nil {
+		return ""
+	}
+	return *a.ID
+}
+
+// GetIPAddress returns the IPAddress field if it's non-nil, zero value otherwise.
+func (a *AccountDevice) GetIPAddress() string {
+	if a == nil || a.IPAddress == nil {
+		return ""
+	}
+	return *a.IPAddress
+}
+
+// GetLastAccess returns the LastAccess field if it's non-nil, zero value otherwise.
+func (a *AccountDevice) GetLastAccess() int64 {
+	if a == nil || a.LastAccess == nil {
+		return 0
+	}
+	return *a.LastAccess
+}
+
+// GetMobile returns the Mobile field if it's non-nil, zero value otherwise.
+func (a *AccountDevice) GetMobile() bool {
+	if a == nil || a.Mobile == nil {
+		return false
+	}
+	return *a.Mobile
+}
+
+// GetOS returns the OS field if it's non-nil, zero value otherwise.
+func (a *AccountDevice) GetOS() string {
+	if a == nil || a.OS == nil {
+		return ""
+	}
+	return *a.OS
+}
+
+// GetOSVersion returns the OSVersion field if it's non-nil, zero value otherwise.
+func (a *AccountDevice) GetOSVersion() string {
+	if a == nil || a.OSVersion == nil {
+		return ""
+	}
+	return *a.OSVersion
+}
+
+// GetBrowser returns the Browser field if it's non-nil, zero value otherwise.
+func (a *AccountSession) GetBrowser() string {
+	if a == nil || a.Browser == nil {
+		return ""
+	}
+	return *a.Browser
+}
+
+// GetCurrent returns the Current field if it's non-nil, zero value otherwise.
+func (a *AccountSession) GetCurrent() bool {
+	if a == nil || a.Current == nil {
+		return false
+	}
+	return *a.Current
+}
+
+// GetExpires returns the Expires field if it's non-nil, zero value otherwise.
+func (a *AccountSession) GetExpires() int64 {
+	if a == nil || a.Expires == nil {
+		return 0
+	}
+	return *a.Expires
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *AccountSession) GetID() string {
+	if a == nil || a.ID == nil {
+		return ""
+	}
+	return *a.ID
+}
+
+// GetIPAddress returns the IPAddress field if it's non-nil, zero value otherwise.
+func (a *AccountSession) GetIPAddress() string {
+	if a == nil || a.IPAddress == nil {
+		return ""
+	}
+	return *a.IPAddress
+}
+
+// GetLastAccess returns the LastAccess field if it's non-nil, zero value otherwise.
+func (a *AccountSession) GetLastAccess() int64 {
+	if a == nil || a.LastAccess == nil {
+		return 0
+	}
+	return *a.LastAccess
+}
+
+// GetStarted returns the Started field if it's non-nil, zero value otherwise.
+func (a *AccountSession) GetStarted() int64 {
+	if a == nil || a.Started == nil {
+		return 0
+	}
+	return *a.Started
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (a *AccountSessionClient) GetClientID() string {
+	if a == nil || a.ClientID == nil {
+		return ""
+	}
+	return *a.ClientID
+}
+
+// GetClientName returns the ClientName field if it's non-nil, zero value otherwise.
+func (a *AccountSessionClient) GetClientName() string {
+	if a == nil || a.ClientName == nil {
+		return ""
+	}
+	return *a.ClientName
+}
+
+// GetInUse returns the InUse field if it's non-nil, zero value otherwise.
+func (a *AccountSessionClient) GetInUse() bool {
+	if a == nil || a.InUse == nil {
+		return false
+	}
+	return *a.InUse
+}
+
+// GetOfflineAccess returns the OfflineAccess field if it's non-nil, zero value otherwise.
+func (a *AccountSessionClient) GetOfflineAccess() bool {
+	if a == nil || a.OfflineAccess == nil {
+		return false
+	}
+	return *a.OfflineAccess
+}
+
+// GetUserConsentRequired returns the UserConsentRequired field if it's non-nil, zero value otherwise.
+func (a *AccountSessionClient) GetUserConsentRequired() bool {
+	if a == nil || a.UserConsentRequired == nil {
+		return false
+	}
+	return *a.UserConsentRequired
+}
+
+// GetResponse returns the Response field.
+func (a *ActionsEmailError) GetResponse() *ErrorResponse {
+	if a == nil {
+		return nil
+	}
+	return a.Response
+}
+
+// GetAuthDetails returns the AuthDetails field.
+func (a *AdminEvent) GetAuthDetails() *AuthDetails {
+	if a == nil {
+		return nil
+	}
+	return a.AuthDetails
+}
+
+// GetDetails returns the Details field if it's non-nil, zero value otherwise.
+func (a *AdminEvent) GetDetails() map[string]string {
+	if a == nil || a.Details == nil {
+		return nil
+	}
+	return *a.Details
+}
+
+// GetError returns the Error field if it's non-nil, zero value otherwise.
+func (a *AdminEvent) GetError() string {
+	if a == nil || a.Error == nil {
+		return ""
+	}
+	return *a.Error
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *AdminEvent) GetID() string {
+	if a == nil || a.ID == nil {
+		return ""
+	}
+	return *a.ID
+}
+
+// GetOperationType returns the OperationType field if it's non-nil, zero value otherwise.
+func (a *AdminEvent) GetOperationType() string {
+	if a == nil || a.OperationType == nil {
+		return ""
+	}
+	return *a.OperationType
+}
+
+// GetRealmID returns the RealmID field if it's non-nil, zero value otherwise.
+func (a *AdminEvent) GetRealmID() string {
+	if a == nil || a.RealmID == nil {
+		return ""
+	}
+	return *a.RealmID
+}
+
+// GetRepresentation returns the Representation field if it's non-nil, zero value otherwise.
+func (a *AdminEvent) GetRepresentation() string {
+	if a == nil || a.Representation == nil {
+		return ""
+	}
+	return *a.Representation
+}
+
+// GetResourcePath returns the ResourcePath field if it's non-nil, zero value otherwise.
+func (a *AdminEvent) GetResourcePath() string {
+	if a == nil || a.ResourcePath == nil {
+		return ""
+	}
+	return *a.ResourcePath
+}
+
+// GetResourceType returns the ResourceType field if it's non-nil, zero value otherwise.
+func (a *AdminEvent) GetResourceType() string {
+	if a == nil || a.ResourceType == nil {
+		return ""
+	}
+	return *a.ResourceType
+}
+
+// GetTime returns the Time field if it's non-nil, zero value otherwise.
+func (a *AdminEvent) GetTime() int64 {
+	if a == nil || a.Time == nil {
+		return 0
+	}
+	return *a.Time
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (a *AuthDetails) GetClientID() string {
+	if a == nil || a.ClientID == nil {
+		return ""
+	}
+	return *a.ClientID
+}
+
+// GetIPAddress returns the IPAddress field if it's non-nil, zero value otherwise.
+func (a *AuthDetails) GetIPAddress() string {
+	if a == nil || a.IPAddress == nil {
+		return ""
+	}
+	return *a.IPAddress
+}
+
+// GetRealmID returns the RealmID field if it's non-nil, zero value otherwise.
+func (a *AuthDetails) GetRealmID() string {
+	if a == nil || a.RealmID == nil {
+		return ""
+	}
+	return *a.RealmID
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (a *AuthDetails) GetUserID() string {
+	if a == nil || a.UserID == nil {
+		return ""
+	}
+	return *a.UserID
+}
+
+// GetAuthenticator returns the Authenticator field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecution) GetAuthenticator() string {
+	if a == nil || a.Authenticator == nil {
+		return ""
+	}
+	return *a.Authenticator
+}
+
+// GetAuthenticatorConfig returns the AuthenticatorConfig field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecution) GetAuthenticatorConfig() string {
+	if a == nil || a.AuthenticatorConfig == nil {
+		return ""
+	}
+	return *a.AuthenticatorConfig
+}
+
+// GetAuthenticatorFlow returns the AuthenticatorFlow field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecution) GetAuthenticatorFlow() bool {
+	if a == nil || a.AuthenticatorFlow == nil {
+		return false
+	}
+	return *a.AuthenticatorFlow
+}
+
+// GetFlowAlias returns the FlowAlias field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecution) GetFlowAlias() string {
+	if a == nil || a.FlowAlias == nil {
+		return ""
+	}
+	return *a.FlowAlias
+}
+
+// GetPriority returns the Priority field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecution) GetPriority() int {
+	if a == nil || a.Priority == nil {
+		return 0
+	}
+	return *a.Priority
+}
+
+// GetRequirement returns the Requirement field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecution) GetRequirement() string {
+	if a == nil || a.Requirement == nil {
+		return ""
+	}
+	return *a.Requirement
+}
+
+// GetUserSetupAllowed returns the UserSetupAllowed field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecution) GetUserSetupAllowed() bool {
+	if a == nil || a.UserSetupAllowed == nil {
+		return false
+	}
+	return *a.UserSetupAllowed
+}
+
+// GetAlias returns the Alias field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecutionInfo) GetAlias() string {
+	if a == nil || a.Alias == nil {
+		return ""
+	}
+	return *a.Alias
+}
+
+// GetAuthenticationConfig returns the AuthenticationConfig field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecutionInfo) GetAuthenticationConfig() string {
+	if a == nil || a.AuthenticationConfig == nil {
+		return ""
+	}
+	return *a.AuthenticationConfig
+}
+
+// GetAuthenticationFlow returns the AuthenticationFlow field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecutionInfo) GetAuthenticationFlow() bool {
+	if a == nil || a.AuthenticationFlow == nil {
+		return false
+	}
+	return *a.AuthenticationFlow
+}
+
+// GetConfigurable returns the Configurable field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecutionInfo) GetConfigurable() bool {
+	if a == nil || a.Configurable == nil {
+		return false
+	}
+	return *a.Configurable
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecutionInfo) GetDescription() string {
+	if a == nil || a.Description == nil {
+		return ""
+	}
+	return *a.Description
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecutionInfo) GetDisplayName() string {
+	if a == nil || a.DisplayName == nil {
+		return ""
+	}
+	return *a.DisplayName
+}
+
+// GetFlowID returns the FlowID field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecutionInfo) GetFlowID() string {
+	if a == nil || a.FlowID == nil {
+		return ""
+	}
+	return *a.FlowID
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecutionInfo) GetID() string {
+	if a == nil || a.ID == nil {
+		return ""
+	}
+	return *a.ID
+}
+
+// GetIndex returns the Index field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecutionInfo) GetIndex() int {
+	if a == nil || a.Index == nil {
+		return 0
+	}
+	return *a.Index
+}
+
+// GetLevel returns the Level field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecutionInfo) GetLevel() int {
+	if a == nil || a.Level == nil {
+		return 0
+	}
+	return *a.Level
+}
+
+// GetProviderID returns the ProviderID field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecutionInfo) GetProviderID() string {
+	if a == nil || a.ProviderID == nil {
+		return ""
+	}
+	return *a.ProviderID
+}
+
+// GetRequirement returns the Requirement field if it's non-nil, zero value otherwise.
+func (a *AuthenticationExecutionInfo) GetRequirement() string {
+	if a == nil || a.Requirement == nil {
+		return ""
+	}
+	return *a.Requirement
+}
+
+// GetAlias returns the Alias field if it's non-nil, zero value otherwise.
+func (a *AuthenticationFlow) GetAlias() string {
+	if a == nil || a.Alias == nil {
+		return ""
+	}
+	return *a.Alias
+}
+
+// GetBuiltIn returns the BuiltIn field if it's non-nil, zero value otherwise.
+func (a *AuthenticationFlow) GetBuiltIn() bool {
+	if a == nil || a.BuiltIn == nil {
+		return false
+	}
+	return *a.BuiltIn
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (a *AuthenticationFlow) GetDescription() string {
+	if a == nil || a.Description == nil {
+		return ""
+	}
+	return *a.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *AuthenticationFlow) GetID() string {
+	if a == nil || a.ID == nil {
+		return ""
+	}
+	return *a.ID
+}
+
+// GetProviderID returns the ProviderID field if it's non-nil, zero value otherwise.
+func (a *AuthenticationFlow) GetProviderID() string {
+	if a == nil || a.ProviderID == nil {
+		return ""
+	}
+	return *a.ProviderID
+}
+
+// GetTopLevel returns the TopLevel field if it's non-nil, zero value otherwise.
+func (a *AuthenticationFlow) GetTopLevel() bool {
+	if a == nil || a.TopLevel == nil {
+		return false
+	}
+	return *a.TopLevel
+}
+
+// GetAlias returns the Alias field if it's non-nil, zero value otherwise.
+func (a *AuthenticatorConfig) GetAlias() string {
+	if a == nil || a.Alias == nil {
+		return ""
+	}
+	return *a.Alias
+}
+
+// GetConfig returns the Config field if it's non-nil, zero value otherwise.
+func (a *AuthenticatorConfig) GetConfig() map[string]string {
+	if a == nil || a.Config == nil {
+		return nil
+	}
+	return *a.Config
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *AuthenticatorConfig) GetID() string {
+	if a == nil || a.ID == nil {
+		return ""
+	}
+	return *a.ID
+}
+
+// GetContentSecurityPolicy returns the ContentSecurityPolicy field if it's non-nil, zero value otherwise.
+func (b *BrowserSecurityHeaders) GetContentSecurityPolicy() string {
+	if b == nil || b.ContentSecurityPolicy == nil {
+		return ""
+	}
+	return *b.ContentSecurityPolicy
+}
+
+// GetContentSecurityPolicyReportOnly returns the ContentSecurityPolicyReportOnly field if it's non-nil, zero value otherwise.
+func (b *BrowserSecurityHeaders) GetContentSecurityPolicyReportOnly() string {
+	if b == nil || b.ContentSecurityPolicyReportOnly == nil {
+		return ""
+	}
+	return *b.ContentSecurityPolicyReportOnly
+}
+
+// GetReferrerPolicy returns the ReferrerPolicy field if it's non-nil, zero value otherwise.
+func (b *BrowserSecurityHeaders) GetReferrerPolicy() string {
+	if b == nil || b.ReferrerPolicy == nil {
+		return ""
+	}
+	return *b.ReferrerPolicy
+}
+
+// GetStrictTransportSecurity returns the StrictTransportSecurity field if it's non-nil, zero value otherwise.
+func (b *BrowserSecurityHeaders) GetStrictTransportSecurity() string {
+	if b == nil || b.StrictTransportSecurity == nil {
+		return ""
+	}
+	return *b.StrictTransportSecurity
+}
+
+// GetXContentTypeOptions returns the XContentTypeOptions field if it's non-nil, zero value otherwise.
+func (b *BrowserSecurityHeaders) GetXContentTypeOptions() string {
+	if b == nil || b.XContentTypeOptions == nil {
+		return ""
+	}
+	return *b.XContentTypeOptions
+}
+
+// GetXFrameOptions returns the XFrameOptions field if it's non-nil, zero value otherwise.
+func (b *BrowserSecurityHeaders) GetXFrameOptions() string {
+	if b == nil || b.XFrameOptions == nil {
+		return ""
+	}
+	return *b.XFrameOptions
+}
+
+// GetXRobotsTag returns the XRobotsTag field if it's non-nil, zero value otherwise.
+func (b *BrowserSecurityHeaders) GetXRobotsTag() string {
+	if b == nil || b.XRobotsTag == nil {
+		return ""
+	}
+	return *b.XRobotsTag
+}
+
+// GetXXSSProtection returns the XXSSProtection field if it's non-nil, zero value otherwise.
+func (b *BrowserSecurityHeaders) GetXXSSProtection() string {
+	if b == nil || b.XXSSProtection == nil {
+		return ""
+	}
+	return *b.XXSSProtection
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (b *BruteForceDetection) GetEnabled() bool {
+	if b == nil || b.Enabled == nil {
+		return false
+	}
+	return *b.Enabled
+}
+
+// GetFailureFactor returns the FailureFactor field if it's non-nil, zero value otherwise.
+func (b *BruteForceDetection) GetFailureFactor() int {
+	if b == nil || b.FailureFactor == nil {
+		return 0
+	}
+	return *b.FailureFactor
+}
+
+// GetMaxDeltaTimeSeconds returns the MaxDeltaTimeSeconds field if it's non-nil, zero value otherwise.
+func (b *BruteForceDetection) GetMaxDeltaTimeSeconds() int {
+	if b == nil || b.MaxDeltaTimeSeconds == nil {
+		return 0
+	}
+	return *b.MaxDeltaTimeSeconds
+}
+
+// GetMaxFailureWaitSeconds returns the MaxFailureWaitSeconds field if it's non-nil, zero value otherwise.
+func (b *BruteForceDetection) GetMaxFailureWaitSeconds() int {
+	if b == nil || b.MaxFailureWaitSeconds == nil {
+		return 0
+	}
+	return *b.MaxFailureWaitSeconds
+}
+
+// GetMaxTemporaryLockouts returns the MaxTemporaryLockouts field if it's non-nil, zero value otherwise.
+func (b *BruteForceDetection) GetMaxTemporaryLockouts() int {
+	if b == nil || b.MaxTemporaryLockouts == nil {
+		return 0
+	}
+	return *b.MaxTemporaryLockouts
+}
+
+// GetMinimumQuickLoginWaitSeconds returns the MinimumQuickLoginWaitSeconds field if it's non-nil, zero value otherwise.
+func (b *BruteForceDetection) GetMinimumQuickLoginWaitSeconds() int {
+	if b == nil || b.MinimumQuickLoginWaitSeconds == nil {
+		return 0
+	}
+	return *b.MinimumQuickLoginWaitSeconds
+}
+
+// GetPermanentLockout returns the PermanentLockout field if it's non-nil, zero value otherwise.
+func (b *BruteForceDetection) GetPermanentLockout() bool {
+	if b == nil || b.PermanentLockout == nil {
+		return false
+	}
+	return *b.PermanentLockout
+}
+
+// GetQuickLoginCheckMilliSeconds returns the QuickLoginCheckMilliSeconds field if it's non-nil, zero value otherwise.
+func (b *BruteForceDetection) GetQuickLoginCheckMilliSeconds() int {
+	if b == nil || b.QuickLoginCheckMilliSeconds == nil {
+		return 0
+	}
+	return *b.QuickLoginCheckMilliSeconds
+}
+
+// GetStrategy returns the Strategy field if it's non-nil, zero value otherwise.
+func (b *BruteForceDetection) GetStrategy() string {
+	if b == nil || b.Strategy == nil {
+		return ""
+	}
+	return *b.Strategy
+}
+
+// GetWaitIncrementSeconds returns the WaitIncrementSeconds field if it's non-nil, zero value otherwise.
+func (b *BruteForceDetection) GetWaitIncrementSeconds() int {
+	if b == nil || b.WaitIncrementSeconds == nil {
+		return 0
+	}
+	return *b.WaitIncrementSeconds
+}
+
+// GetDisabled returns the Disabled field if it's non-nil, zero value otherwise.
+func (b *BruteForceStatus) GetDisabled() bool {
+	if b == nil || b.Disabled == nil {
+		return false
+	}
+	return *b.Disabled
+}
+
+// GetLastFailure returns the LastFailure field if it's non-nil, zero value otherwise.
+func (b *BruteForceStatus) GetLastFailure() int64 {
+	if b == nil || b.LastFailure == nil {
+		return 0
+	}
+	return *b.LastFailure
+}
+
+// GetLastIPFailure returns the LastIPFailure field if it's non-nil, zero value otherwise.
+func (b *BruteForceStatus) GetLastIPFailure() string {
+	if b == nil || b.LastIPFailure == nil {
+		return ""
+	}
+	return *b.LastIPFailure
+}
+
+// GetNumFailures returns the NumFailures field if it's non-nil, zero value otherwise.
+func (b *BruteForceStatus) GetNumFailures() int {
+	if b == nil || b.NumFailures == nil {
+		return 0
+	}
+	return *b.NumFailures
+}
+
+// GetRetry returns the Retry field.
+func (b *BulkOptions) GetRetry() *RetryPolicy {
+	if b == nil {
+		return nil
+	}
+	return b.Retry
+}
+
+// GetUser returns the User field.
+func (b *BulkResult) GetUser() *User {
+	if b == nil {
+		return nil
+	}
+	return b.User
+}
+
+// GetCertificate returns the Certificate field if it's non-nil, zero value otherwise.
+func (c *CertificateInfo) GetCertificate() string {
+	if c == nil || c.Certificate == nil {
+		return ""
+	}
+	return *c.Certificate
+}
+
+// GetKid returns the Kid field if it's non-nil, zero value otherwise.
+func (c *CertificateInfo) GetKid() string {
+	if c == nil || c.Kid == nil {
+		return ""
+	}
+	return *c.Kid
+}
+
+// GetPrivateKey returns the PrivateKey field if it's non-nil, zero value otherwise.
+func (c *CertificateInfo) GetPrivateKey() string {
+	if c == nil || c.PrivateKey == nil {
+		return ""
+	}
+	return *c.PrivateKey
+}
+
+// GetPublicKey returns the PublicKey field if it's non-nil, zero value otherwise.
+func (c *CertificateInfo) GetPublicKey() string {
+	if c == nil || c.PublicKey == nil {
+		return ""
+	}
+	return *c.PublicKey
+}
+
+// GetRealmAccess returns the RealmAccess field.
+func (c *Claims) GetRealmAccess() *Access {
+	if c == nil {
+		return nil
+	}
+	return c.RealmAccess
+}
+
+// GetAccess returns the Access field if it's non-nil, zero value otherwise.
+func (c *Client) GetAccess() map[string]bool {
+	if c == nil || c.Access == nil {
+		return nil
+	}
+	return *c.Access
+}
+
+// GetAlwaysDisplayInConsole returns the AlwaysDisplayInConsole field if it's non-nil, zero value otherwise.
+func (c *Client) GetAlwaysDisplayInConsole() bool {
+	if c == nil || c.AlwaysDisplayInConsole == nil {
+		return false
+	}
+	return *c.AlwaysDisplayInConsole
+}
+
+// GetAttributes returns the Attributes field if it's non-nil, zero value otherwise.
+func (c *Client) GetAttributes() map[string]string {
+	if c == nil || c.Attributes == nil {
+		return nil
+	}
+	return *c.Attributes
+}
+
+// GetAuthenticationFlowBindingOverrides returns the AuthenticationFlowBindingOverrides field if it's non-nil, zero value otherwise.
+func (c *Client) GetAuthenticationFlowBindingOverrides() map[string]string {
+	if c == nil || c.AuthenticationFlowBindingOverrides == nil {
+		return nil
+	}
+	return *c.AuthenticationFlowBindingOverrides
+}
+
+// GetAuthorizationServicesEnabled returns the AuthorizationServicesEnabled field if it's non-nil, zero value otherwise.
+func (c *Client) GetAuthorizationServicesEnabled() bool {
+	if c == nil || c.AuthorizationServicesEnabled == nil {
+		return false
+	}
+	return *c.AuthorizationServicesEnabled
+}
+
+// GetBaseURL returns the BaseURL field if it's non-nil, zero value otherwise.
+func (c *Client) GetBaseURL() string {
+	if c == nil || c.BaseURL == nil {
+		return ""
+	}
+	return *c.BaseURL
+}
+
+// GetBearerOnly returns the BearerOnly field if it's non-nil, zero value otherwise.
+func (c *Client) GetBearerOnly() bool {
+	if c == nil || c.BearerOnly == nil {
+		return false
+	}
+	return *c.BearerOnly
+}
+
+// GetClientAuthenticatorType returns the ClientAuthenticatorType field if it's non-nil, zero value otherwise.
+func (c *Client) GetClientAuthenticatorType() string {
+	if c == nil || c.ClientAuthenticatorType == nil {
+		return ""
+	}
+	return *c.ClientAuthenticatorType
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *Client) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetConsentRequired returns the ConsentRequired field if it's non-nil, zero value otherwise.
+func (c *Client) GetConsentRequired() bool {
+	if c == nil || c.ConsentRequired == nil {
+		return false
+	}
+	return *c.ConsentRequired
+}
+
+// GetDirectAccessGrantsEnabled returns the DirectAccessGrantsEnabled field if it's non-nil, zero value otherwise.
+func (c *Client) GetDirectAccessGrantsEnabled() bool {
+	if c == nil || c.DirectAccessGrantsEnabled == nil {
+		return false
+	}
+	return *c.DirectAccessGrantsEnabled
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (c *Client) GetEnabled() bool {
+	if c == nil || c.Enabled == nil {
+		return false
+	}
+	return *c.Enabled
+}
+
+// GetFrontchannelLogout returns the FrontchannelLogout field if it's non-nil, zero value otherwise.
+func (c *Client) GetFrontchannelLogout() bool {
+	if c == nil || c.FrontchannelLogout == nil {
+		return false
+	}
+	return *c.FrontchannelLogout
+}
+
+// GetFullScopeAllowed returns the FullScopeAllowed field if it's non-nil, zero value otherwise.
+func (c *Client) GetFullScopeAllowed() bool {
+	if c == nil || c.FullScopeAllowed == nil {
+		return false
+	}
+	return *c.FullScopeAllowed
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *Client) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetImplicitFlowEnabled returns the ImplicitFlowEnabled field if it's non-nil, zero value otherwise.
+func (c *Client) GetImplicitFlowEnabled() bool {
+	if c == nil || c.ImplicitFlowEnabled == nil {
+		return false
+	}
+	return *c.ImplicitFlowEnabled
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *Client) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetNodeReRegistrationTimeout returns the NodeReRegistrationTimeout field if it's non-nil, zero value otherwise.
+func (c *Client) GetNodeReRegistrationTimeout() int {
+	if c == nil || c.NodeReRegistrationTimeout == nil {
+		return 0
+	}
+	return *c.NodeReRegistrationTimeout
+}
+
+// GetNotBefore returns the NotBefore field if it's non-nil, zero value otherwise.
+func (c *Client) GetNotBefore() int {
+	if c == nil || c.NotBefore == nil {
+		return 0
+	}
+	return *c.NotBefore
+}
+
+// GetProtocol returns the Protocol field if it's non-nil, zero value otherwise.
+func (c *Client) GetProtocol() string {
+	if c == nil || c.Protocol == nil {
+		return ""
+	}
+	return *c.Protocol
+}
+
+// GetPublicClient returns the PublicClient field if it's non-nil, zero value otherwise.
+func (c *Client) GetPublicClient() bool {
+	if c == nil || c.PublicClient == nil {
+		return false
+	}
+	return *c.PublicClient
+}
+
+// GetRegisteredNodes returns the RegisteredNodes field if it's non-nil, zero value otherwise.
+func (c *Client) GetRegisteredNodes() map[string]int {
+	if c == nil || c.RegisteredNodes == nil {
+		return nil
+	}
+	return *c.RegisteredNodes
+}
+
+// GetRegistrationAccessToken returns the RegistrationAccessToken field if it's non-nil, zero value otherwise.
+func (c *Client) GetRegistrationAccessToken() string {
+	if c == nil || c.RegistrationAccessToken == nil {
+		return ""
+	}
+	return *c.RegistrationAccessToken
+}
+
+// GetRootURL returns the RootURL field if it's non-nil, zero value otherwise.
+func (c *Client) GetRootURL() string {
+	if c == nil || c.RootURL == nil {
+		return ""
+	}
+	return *c.RootURL
+}
+
+// GetServiceAccountsEnabled returns the ServiceAccountsEnabled field if it's non-nil, zero value otherwise.
+func (c *Client) GetServiceAccountsEnabled() bool {
+	if c == nil || c.ServiceAccountsEnabled == nil {
+		return false
+	}
+	return *c.ServiceAccountsEnabled
+}
+
+// GetStandardFlowEnabled returns the StandardFlowEnabled field if it's non-nil, zero value otherwise.
+func (c *Client) GetStandardFlowEnabled() bool {
+	if c == nil || c.StandardFlowEnabled == nil {
+		return false
+	}
+	return *c.StandardFlowEnabled
+}
+
+// GetSurrogateAuthRequired returns the SurrogateAuthRequired field if it's non-nil, zero value otherwise.
+func (c *Client) GetSurrogateAuthRequired() bool {
+	if c == nil || c.SurrogateAuthRequired == nil {
+		return false
+	}
+	return *c.SurrogateAuthRequired
+}
+
+// GetCount returns the Count field if it's non-nil, zero value otherwise.
+func (c *ClientInitialAccess) GetCount() int {
+	if c == nil || c.Count == nil {
+		return 0
+	}
+	return *c.Count
+}
+
+// GetExpiration returns the Expiration field if it's non-nil, zero value otherwise.
+func (c *ClientInitialAccess) GetExpiration() int {
+	if c == nil || c.Expiration == nil {
+		return 0
+	}
+	return *c.Expiration
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *ClientInitialAccess) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetRemainingCount returns the RemainingCount field if it's non-nil, zero value otherwise.
+func (c *ClientInitialAccess) GetRemainingCount() int {
+	if c == nil || c.RemainingCount == nil {
+		return 0
+	}
+	return *c.RemainingCount
+}
+
+// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
+func (c *ClientInitialAccess) GetTimestamp() int {
+	if c == nil || c.Timestamp == nil {
+		return 0
+	}
+	return *c.Timestamp
+}
+
+// GetToken returns the Token field if it's non-nil, zero value otherwise.
+func (c *ClientInitialAccess) GetToken() string {
+	if c == nil || c.Token == nil {
+		return ""
+	}
+	return *c.Token
+}
+
+// GetCount returns the Count field if it's non-nil, zero value otherwise.
+func (c *ClientInitialAccessCreate) GetCount() int {
+	if c == nil || c.Count == nil {
+		return 0
+	}
+	return *c.Count
+}
+
+// GetExpiration returns the Expiration field if it's non-nil, zero value otherwise.
+func (c *ClientInitialAccessCreate) GetExpiration() int {
+	if c == nil || c.Expiration == nil {
+		return 0
+	}
+	return *c.Expiration
+}
+
+// GetSearch returns the Search field if it's non-nil, zero value otherwise.
+func (c *ClientListOptions) GetSearch() bool {
+	if c == nil || c.Search == nil {
+		return false
+	}
+	return *c.Search
+}
+
+// GetViewableOnly returns the ViewableOnly field if it's non-nil, zero value otherwise.
+func (c *ClientListOptions) GetViewableOnly() bool {
+	if c == nil || c.ViewableOnly == nil {
+		return false
+	}
+	return *c.ViewableOnly
+}
+
+// GetClient returns the Client field if it's non-nil, zero value otherwise.
+func (c *ClientRoleMappings) GetClient() string {
+	if c == nil || c.Client == nil {
+		return ""
+	}
+	return *c.Client
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *ClientRoleMappings) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetAttributes returns the Attributes field if it's non-nil, zero value otherwise.
+func (c *ClientScope) GetAttributes() map[string]string {
+	if c == nil || c.Attributes == nil {
+		return nil
+	}
+	return *c.Attributes
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (c *ClientScope) GetDescription() string {
+	if c == nil || c.Description == nil {
+		return ""
+	}
+	return *c.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *ClientScope) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *ClientScope) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetProtocol returns the Protocol field if it's non-nil, zero value otherwise.
+func (c *ClientScope) GetProtocol() string {
+	if c == nil || c.Protocol == nil {
+		return ""
+	}
+	return *c.Protocol
+}
+
+// GetCurrent returns the Current field.
+func (c *ClientSecrets) GetCurrent() *Credential {
+	if c == nil {
+		return nil
+	}
+	return c.Current
+}
+
+// GetRotated returns the Rotated field.
+func (c *ClientSecrets) GetRotated() *Credential {
+	if c == nil {
+		return nil
+	}
+	return c.Rotated
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (c *ClientSessionStats) GetActive() string {
+	if c == nil || c.Active == nil {
+		return ""
+	}
+	return *c.Active
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ClientSessionStats) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *ClientSessionStats) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetOffline returns the Offline field if it's non-nil, zero value otherwise.
+func (c *ClientSessionStats) GetOffline() string {
+	if c == nil || c.Offline == nil {
+		return ""
+	}
+	return *c.Offline
+}
+
+// GetConfig returns the Config field if it's non-nil, zero value otherwise.
+func (c *Component) GetConfig() map[string][]string {
+	if c == nil || c.Config == nil {
+		return nil
+	}
+	return *c.Config
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *Component) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *Component) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetParentID returns the ParentID field if it's non-nil, zero value otherwise.
+func (c *Component) GetParentID() string {
+	if c == nil || c.ParentID == nil {
+		return ""
+	}
+	return *c.ParentID
+}
+
+// GetProviderID returns the ProviderID field if it's non-nil, zero value otherwise.
+func (c *Component) GetProviderID() string {
+	if c == nil || c.ProviderID == nil {
+		return ""
+	}
+	return *c.ProviderID
+}
+
+// GetProviderType returns the ProviderType field if it's non-nil, zero value otherwise.
+func (c *Component) GetProviderType() string {
+	if c == nil || c.ProviderType == nil {
+		return ""
+	}
+	return *c.ProviderType
+}
+
+// GetSubType returns the SubType field if it's non-nil, zero value otherwise.
+func (c *Component) GetSubType() string {
+	if c == nil || c.SubType == nil {
+		return ""
+	}
+	return *c.SubType
+}
+
+// GetHelpText returns the HelpText field if it's non-nil, zero value otherwise.
+func (c *ComponentType) GetHelpText() string {
+	if c == nil || c.HelpText == nil {
+		return ""
+	}
+	return *c.HelpText
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *ComponentType) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
+func (c *ComponentType) GetMetadata() map[string]interface{} {
+	if c == nil || c.Metadata == nil {
+		return nil
+	}
+	return *c.Metadata
+}
+
+// GetHelpText returns the HelpText field if it's non-nil, zero value otherwise.
+func (c *ConfigProperty) GetHelpText() string {
+	if c == nil || c.HelpText == nil {
+		return ""
+	}
+	return *c.HelpText
+}
+
+// GetLabel returns the Label field if it's non-nil, zero value otherwise.
+func (c *ConfigProperty) GetLabel() string {
+	if c == nil || c.Label == nil {
+		return ""
+	}
+	return *c.Label
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *ConfigProperty) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetReadOnly returns the ReadOnly field if it's non-nil, zero value otherwise.
+func (c *ConfigProperty) GetReadOnly() bool {
+	if c == nil || c.ReadOnly == nil {
+		return false
+	}
+	return *c.ReadOnly
+}
+
+// GetRequired returns the Required field if it's non-nil, zero value otherwise.
+func (c *ConfigProperty) GetRequired() bool {
+	if c == nil || c.Required == nil {
+		return false
+	}
+	return *c.Required
+}
+
+// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
+func (c *ConfigProperty) GetSecret() bool {
+	if c == nil || c.Secret == nil {
+		return false
+	}
+	return *c.Secret
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (c *ConfigProperty) GetType() string {
+	if c == nil || c.Type == nil {
+		return ""
+	}
+	return *c.Type
+}
+
+// GetAuthorizationEndpoint returns the AuthorizationEndpoint field if it's non-nil, zero value otherwise.
+func (c *Configuration) GetAuthorizationEndpoint() string {
+	if c == nil || c.AuthorizationEndpoint == nil {
+		return ""
+	}
+	return *c.AuthorizationEndpoint
+}
+
+// GetEndSessionEndpoint returns the EndSessionEndpoint field if it's non-nil, zero value otherwise.
+func (c *Configuration) GetEndSessionEndpoint() string {
+	if c == nil || c.EndSessionEndpoint == nil {
+		return ""
+	}
+	return *c.EndSessionEndpoint
+}
+
+// GetIntrospectionEndpoint returns the IntrospectionEndpoint field if it's non-nil, zero value otherwise.
+func (c *Configuration) GetIntrospectionEndpoint() string {
+	if c == nil || c.IntrospectionEndpoint == nil {
+		return ""
+	}
+	return *c.IntrospectionEndpoint
+}
+
+// GetIssuer returns the Issuer field if it's non-nil, zero value otherwise.
+func (c *Configuration) GetIssuer() string {
+	if c == nil || c.Issuer == nil {
+		return ""
+	}
+	return *c.Issuer
+}
+
+// GetJwksURI returns the JwksURI field if it's non-nil, zero value otherwise.
+func (c *Configuration) GetJwksURI() string {
+	if c == nil || c.JwksURI == nil {
+		return ""
+	}
+	return *c.JwksURI
+}
+
+// GetPermissionEndpoint returns the PermissionEndpoint field if it's non-nil, zero value otherwise.
+func (c *Configuration) GetPermissionEndpoint() string {
+	if c == nil || c.PermissionEndpoint == nil {
+		return ""
+	}
+	return *c.PermissionEndpoint
+}
+
+// GetPolicyEndpoint returns the PolicyEndpoint field if it's non-nil, zero value otherwise.
+func (c *Configuration) GetPolicyEndpoint() string {
+	if c == nil || c.PolicyEndpoint == nil {
+		return ""
+	}
+	return *c.PolicyEndpoint
+}
+
+// GetRegistrationEndpoint returns the RegistrationEndpoint field if it's non-nil, zero value otherwise.
+func (c *Configuration) GetRegistrationEndpoint() string {
+	if c == nil || c.RegistrationEndpoint == nil {
+		return ""
+	}
+	return *c.RegistrationEndpoint
+}
+
+// GetResourceRegistrationEndpoint returns the ResourceRegistrationEndpoint field if it's non-nil, zero value otherwise.
+func (c *Configuration) GetResourceRegistrationEndpoint() string {
+	if c == nil || c.ResourceRegistrationEndpoint == nil {
+		return ""
+	}
+	return *c.ResourceRegistrationEndpoint
+}
+
+// GetTokenEndpoint returns the TokenEndpoint field if it's non-nil, zero value otherwise.
+func (c *Configuration) GetTokenEndpoint() string {
+	if c == nil || c.TokenEndpoint == nil {
+		return ""
+	}
+	return *c.TokenEndpoint
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (c *Credential) GetCreatedDate() int64 {
+	if c == nil || c.CreatedDate == nil {
+		return 0
+	}
+	return *c.CreatedDate
+}
+
+// GetCredentialData returns the CredentialData field if it's non-nil, zero value otherwise.
+func (c *Credential) GetCredentialData() string {
+	if c == nil || c.CredentialData == nil {
+		return ""
+	}
+	return *c.CredentialData
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *Credential) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetPriority returns the Priority field if it's non-nil, zero value otherwise.
+func (c *Credential) GetPriority() int {
+	if c == nil || c.Priority == nil {
+		return 0
+	}
+	return *c.Priority
+}
+
+// GetSecretData returns the SecretData field if it's non-nil, zero value otherwise.
+func (c *Credential) GetSecretData() string {
+	if c == nil || c.SecretData == nil {
+		return ""
+	}
+	return *c.SecretData
+}
+
+// GetTemporary returns the Temporary field if it's non-nil, zero value otherwise.
+func (c *Credential) GetTemporary() bool {
+	if c == nil || c.Temporary == nil {
+		return false
+	}
+	return *c.Temporary
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (c *Credential) GetType() string {
+	if c == nil || c.Type == nil {
+		return ""
+	}
+	return *c.Type
+}
+
+// GetUserLabel returns the UserLabel field if it's non-nil, zero value otherwise.
+func (c *Credential) GetUserLabel() string {
+	if c == nil || c.UserLabel == nil {
+		return ""
+	}
+	return *c.UserLabel
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (c *Credential) GetValue() string {
+	if c == nil || c.Value == nil {
+		return ""
+	}
+	return *c.Value
+}
+
+// GetResource returns the Resource field.
+func (e *EvaluationResult) GetResource() *Resource {
+	if e == nil {
+		return nil
+	}
+	return e.Resource
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (e *EvaluationResult) GetStatus() string {
+	if e == nil || e.Status == nil {
+		return ""
+	}
+	return *e.Status
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (e *Event) GetClientID() string {
+	if e == nil || e.ClientID == nil {
+		return ""
+	}
+	return *e.ClientID
+}
+
+// GetDetails returns the Details field if it's non-nil, zero value otherwise.
+func (e *Event) GetDetails() map[string]string {
+	if e == nil || e.Details == nil {
+		return nil
+	}
+	return *e.Details
+}
+
+// GetError returns the Error field if it's non-nil, zero value otherwise.
+func (e *Event) GetError() string {
+	if e == nil || e.Error == nil {
+		return ""
+	}
+	return *e.Error
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Event) GetID() string {
+	if e == nil || e.ID == nil {
+		return ""
+	}
+	return *e.ID
+}
+
+// GetIPAddress returns the IPAddress field if it's non-nil, zero value otherwise.
+func (e *Event) GetIPAddress() string {
+	if e == nil || e.IPAddress == nil {
+		return ""
+	}
+	return *e.IPAddress
+}
+
+// GetRealmID returns the RealmID field if it's non-nil, zero value otherwise.
+func (e *Event) GetRealmID() string {
+	if e == nil || e.RealmID == nil {
+		return ""
+	}
+	return *e.RealmID
+}
+
+// GetSessionID returns the SessionID field if it's non-nil, zero value otherwise.
+func (e *Event) GetSessionID() string {
+	if e == nil || e.SessionID == nil {
+		return ""
+	}
+	return *e.SessionID
+}
+
+// GetTime returns the Time field if it's non-nil, zero value otherwise.
+func (e *Event) GetTime() int64 {
+	if e == nil || e.Time == nil {
+		return 0
+	}
+	return *e.Time
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (e *Event) GetType() string {
+	if e == nil || e.Type == nil {
+		return ""
+	}
+	return *e.Type
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (e *Event) GetUserID() string {
+	if e == nil || e.UserID == nil {
+		return ""
+	}
+	return *e.UserID
+}
+
+// GetIdentityProvider returns the IdentityProvider field if it's non-nil, zero value otherwise.
+func (f *FederatedIdentity) GetIdentityProvider() string {
+	if f == nil || f.IdentityProvider == nil {
+		return ""
+	}
+	return *f.IdentityProvider
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (f *FederatedIdentity) GetUserID() string {
+	if f == nil || f.UserID == nil {
+		return ""
+	}
+	return *f.UserID
+}
+
+// GetUserName returns the UserName field if it's non-nil, zero value otherwise.
+func (f *FederatedIdentity) GetUserName() string {
+	if f == nil || f.UserName == nil {
+		return ""
+	}
+	return *f.UserName
+}
+
+// GetAccess returns the Access field if it's non-nil, zero value otherwise.
+func (g *Group) GetAccess() map[string]bool {
+	if g == nil || g.Access == nil {
+		return nil
+	}
+	return *g.Access
+}
+
+// GetAttributes returns the Attributes field if it's non-nil, zero value otherwise.
+func (g *Group) GetAttributes() map[string][]string {
+	if g == nil || g.Attributes == nil {
+		return nil
+	}
+	return *g.Attributes
+}
+
+// GetClientRoles returns the ClientRoles field if it's non-nil, zero value otherwise.
+func (g *Group) GetClientRoles() map[string][]string {
+	if g == nil || g.ClientRoles == nil {
+		return nil
+	}
+	return *g.ClientRoles
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *Group) GetID() string {
+	if g == nil || g.ID == nil {
+		return ""
+	}
+	return *g.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *Group) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
+	}
+	return *g.Name
+}
+
+// GetParentID returns the ParentID field if it's non-nil, zero value otherwise.
+func (g *Group) GetParentID() string {
+	if g == nil || g.ParentID == nil {
+		return ""
+	}
+	return *g.ParentID
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (g *Group) GetPath() string {
+	if g == nil || g.Path == nil {
+		return ""
+	}
+	return *g.Path
+}
+
+// GetSubGroupCount returns the SubGroupCount field if it's non-nil, zero value otherwise.
+func (g *Group) GetSubGroupCount() int64 {
+	if g == nil || g.SubGroupCount == nil {
+		return 0
+	}
+	return *g.SubGroupCount
+}
+
+// GetTop returns the Top field if it's non-nil, zero value otherwise.
+func (g *GroupCountOptions) GetTop() bool {
+	if g == nil || g.Top == nil {
+		return false
+	}
+	return *g.Top
+}
+
+// GetExtendChildren returns the ExtendChildren field if it's non-nil, zero value otherwise.
+func (g *GroupDefinition) GetExtendChildren() bool {
+	if g == nil || g.ExtendChildren == nil {
+		return false
+	}
+	return *g.ExtendChildren
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GroupDefinition) GetID() string {
+	if g == nil || g.ID == nil {
+		return ""
+	}
+	return *g.ID
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (g *GroupDefinition) GetPath() string {
+	if g == nil || g.Path == nil {
+		return ""
+	}
+	return *g.Path
+}
+
+// GetBriefRepresentation returns the BriefRepresentation field if it's non-nil, zero value otherwise.
+func (g *GroupListOptions) GetBriefRepresentation() bool {
+	if g == nil || g.BriefRepresentation == nil {
+		return false
+	}
+	return *g.BriefRepresentation
+}
+
+// GetExact returns the Exact field if it's non-nil, zero value otherwise.
+func (g *GroupListOptions) GetExact() bool {
+	if g == nil || g.Exact == nil {
+		return false
+	}
+	return *g.Exact
+}
+
+// GetBriefRepresentation returns the BriefRepresentation field if it's non-nil, zero value otherwise.
+func (g *GroupMembersListOptions) GetBriefRepresentation() bool {
+	if g == nil || g.BriefRepresentation == nil {
+		return false
+	}
+	return *g.BriefRepresentation
+}
+
+// GetGroupsClaim returns the GroupsClaim field if it's non-nil, zero value otherwise.
+func (g *GroupPolicy) GetGroupsClaim() string {
+	if g == nil || g.GroupsClaim == nil {
+		return ""
+	}
+	return *g.GroupsClaim
+}
+
+// GetRedirect returns the Redirect field if it's non-nil, zero value otherwise.
+func (i *Impersonation) GetRedirect() string {
+	if i == nil || i.Redirect == nil {
+		return ""
+	}
+	return *i.Redirect
+}
+
+// GetSameRealm returns the SameRealm field if it's non-nil, zero value otherwise.
+func (i *Impersonation) GetSameRealm() bool {
+	if i == nil || i.SameRealm == nil {
+		return false
+	}
+	return *i.SameRealm
+}
+
+// GetRealmAccess returns the RealmAccess field.
+func (i *IntrospectionResult) GetRealmAccess() *Access {
+	if i == nil {
+		return nil
+	}
+	return i.RealmAccess
+}
+
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (j *JSPolicy) GetCode() string {
+	if j == nil || j.Code == nil {
+		return ""
+	}
+	return *j.Code
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (k *KerberosFederationProvider) GetEnabled() bool {
+	if k == nil || k.Enabled == nil {
+		return false
+	}
+	return *k.Enabled
+}
+
+// GetAlgorithm returns the Algorithm field if it's non-nil, zero value otherwise.
+func (k *KeyMetadata) GetAlgorithm() string {
+	if k == nil || k.Algorithm == nil {
+		return ""
+	}
+	return *k.Algorithm
+}
+
+// GetCertificate returns the Certificate field if it's non-nil, zero value otherwise.
+func (k *KeyMetadata) GetCertificate() string {
+	if k == nil || k.Certificate == nil {
+		return ""
+	}
+	return *k.Certificate
+}
+
+// GetKid returns the Kid field if it's non-nil, zero value otherwise.
+func (k *KeyMetadata) GetKid() string {
+	if k == nil || k.Kid == nil {
+		return ""
+	}
+	return *k.Kid
+}
+
+// GetProviderID returns the ProviderID field if it's non-nil, zero value otherwise.
+func (k *KeyMetadata) GetProviderID() string {
+	if k == nil || k.ProviderID == nil {
+		return ""
+	}
+	return *k.ProviderID
+}
+
+// GetProviderPriority returns the ProviderPriority field if it's non-nil, zero value otherwise.
+func (k *KeyMetadata) GetProviderPriority() int64 {
+	if k == nil || k.ProviderPriority == nil {
+		return 0
+	}
+	return *k.ProviderPriority
+}
+
+// GetPublicKey returns the PublicKey field if it's non-nil, zero value otherwise.
+func (k *KeyMetadata) GetPublicKey() string {
+	if k == nil || k.PublicKey == nil {
+		return ""
+	}
+	return *k.PublicKey
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (k *KeyMetadata) GetStatus() string {
+	if k == nil || k.Status == nil {
+		return ""
+	}
+	return *k.Status
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (k *KeyMetadata) GetType() string {
+	if k == nil || k.Type == nil {
+		return ""
+	}
+	return *k.Type
+}
+
+// GetUse returns the Use field if it's non-nil, zero value otherwise.
+func (k *KeyMetadata) GetUse() string {
+	if k == nil || k.Use == nil {
+		return ""
+	}
+	return *k.Use
+}
+
+// GetValidTo returns the ValidTo field if it's non-nil, zero value otherwise.
+func (k *KeyMetadata) GetValidTo() int64 {
+	if k == nil || k.ValidTo == nil {
+		return 0
+	}
+	return *k.ValidTo
+}
+
+// GetFormat returns the Format field if it's non-nil, zero value otherwise.
+func (k *KeyStoreConfig) GetFormat() string {
+	if k == nil || k.Format == nil {
+		return ""
+	}
+	return *k.Format
+}
+
+// GetKeyAlias returns the KeyAlias field if it's non-nil, zero value otherwise.
+func (k *KeyStoreConfig) GetKeyAlias() string {
+	if k == nil || k.KeyAlias == nil {
+		return ""
+	}
+	return *k.KeyAlias
+}
+
+// GetKeyPassword returns the KeyPassword field if it's non-nil, zero value otherwise.
+func (k *KeyStoreConfig) GetKeyPassword() string {
+	if k == nil || k.KeyPassword == nil {
+		return ""
+	}
+	return *k.KeyPassword
+}
+
+// GetRealmAlias returns the RealmAlias field if it's non-nil, zero value otherwise.
+func (k *KeyStoreConfig) GetRealmAlias() string {
+	if k == nil || k.RealmAlias == nil {
+		return ""
+	}
+	return *k.RealmAlias
+}
+
+// GetRealmCertificate returns the RealmCertificate field if it's non-nil, zero value otherwise.
+func (k *KeyStoreConfig) GetRealmCertificate() bool {
+	if k == nil || k.RealmCertificate == nil {
+		return false
+	}
+	return *k.RealmCertificate
+}
+
+// GetStorePassword returns the StorePassword field if it's non-nil, zero value otherwise.
+func (k *KeyStoreConfig) GetStorePassword() string {
+	if k == nil || k.StorePassword == nil {
+		return ""
+	}
+	return *k.StorePassword
+}
+
+// GetPreserveGroupInheritance returns the PreserveGroupInheritance field if it's non-nil, zero value otherwise.
+func (l *LDAPGroupMapper) GetPreserveGroupInheritance() bool {
+	if l == nil || l.PreserveGroupInheritance == nil {
+		return false
+	}
+	return *l.PreserveGroupInheritance
+}
+
+// GetConnected returns the Connected field if it's non-nil, zero value otherwise.
+func (l *LinkedAccount) GetConnected() bool {
+	if l == nil || l.Connected == nil {
+		return false
+	}
+	return *l.Connected
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (l *LinkedAccount) GetDisplayName() string {
+	if l == nil || l.DisplayName == nil {
+		return ""
+	}
+	return *l.DisplayName
+}
+
+// GetGUIOrder returns the GUIOrder field if it's non-nil, zero value otherwise.
+func (l *LinkedAccount) GetGUIOrder() string {
+	if l == nil || l.GUIOrder == nil {
+		return ""
+	}
+	return *l.GUIOrder
+}
+
+// GetLinkedUsername returns the LinkedUsername field if it's non-nil, zero value otherwise.
+func (l *LinkedAccount) GetLinkedUsername() string {
+	if l == nil || l.LinkedUsername == nil {
+		return ""
+	}
+	return *l.LinkedUsername
+}
+
+// GetProviderAlias returns the ProviderAlias field if it's non-nil, zero value otherwise.
+func (l *LinkedAccount) GetProviderAlias() string {
+	if l == nil || l.ProviderAlias == nil {
+		return ""
+	}
+	return *l.ProviderAlias
+}
+
+// GetProviderName returns the ProviderName field if it's non-nil, zero value otherwise.
+func (l *LinkedAccount) GetProviderName() string {
+	if l == nil || l.ProviderName == nil {
+		return ""
+	}
+	return *l.ProviderName
+}
+
+// GetSocial returns the Social field if it's non-nil, zero value otherwise.
+func (l *LinkedAccount) GetSocial() bool {
+	if l == nil || l.Social == nil {
+		return false
+	}
+	return *l.Social
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (m *ManagementPermissionReference) GetEnabled() bool {
+	if m == nil || m.Enabled == nil {
+		return false
+	}
+	return *m.Enabled
+}
+
+// GetResource returns the Resource field if it's non-nil, zero value otherwise.
+func (m *ManagementPermissionReference) GetResource() string {
+	if m == nil || m.Resource == nil {
+		return ""
+	}
+	return *m.Resource
+}
+
+// GetScopePermissions returns the ScopePermissions field if it's non-nil, zero value otherwise.
+func (m *ManagementPermissionReference) GetScopePermissions() map[string]string {
+	if m == nil || m.ScopePermissions == nil {
+		return nil
+	}
+	return *m.ScopePermissions
+}
+
+// GetAlgorithm returns the Algorithm field if it's non-nil, zero value otherwise.
+func (o *OTPPolicy) GetAlgorithm() string {
+	if o == nil || o.Algorithm == nil {
+		return ""
+	}
+	return *o.Algorithm
+}
+
+// GetCodeReusable returns the CodeReusable field if it's non-nil, zero value otherwise.
+func (o *OTPPolicy) GetCodeReusable() bool {
+	if o == nil || o.CodeReusable == nil {
+		return false
+	}
+	return *o.CodeReusable
+}
+
+// GetDigits returns the Digits field if it's non-nil, zero value otherwise.
+func (o *OTPPolicy) GetDigits() int {
+	if o == nil || o.Digits == nil {
+		return 0
+	}
+	return *o.Digits
+}
+
+// GetInitialCounter returns the InitialCounter field if it's non-nil, zero value otherwise.
+func (o *OTPPolicy) GetInitialCounter() int {
+	if o == nil || o.InitialCounter == nil {
+		return 0
+	}
+	return *o.InitialCounter
+}
+
+// GetLookAheadWindow returns the LookAheadWindow field if it's non-nil, zero value otherwise.
+func (o *OTPPolicy) GetLookAheadWindow() int {
+	if o == nil || o.LookAheadWindow == nil {
+		return 0
+	}
+	return *o.LookAheadWindow
+}
+
+// GetPeriod returns the Period field if it's non-nil, zero value otherwise.
+func (o *OTPPolicy) GetPeriod() int {
+	if o == nil || o.Period == nil {
+		return 0
+	}
+	return *o.Period
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (o *OTPPolicy) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+// GetBackchannelLogoutSupported returns the BackchannelLogoutSupported field if it's non-nil, zero value otherwise.
+func (o *OpenIDConfiguration) GetBackchannelLogoutSupported() bool {
+	if o == nil || o.BackchannelLogoutSupported == nil {
+		return false
+	}
+	return *o.BackchannelLogoutSupported
+}
+
+// GetFrontchannelLogoutSupported returns the FrontchannelLogoutSupported field if it's non-nil, zero value otherwise.
+func (o *OpenIDConfiguration) GetFrontchannelLogoutSupported() bool {
+	if o == nil || o.FrontchannelLogoutSupported == nil {
+		return false
+	}
+	return *o.FrontchannelLogoutSupported
+}
+
+// GetRequestParameterSupported returns the RequestParameterSupported field if it's non-nil, zero value otherwise.
+func (o *OpenIDConfiguration) GetRequestParameterSupported() bool {
+	if o == nil || o.RequestParameterSupported == nil {
+		return false
+	}
+	return *o.RequestParameterSupported
+}
+
+// GetTLSClientCertificateBoundAccessTokens returns the TLSClientCertificateBoundAccessTokens field if it's non-nil, zero value otherwise.
+func (o *OpenIDConfiguration) GetTLSClientCertificateBoundAccessTokens() bool {
+	if o == nil || o.TLSClientCertificateBoundAccessTokens == nil {
+		return false
+	}
+	return *o.TLSClientCertificateBoundAccessTokens
+}
+
+// GetDecisionStrategy returns the DecisionStrategy field if it's non-nil, zero value otherwise.
+func (p *Permission) GetDecisionStrategy() string {
+	if p == nil || p.DecisionStrategy == nil {
+		return ""
+	}
+	return *p.DecisionStrategy
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *Permission) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *Permission) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetLogic returns the Logic field if it's non-nil, zero value otherwise.
+func (p *Permission) GetLogic() string {
+	if p == nil || p.Logic == nil {
+		return ""
+	}
+	return *p.Logic
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Permission) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetOwner returns the Owner field if it's non-nil, zero value otherwise.
+func (p *Permission) GetOwner() string {
+	if p == nil || p.Owner == nil {
+		return ""
+	}
+	return *p.Owner
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *Permission) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetClaims returns the Claims field if it's non-nil, zero value otherwise.
+func (p *PermissionRequest) GetClaims() map[string][]string {
+	if p == nil || p.Claims == nil {
+		return nil
+	}
+	return *p.Claims
+}
+
+// GetResourceID returns the ResourceID field if it's non-nil, zero value otherwise.
+func (p *PermissionRequest) GetResourceID() string {
+	if p == nil || p.ResourceID == nil {
+		return ""
+	}
+	return *p.ResourceID
+}
+
+// GetGranted returns the Granted field if it's non-nil, zero value otherwise.
+func (p *PermissionTicket) GetGranted() bool {
+	if p == nil || p.Granted == nil {
+		return false
+	}
+	return *p.Granted
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PermissionTicket) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetOwner returns the Owner field if it's non-nil, zero value otherwise.
+func (p *PermissionTicket) GetOwner() string {
+	if p == nil || p.Owner == nil {
+		return ""
+	}
+	return *p.Owner
+}
+
+// GetOwnerName returns the OwnerName field if it's non-nil, zero value otherwise.
+func (p *PermissionTicket) GetOwnerName() string {
+	if p == nil || p.OwnerName == nil {
+		return ""
+	}
+	return *p.OwnerName
+}
+
+// GetRequester returns the Requester field if it's non-nil, zero value otherwise.
+func (p *PermissionTicket) GetRequester() string {
+	if p == nil || p.Requester == nil {
+		return ""
+	}
+	return *p.Requester
+}
+
+// GetRequesterName returns the RequesterName field if it's non-nil, zero value otherwise.
+func (p *PermissionTicket) GetRequesterName() string {
+	if p == nil || p.RequesterName == nil {
+		return ""
+	}
+	return *p.RequesterName
+}
+
+// GetResource returns the Resource field if it's non-nil, zero value otherwise.
+func (p *PermissionTicket) GetResource() string {
+	if p == nil || p.Resource == nil {
+		return ""
+	}
+	return *p.Resource
+}
+
+// GetResourceName returns the ResourceName field if it's non-nil, zero value otherwise.
+func (p *PermissionTicket) GetResourceName() string {
+	if p == nil || p.ResourceName == nil {
+		return ""
+	}
+	return *p.ResourceName
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (p *PermissionTicket) GetScope() string {
+	if p == nil || p.Scope == nil {
+		return ""
+	}
+	return *p.Scope
+}
+
+// GetScopeName returns the ScopeName field if it's non-nil, zero value otherwise.
+func (p *PermissionTicket) GetScopeName() string {
+	if p == nil || p.ScopeName == nil {
+		return ""
+	}
+	return *p.ScopeName
+}
+
+// GetGranted returns the Granted field if it's non-nil, zero value otherwise.
+func (p *PermissionTicketListOptions) GetGranted() bool {
+	if p == nil || p.Granted == nil {
+		return false
+	}
+	return *p.Granted
+}
+
+// GetReturnNames returns the ReturnNames field if it's non-nil, zero value otherwise.
+func (p *PermissionTicketListOptions) GetReturnNames() bool {
+	if p == nil || p.ReturnNames == nil {
+		return false
+	}
+	return *p.ReturnNames
+}
+
+// GetConfig returns the Config field if it's non-nil, zero value otherwise.
+func (p *Policy) GetConfig() map[string]string {
+	if p == nil || p.Config == nil {
+		return nil
+	}
+	return *p.Config
+}
+
+// GetDecisionStrategy returns the DecisionStrategy field if it's non-nil, zero value otherwise.
+func (p *Policy) GetDecisionStrategy() string {
+	if p == nil || p.DecisionStrategy == nil {
+		return ""
+	}
+	return *p.DecisionStrategy
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *Policy) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *Policy) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetLogic returns the Logic field if it's non-nil, zero value otherwise.
+func (p *Policy) GetLogic() string {
+	if p == nil || p.Logic == nil {
+		return ""
+	}
+	return *p.Logic
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Policy) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetOwner returns the Owner field if it's non-nil, zero value otherwise.
+func (p *Policy) GetOwner() string {
+	if p == nil || p.Owner == nil {
+		return ""
+	}
+	return *p.Owner
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *Policy) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (p *PolicyEvaluationRequest) GetClientID() string {
+	if p == nil || p.ClientID == nil {
+		return ""
+	}
+	return *p.ClientID
+}
+
+// GetEntitlements returns the Entitlements field if it's non-nil, zero value otherwise.
+func (p *PolicyEvaluationRequest) GetEntitlements() bool {
+	if p == nil || p.Entitlements == nil {
+		return false
+	}
+	return *p.Entitlements
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (p *PolicyEvaluationRequest) GetUserID() string {
+	if p == nil || p.UserID == nil {
+		return ""
+	}
+	return *p.UserID
+}
+
+// GetEntitlements returns the Entitlements field if it's non-nil, zero value otherwise.
+func (p *PolicyEvaluationResponse) GetEntitlements() bool {
+	if p == nil || p.Entitlements == nil {
+		return false
+	}
+	return *p.Entitlements
+}
+
+// GetRPT returns the RPT field if it's non-nil, zero value otherwise.
+func (p *PolicyEvaluationResponse) GetRPT() map[string]interface{} {
+	if p == nil || p.RPT == nil {
+		return nil
+	}
+	return *p.RPT
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *PolicyEvaluationResponse) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
+	}
+	return *p.Status
+}
+
+// GetPolicy returns the Policy field.
+func (p *PolicyResult) GetPolicy() *Policy {
+	if p == nil {
+		return nil
+	}
+	return p.Policy
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *PolicyResult) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
+	}
+	return *p.Status
+}
+
+// GetAttributes returns the Attributes field if it's non-nil, zero value otherwise.
+func (p *ProtectedResource) GetAttributes() map[string][]string {
+	if p == nil || p.Attributes == nil {
+		return nil
+	}
+	return *p.Attributes
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (p *ProtectedResource) GetDisplayName() string {
+	if p == nil || p.DisplayName == nil {
+		return ""
+	}
+	return *p.DisplayName
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProtectedResource) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetIconURI returns the IconURI field if it's non-nil, zero value otherwise.
+func (p *ProtectedResource) GetIconURI() string {
+	if p == nil || p.IconURI == nil {
+		return ""
+	}
+	return *p.IconURI
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProtectedResource) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetOwner returns the Owner field if it's non-nil, zero value otherwise.
+func (p *ProtectedResource) GetOwner() string {
+	if p == nil || p.Owner == nil {
+		return ""
+	}
+	return *p.Owner
+}
+
+// GetOwnerManagedAccess returns the OwnerManagedAccess field if it's non-nil, zero value otherwise.
+func (p *ProtectedResource) GetOwnerManagedAccess() bool {
+	if p == nil || p.OwnerManagedAccess == nil {
+		return false
+	}
+	return *p.OwnerManagedAccess
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *ProtectedResource) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetExact returns the Exact field if it's non-nil, zero value otherwise.
+func (p *ProtectedResourceListOptions) GetExact() bool {
+	if p == nil || p.Exact == nil {
+		return false
+	}
+	return *p.Exact
+}
+
+// GetMatchingURI returns the MatchingURI field if it's non-nil, zero value otherwise.
+func (p *ProtectedResourceListOptions) GetMatchingURI() bool {
+	if p == nil || p.MatchingURI == nil {
+		return false
+	}
+	return *p.MatchingURI
+}
+
+// GetConfig returns the Config field if it's non-nil, zero value otherwise.
+func (p *ProtocolMapper) GetConfig() map[string]string {
+	if p == nil || p.Config == nil {
+		return nil
+	}
+	return *p.Config
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProtocolMapper) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProtocolMapper) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetProtocol returns the Protocol field if it's non-nil, zero value otherwise.
+func (p *ProtocolMapper) GetProtocol() string {
+	if p == nil || p.Protocol == nil {
+		return ""
+	}
+	return *p.Protocol
+}
+
+// GetProtocolMapper returns the ProtocolMapper field if it's non-nil, zero value otherwise.
+func (p *ProtocolMapper) GetProtocolMapper() string {
+	if p == nil || p.ProtocolMapper == nil {
+		return ""
+	}
+	return *p.ProtocolMapper
+}
+
+// GetAccessCodeLifespan returns the AccessCodeLifespan field if it's non-nil, zero value otherwise.
+func (r *Realm) GetAccessCodeLifespan() int {
+	if r == nil || r.AccessCodeLifespan == nil {
+		return 0
+	}
+	return *r.AccessCodeLifespan
+}
+
+// GetAccessCodeLifespanLogin returns the AccessCodeLifespanLogin field if it's non-nil, zero value otherwise.
+func (r *Realm) GetAccessCodeLifespanLogin() int {
+	if r == nil || r.AccessCodeLifespanLogin == nil {
+		return 0
+	}
+	return *r.AccessCodeLifespanLogin
+}
+
+// GetAccessCodeLifespanUserAction returns the AccessCodeLifespanUserAction field if it's non-nil, zero value otherwise.
+func (r *Realm) GetAccessCodeLifespanUserAction() int {
+	if r == nil || r.AccessCodeLifespanUserAction == nil {
+		return 0
+	}
+	return *r.AccessCodeLifespanUserAction
+}
+
+// GetAccessTokenLifespan returns the AccessTokenLifespan field if it's non-nil, zero value otherwise.
+func (r *Realm) GetAccessTokenLifespan() int {
+	if r == nil || r.AccessTokenLifespan == nil {
+		return 0
+	}
+	return *r.AccessTokenLifespan
+}
+
+// GetAccessTokenLifespanForImplicitFlow returns the AccessTokenLifespanForImplicitFlow field if it's non-nil, zero value otherwise.
+func (r *Realm) GetAccessTokenLifespanForImplicitFlow() int {
+	if r == nil || r.AccessTokenLifespanForImplicitFlow == nil {
+		return 0
+	}
+	return *r.AccessTokenLifespanForImplicitFlow
+}
+
+// GetAccountTheme returns the AccountTheme field if it's non-nil, zero value otherwise.
+func (r *Realm) GetAccountTheme() string {
+	if r == nil || r.AccountTheme == nil {
+		return ""
+	}
+	return *r.AccountTheme
+}
+
+// GetActionTokenGeneratedByAdminLifespan returns the ActionTokenGeneratedByAdminLifespan field if it's non-nil, zero value otherwise.
+func (r *Realm) GetActionTokenGeneratedByAdminLifespan() int {
+	if r == nil || r.ActionTokenGeneratedByAdminLifespan == nil {
+		return 0
+	}
+	return *r.ActionTokenGeneratedByAdminLifespan
+}
+
+// GetActionTokenGeneratedByUserLifespan returns the ActionTokenGeneratedByUserLifespan field if it's non-nil, zero value otherwise.
+func (r *Realm) GetActionTokenGeneratedByUserLifespan() int {
+	if r == nil || r.ActionTokenGeneratedByUserLifespan == nil {
+		return 0
+	}
+	return *r.ActionTokenGeneratedByUserLifespan
+}
+
+// GetAdminEventsDetailsEnabled returns the AdminEventsDetailsEnabled field if it's non-nil, zero value otherwise.
+func (r *Realm) GetAdminEventsDetailsEnabled() bool {
+	if r == nil || r.AdminEventsDetailsEnabled == nil {
+		return false
+	}
+	return *r.AdminEventsDetailsEnabled
+}
+
+// GetAdminEventsEnabled returns the AdminEventsEnabled field if it's non-nil, zero value otherwise.
+func (r *Realm) GetAdminEventsEnabled() bool {
+	if r == nil || r.AdminEventsEnabled == nil {
+		return false
+	}
+	return *r.AdminEventsEnabled
+}
+
+// GetAdminTheme returns the AdminTheme field if it's non-nil, zero value otherwise.
+func (r *Realm) GetAdminTheme() string {
+	if r == nil || r.AdminTheme == nil {
+		return ""
+	}
+	return *r.AdminTheme
+}
+
+// GetAttributes returns the Attributes field if it's non-nil, zero value otherwise.
+func (r *Realm) GetAttributes() map[string]string {
+	if r == nil || r.Attributes == nil {
+		return nil
+	}
+	return *r.Attributes
+}
+
+// GetBrowserFlow returns the BrowserFlow field if it's non-nil, zero value otherwise.
+func (r *Realm) GetBrowserFlow() string {
+	if r == nil || r.BrowserFlow == nil {
+		return ""
+	}
+	return *r.BrowserFlow
+}
+
+// GetBrowserSecurityHeaders returns the BrowserSecurityHeaders field if it's non-nil, zero value otherwise.
+func (r *Realm) GetBrowserSecurityHeaders() map[string]string {
+	if r == nil || r.BrowserSecurityHeaders == nil {
+		return nil
+	}
+	return *r.BrowserSecurityHeaders
+}
+
+// GetBruteForceProtected returns the BruteForceProtected field if it's non-nil, zero value otherwise.
+func (r *Realm) GetBruteForceProtected() bool {
+	if r == nil || r.BruteForceProtected == nil {
+		return false
+	}
+	return *r.BruteForceProtected
+}
+
+// GetBruteForceStrategy returns the BruteForceStrategy field if it's non-nil, zero value otherwise.
+func (r *Realm) GetBruteForceStrategy() string {
+	if r == nil || r.BruteForceStrategy == nil {
+		return ""
+	}
+	return *r.BruteForceStrategy
+}
+
+// GetClientAuthenticationFlow returns the ClientAuthenticationFlow field if it's non-nil, zero value otherwise.
+func (r *Realm) GetClientAuthenticationFlow() string {
+	if r == nil || r.ClientAuthenticationFlow == nil {
+		return ""
+	}
+	return *r.ClientAuthenticationFlow
+}
+
+// GetClientOfflineSessionIdleTimeout returns the ClientOfflineSessionIdleTimeout field if it's non-nil, zero value otherwise.
+func (r *Realm) GetClientOfflineSessionIdleTimeout() int {
+	if r == nil || r.ClientOfflineSessionIdleTimeout == nil {
+		return 0
+	}
+	return *r.ClientOfflineSessionIdleTimeout
+}
+
+// GetClientOfflineSessionMaxLifespan returns the ClientOfflineSessionMaxLifespan field if it's non-nil, zero value otherwise.
+func (r *Realm) GetClientOfflineSessionMaxLifespan() int {
+	if r == nil || r.ClientOfflineSessionMaxLifespan == nil {
+		return 0
+	}
+	return *r.ClientOfflineSessionMaxLifespan
+}
+
+// GetClientSessionIdleTimeout returns the ClientSessionIdleTimeout field if it's non-nil, zero value otherwise.
+func (r *Realm) GetClientSessionIdleTimeout() int {
+	if r == nil || r.ClientSessionIdleTimeout == nil {
+		return 0
+	}
+	return *r.ClientSessionIdleTimeout
+}
+
+// GetClientSessionMaxLifespan returns the ClientSessionMaxLifespan field if it's non-nil, zero value otherwise.
+func (r *Realm) GetClientSessionMaxLifespan() int {
+	if r == nil || r.ClientSessionMaxLifespan == nil {
+		return 0
+	}
+	return *r.ClientSessionMaxLifespan
+}
+
+// GetDefaultLocale returns the DefaultLocale field if it's non-nil, zero value otherwise.
+func (r *Realm) GetDefaultLocale() string {
+	if r == nil || r.DefaultLocale == nil {
+		return ""
+	}
+	return *r.DefaultLocale
+}
+
+// GetDefaultRole returns the DefaultRole field.
+func (r *Realm) GetDefaultRole() *Role {
+	if r == nil {
+		return nil
+	}
+	return r.DefaultRole
+}
+
+// GetDefaultSignatureAlgorithm returns the DefaultSignatureAlgorithm field if it's non-nil, zero value otherwise.
+func (r *Realm) GetDefaultSignatureAlgorithm() string {
+	if r == nil || r.DefaultSignatureAlgorithm == nil {
+		return ""
+	}
+	return *r.DefaultSignatureAlgorithm
+}
+
+// GetDirectGrantFlow returns the DirectGrantFlow field if it's non-nil, zero value otherwise.
+func (r *Realm) GetDirectGrantFlow() string {
+	if r == nil || r.DirectGrantFlow == nil {
+		return ""
+	}
+	return *r.DirectGrantFlow
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (r *Realm) GetDisplayName() string {
+	if r == nil || r.DisplayName == nil {
+		return ""
+	}
+	return *r.DisplayName
+}
+
+// GetDisplayNameHTML returns the DisplayNameHTML field if it's non-nil, zero value otherwise.
+func (r *Realm) GetDisplayNameHTML() string {
+	if r == nil || r.DisplayNameHTML == nil {
+		return ""
+	}
+	return *r.DisplayNameHTML
+}
+
+// GetDockerAuthenticationFlow returns the DockerAuthenticationFlow field if it's non-nil, zero value otherwise.
+func (r *Realm) GetDockerAuthenticationFlow() string {
+	if r == nil || r.DockerAuthenticationFlow == nil {
+		return ""
+	}
+	return *r.DockerAuthenticationFlow
+}
+
+// GetDuplicateEmailsAllowed returns the DuplicateEmailsAllowed field if it's non-nil, zero value otherwise.
+func (r *Realm) GetDuplicateEmailsAllowed() bool {
+	if r == nil || r.DuplicateEmailsAllowed == nil {
+		return false
+	}
+	return *r.DuplicateEmailsAllowed
+}
+
+// GetEditUsernameAllowed returns the EditUsernameAllowed field if it's non-nil, zero value otherwise.
+func (r *Realm) GetEditUsernameAllowed() bool {
+	if r == nil || r.EditUsernameAllowed == nil {
+		return false
+	}
+	return *r.EditUsernameAllowed
+}
+
+// GetEmailTheme returns the EmailTheme field if it's non-nil, zero value otherwise.
+func (r *Realm) GetEmailTheme() string {
+	if r == nil || r.EmailTheme == nil {
+		return ""
+	}
+	return *r.EmailTheme
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (r *Realm) GetEnabled() bool {
+	if r == nil || r.Enabled == nil {
+		return false
+	}
+	return *r.Enabled
+}
+
+// GetEventsEnabled returns the EventsEnabled field if it's non-nil, zero value otherwise.
+func (r *Realm) GetEventsEnabled() bool {
+	if r == nil || r.EventsEnabled == nil {
+		return false
+	}
+	return *r.EventsEnabled
+}
+
+// GetEventsExpiration returns the EventsExpiration field if it's non-nil, zero value otherwise.
+func (r *Realm) GetEventsExpiration() int64 {
+	if r == nil || r.EventsExpiration == nil {
+		return 0
+	}
+	return *r.EventsExpiration
+}
+
+// GetFailureFactor returns the FailureFactor field if it's non-nil, zero value otherwise.
+func (r *Realm) GetFailureFactor() int {
+	if r == nil || r.FailureFactor == nil {
+		return 0
+	}
+	return *r.FailureFactor
+}
+
+// GetFirstBrokerLoginFlow returns the FirstBrokerLoginFlow field if it's non-nil, zero value otherwise.
+func (r *Realm) GetFirstBrokerLoginFlow() string {
+	if r == nil || r.FirstBrokerLoginFlow == nil {
+		return ""
+	}
+	return *r.FirstBrokerLoginFlow
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Realm) GetID() string {
+	if r == nil || r.ID == nil {
+		return ""
+	}
+	return *r.ID
+}
+
+// GetInternationalizationEnabled returns the InternationalizationEnabled field if it's non-nil, zero value otherwise.
+func (r *Realm) GetInternationalizationEnabled() bool {
+	if r == nil || r.InternationalizationEnabled == nil {
+		return false
+	}
+	return *r.InternationalizationEnabled
+}
+
+// GetKeycloakVersion returns the KeycloakVersion field if it's non-nil, zero value otherwise.
+func (r *Realm) GetKeycloakVersion() string {
+	if r == nil || r.KeycloakVersion == nil {
+		return ""
+	}
+	return *r.KeycloakVersion
+}
+
+// GetLoginTheme returns the LoginTheme field if it's non-nil, zero value otherwise.
+func (r *Realm) GetLoginTheme() string {
+	if r == nil || r.LoginTheme == nil {
+		return ""
+	}
+	return *r.LoginTheme
+}
+
+// GetLoginWithEmailAllowed returns the LoginWithEmailAllowed field if it's non-nil, zero value otherwise.
+func (r *Realm) GetLoginWithEmailAllowed() bool {
+	if r == nil || r.LoginWithEmailAllowed == nil {
+		return false
+	}
+	return *r.LoginWithEmailAllowed
+}
+
+// GetMaxDeltaTimeSeconds returns the MaxDeltaTimeSeconds field if it's non-nil, zero value otherwise.
+func (r *Realm) GetMaxDeltaTimeSeconds() int {
+	if r == nil || r.MaxDeltaTimeSeconds == nil {
+		return 0
+	}
+	return *r.MaxDeltaTimeSeconds
+}
+
+// GetMaxFailureWaitSeconds returns the MaxFailureWaitSeconds field if it's non-nil, zero value otherwise.
+func (r *Realm) GetMaxFailureWaitSeconds() int {
+	if r == nil || r.MaxFailureWaitSeconds == nil {
+		return 0
+	}
+	return *r.MaxFailureWaitSeconds
+}
+
+// GetMaxTemporaryLockouts returns the MaxTemporaryLockouts field if it's non-nil, zero value otherwise.
+func (r *Realm) GetMaxTemporaryLockouts() int {
+	if r == nil || r.MaxTemporaryLockouts == nil {
+		return 0
+	}
+	return *r.MaxTemporaryLockouts
+}
+
+// GetMinimumQuickLoginWaitSeconds returns the MinimumQuickLoginWaitSeconds field if it's non-nil, zero value otherwise.
+func (r *Realm) GetMinimumQuickLoginWaitSeconds() int {
+	if r == nil || r.MinimumQuickLoginWaitSeconds == nil {
+		return 0
+	}
+	return *r.MinimumQuickLoginWaitSeconds
+}
+
+// GetNotBefore returns the NotBefore field if it's non-nil, zero value otherwise.
+func (r *Realm) GetNotBefore() int {
+	if r == nil || r.NotBefore == nil {
+		return 0
+	}
+	return *r.NotBefore
+}
+
+// GetOAuth2DeviceCodeLifespan returns the OAuth2DeviceCodeLifespan field if it's non-nil, zero value otherwise.
+func (r *Realm) GetOAuth2DeviceCodeLifespan() int {
+	if r == nil || r.OAuth2DeviceCodeLifespan == nil {
+		return 0
+	}
+	return *r.OAuth2DeviceCodeLifespan
+}
+
+// GetOAuth2DevicePollingInterval returns the OAuth2DevicePollingInterval field if it's non-nil, zero value otherwise.
+func (r *Realm) GetOAuth2DevicePollingInterval() int {
+	if r == nil || r.OAuth2DevicePollingInterval == nil {
+		return 0
+	}
+	return *r.OAuth2DevicePollingInterval
+}
+
+// GetOfflineSessionIdleTimeout returns the OfflineSessionIdleTimeout field if it's non-nil, zero value otherwise.
+func (r *Realm) GetOfflineSessionIdleTimeout() int {
+	if r == nil || r.OfflineSessionIdleTimeout == nil {
+		return 0
+	}
+	return *r.OfflineSessionIdleTimeout
+}
+
+// GetOfflineSessionMaxLifespan returns the OfflineSessionMaxLifespan field if it's non-nil, zero value otherwise.
+func (r *Realm) GetOfflineSessionMaxLifespan() int {
+	if r == nil || r.OfflineSessionMaxLifespan == nil {
+		return 0
+	}
+	return *r.OfflineSessionMaxLifespan
+}
+
+// GetOfflineSessionMaxLifespanEnabled returns the OfflineSessionMaxLifespanEnabled field if it's non-nil, zero value otherwise.
+func (r *Realm) GetOfflineSessionMaxLifespanEnabled() bool {
+	if r == nil || r.OfflineSessionMaxLifespanEnabled == nil {
+		return false
+	}
+	return *r.OfflineSessionMaxLifespanEnabled
+}
+
+// GetOtpPolicyAlgorithm returns the OtpPolicyAlgorithm field if it's non-nil, zero value otherwise.
+func (r *Realm) GetOtpPolicyAlgorithm() string {
+	if r == nil || r.OtpPolicyAlgorithm == nil {
+		return ""
+	}
+	return *r.OtpPolicyAlgorithm
+}
+
+// GetOtpPolicyCodeReusable returns the OtpPolicyCodeReusable field if it's non-nil, zero value otherwise.
+func (r *Realm) GetOtpPolicyCodeReusable() bool {
+	if r == nil || r.OtpPolicyCodeReusable == nil {
+		return false
+	}
+	return *r.OtpPolicyCodeReusable
+}
+
+// GetOtpPolicyDigits returns the OtpPolicyDigits field if it's non-nil, zero value otherwise.
+func (r *Realm) GetOtpPolicyDigits() int {
+	if r == nil || r.OtpPolicyDigits == nil {
+		return 0
+	}
+	return *r.OtpPolicyDigits
+}
+
+// GetOtpPolicyInitialCounter returns the OtpPolicyInitialCounter field if it's non-nil, zero value otherwise.
+func (r *Realm) GetOtpPolicyInitialCounter() int {
+	if r == nil || r.OtpPolicyInitialCounter == nil {
+		return 0
+	}
+	return *r.OtpPolicyInitialCounter
+}
+
+// GetOtpPolicyLookAheadWindow returns the OtpPolicyLookAheadWindow field if it's non-nil, zero value otherwise.
+func (r *Realm) GetOtpPolicyLookAheadWindow() int {
+	if r == nil || r.OtpPolicyLookAheadWindow == nil {
+		return 0
+	}
+	return *r.OtpPolicyLookAheadWindow
+}
+
+// GetOtpPolicyPeriod returns the OtpPolicyPeriod field if it's non-nil, zero value otherwise.
+func (r *Realm) GetOtpPolicyPeriod() int {
+	if r == nil || r.OtpPolicyPeriod == nil {
+		return 0
+	}
+	return *r.OtpPolicyPeriod
+}
+
+// GetOtpPolicyType returns the OtpPolicyType field if it's non-nil, zero value otherwise.
+func (r *Realm) GetOtpPolicyType() string {
+	if r == nil || r.OtpPolicyType == nil {
+		return ""
+	}
+	return *r.OtpPolicyType
+}
+
+// GetPasswordPolicy returns the PasswordPolicy field if it's non-nil, zero value otherwise.
+func (r *Realm) GetPasswordPolicy() string {
+	if r == nil || r.PasswordPolicy == nil {
+		return ""
+	}
+	return *r.PasswordPolicy
+}
+
+// GetPermanentLockout returns the PermanentLockout field if it's non-nil, zero value otherwise.
+func (r *Realm) GetPermanentLockout() bool {
+	if r == nil || r.PermanentLockout == nil {
+		return false
+	}
+	return *r.PermanentLockout
+}
+
+// GetQuickLoginCheckMilliSeconds returns the QuickLoginCheckMilliSeconds field if it's non-nil, zero value otherwise.
+func (r *Realm) GetQuickLoginCheckMilliSeconds() int {
+	if r == nil || r.QuickLoginCheckMilliSeconds == nil {
+		return 0
+	}
+	return *r.QuickLoginCheckMilliSeconds
+}
+
+// GetRealm returns the Realm field if it's non-nil, zero value otherwise.
+func (r *Realm) GetRealm() string {
+	if r == nil || r.Realm == nil {
+		return ""
+	}
+	return *r.Realm
+}
+
+// GetRefreshTokenMaxReuse returns the RefreshTokenMaxReuse field if it's non-nil, zero value otherwise.
+func (r *Realm) GetRefreshTokenMaxReuse() int {
+	if r == nil || r.RefreshTokenMaxReuse == nil {
+		return 0
+	}
+	return *r.RefreshTokenMaxReuse
+}
+
+// GetRegistrationAllowed returns the RegistrationAllowed field if it's non-nil, zero value otherwise.
+func (r *Realm) GetRegistrationAllowed() bool {
+	if r == nil || r.RegistrationAllowed == nil {
+		return false
+	}
+	return *r.RegistrationAllowed
+}
+
+// GetRegistrationEmailAsUsername returns the RegistrationEmailAsUsername field if it's non-nil, zero value otherwise.
+func (r *Realm) GetRegistrationEmailAsUsername() bool {
+	if r == nil || r.RegistrationEmailAsUsername == nil {
+		return false
+	}
+	return *r.RegistrationEmailAsUsername
+}
+
+// GetRegistrationFlow returns the RegistrationFlow field if it's non-nil, zero value otherwise.
+func (r *Realm) GetRegistrationFlow() string {
+	if r == nil || r.RegistrationFlow == nil {
+		return ""
+	}
+	return *r.RegistrationFlow
+}
+
+// GetRememberMe returns the RememberMe field if it's non-nil, zero value otherwise.
+func (r *Realm) GetRememberMe() bool {
+	if r == nil || r.RememberMe == nil {
+		return false
+	}
+	return *r.RememberMe
+}
+
+// GetResetCredentialsFlow returns the ResetCredentialsFlow field if it's non-nil, zero value otherwise.
+func (r *Realm) GetResetCredentialsFlow() string {
+	if r == nil || r.ResetCredentialsFlow == nil {
+		return ""
+	}
+	return *r.ResetCredentialsFlow
+}
+
+// GetResetPasswordAllowed returns the ResetPasswordAllowed field if it's non-nil, zero value otherwise.
+func (r *Realm) GetResetPasswordAllowed() bool {
+	if r == nil || r.ResetPasswordAllowed == nil {
+		return false
+	}
+	return *r.ResetPasswordAllowed
+}
+
+// GetRevokeRefreshToken returns the RevokeRefreshToken field if it's non-nil, zero value otherwise.
+func (r *Realm) GetRevokeRefreshToken() bool {
+	if r == nil || r.RevokeRefreshToken == nil {
+		return false
+	}
+	return *r.RevokeRefreshToken
+}
+
+// GetRoles returns the Roles field.
+func (r *Realm) GetRoles() *Roles {
+	if r == nil {
+		return nil
+	}
+	return r.Roles
+}
+
+// GetSMTPServer returns the SMTPServer field if it's non-nil, zero value otherwise.
+func (r *Realm) GetSMTPServer() map[string]string {
+	if r == nil || r.SMTPServer == nil {
+		return nil
+	}
+	return *r.SMTPServer
+}
+
+// GetSslRequired returns the SslRequired field if it's non-nil, zero value otherwise.
+func (r *Realm) GetSslRequired() string {
+	if r == nil || r.SslRequired == nil {
+		return ""
+	}
+	return *r.SslRequired
+}
+
+// GetSsoSessionIdleTimeout returns the SsoSessionIdleTimeout field if it's non-nil, zero value otherwise.
+func (r *Realm) GetSsoSessionIdleTimeout() int {
+	if r == nil || r.SsoSessionIdleTimeout == nil {
+		return 0
+	}
+	return *r.SsoSessionIdleTimeout
+}
+
+// GetSsoSessionIdleTimeoutRememberMe returns the SsoSessionIdleTimeoutRememberMe field if it's non-nil, zero value otherwise.
+func (r *Realm) GetSsoSessionIdleTimeoutRememberMe() int {
+	if r == nil || r.SsoSessionIdleTimeoutRememberMe == nil {
+		return 0
+	}
+	return *r.SsoSessionIdleTimeoutRememberMe
+}
+
+// GetSsoSessionMaxLifespan returns the SsoSessionMaxLifespan field if it's non-nil, zero value otherwise.
+func (r *Realm) GetSsoSessionMaxLifespan() int {
+	if r == nil || r.SsoSessionMaxLifespan == nil {
+		return 0
+	}
+	return *r.SsoSessionMaxLifespan
+}
+
+// GetSsoSessionMaxLifespanRememberMe returns the SsoSessionMaxLifespanRememberMe field if it's non-nil, zero value otherwise.
+func (r *Realm) GetSsoSessionMaxLifespanRememberMe() int {
+	if r == nil || r.SsoSessionMaxLifespanRememberMe == nil {
+		return 0
+	}
+	return *r.SsoSessionMaxLifespanRememberMe
+}
+
+// GetUserManagedAccessAllowed returns the UserManagedAccessAllowed field if it's non-nil, zero value otherwise.
+func (r *Realm) GetUserManagedAccessAllowed() bool {
+	if r == nil || r.UserManagedAccessAllowed == nil {
+		return false
+	}
+	return *r.UserManagedAccessAllowed
+}
+
+// GetVerifyEmail returns the VerifyEmail field if it's non-nil, zero value otherwise.
+func (r *Realm) GetVerifyEmail() bool {
+	if r == nil || r.VerifyEmail == nil {
+		return false
+	}
+	return *r.VerifyEmail
+}
+
+// GetWaitIncrementSeconds returns the WaitIncrementSeconds field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWaitIncrementSeconds() int {
+	if r == nil || r.WaitIncrementSeconds == nil {
+		return 0
+	}
+	return *r.WaitIncrementSeconds
+}
+
+// GetWebAuthnPolicyAttestationConveyancePreference returns the WebAuthnPolicyAttestationConveyancePreference field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyAttestationConveyancePreference() string {
+	if r == nil || r.WebAuthnPolicyAttestationConveyancePreference == nil {
+		return ""
+	}
+	return *r.WebAuthnPolicyAttestationConveyancePreference
+}
+
+// GetWebAuthnPolicyAuthenticatorAttachment returns the WebAuthnPolicyAuthenticatorAttachment field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyAuthenticatorAttachment() string {
+	if r == nil || r.WebAuthnPolicyAuthenticatorAttachment == nil {
+		return ""
+	}
+	return *r.WebAuthnPolicyAuthenticatorAttachment
+}
+
+// GetWebAuthnPolicyAvoidSameAuthenticatorRegister returns the WebAuthnPolicyAvoidSameAuthenticatorRegister field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyAvoidSameAuthenticatorRegister() bool {
+	if r == nil || r.WebAuthnPolicyAvoidSameAuthenticatorRegister == nil {
+		return false
+	}
+	return *r.WebAuthnPolicyAvoidSameAuthenticatorRegister
+}
+
+// GetWebAuthnPolicyCreateTimeout returns the WebAuthnPolicyCreateTimeout field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyCreateTimeout() int {
+	if r == nil || r.WebAuthnPolicyCreateTimeout == nil {
+		return 0
+	}
+	return *r.WebAuthnPolicyCreateTimeout
+}
+
+// GetWebAuthnPolicyPasswordlessAttestationConveyancePreference returns the WebAuthnPolicyPasswordlessAttestationConveyancePreference field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyPasswordlessAttestationConveyancePreference() string {
+	if r == nil || r.WebAuthnPolicyPasswordlessAttestationConveyancePreference == nil {
+		return ""
+	}
+	return *r.WebAuthnPolicyPasswordlessAttestationConveyancePreference
+}
+
+// GetWebAuthnPolicyPasswordlessAuthenticatorAttachment returns the WebAuthnPolicyPasswordlessAuthenticatorAttachment field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyPasswordlessAuthenticatorAttachment() string {
+	if r == nil || r.WebAuthnPolicyPasswordlessAuthenticatorAttachment == nil {
+		return ""
+	}
+	return *r.WebAuthnPolicyPasswordlessAuthenticatorAttachment
+}
+
+// GetWebAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister returns the WebAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister() bool {
+	if r == nil || r.WebAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister == nil {
+		return false
+	}
+	return *r.WebAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister
+}
+
+// GetWebAuthnPolicyPasswordlessCreateTimeout returns the WebAuthnPolicyPasswordlessCreateTimeout field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyPasswordlessCreateTimeout() int {
+	if r == nil || r.WebAuthnPolicyPasswordlessCreateTimeout == nil {
+		return 0
+	}
+	return *r.WebAuthnPolicyPasswordlessCreateTimeout
+}
+
+// GetWebAuthnPolicyPasswordlessRequireResidentKey returns the WebAuthnPolicyPasswordlessRequireResidentKey field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyPasswordlessRequireResidentKey() string {
+	if r == nil || r.WebAuthnPolicyPasswordlessRequireResidentKey == nil {
+		return ""
+	}
+	return *r.WebAuthnPolicyPasswordlessRequireResidentKey
+}
+
+// GetWebAuthnPolicyPasswordlessRpEntityName returns the WebAuthnPolicyPasswordlessRpEntityName field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyPasswordlessRpEntityName() string {
+	if r == nil || r.WebAuthnPolicyPasswordlessRpEntityName == nil {
+		return ""
+	}
+	return *r.WebAuthnPolicyPasswordlessRpEntityName
+}
+
+// GetWebAuthnPolicyPasswordlessRpID returns the WebAuthnPolicyPasswordlessRpID field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyPasswordlessRpID() string {
+	if r == nil || r.WebAuthnPolicyPasswordlessRpID == nil {
+		return ""
+	}
+	return *r.WebAuthnPolicyPasswordlessRpID
+}
+
+// GetWebAuthnPolicyPasswordlessUserVerificationRequirement returns the WebAuthnPolicyPasswordlessUserVerificationRequirement field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyPasswordlessUserVerificationRequirement() string {
+	if r == nil || r.WebAuthnPolicyPasswordlessUserVerificationRequirement == nil {
+		return ""
+	}
+	return *r.WebAuthnPolicyPasswordlessUserVerificationRequirement
+}
+
+// GetWebAuthnPolicyRequireResidentKey returns the WebAuthnPolicyRequireResidentKey field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyRequireResidentKey() string {
+	if r == nil || r.WebAuthnPolicyRequireResidentKey == nil {
+		return ""
+	}
+	return *r.WebAuthnPolicyRequireResidentKey
+}
+
+// GetWebAuthnPolicyRpEntityName returns the WebAuthnPolicyRpEntityName field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyRpEntityName() string {
+	if r == nil || r.WebAuthnPolicyRpEntityName == nil {
+		return ""
+	}
+	return *r.WebAuthnPolicyRpEntityName
+}
+
+// GetWebAuthnPolicyRpID returns the WebAuthnPolicyRpID field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyRpID() string {
+	if r == nil || r.WebAuthnPolicyRpID == nil {
+		return ""
+	}
+	return *r.WebAuthnPolicyRpID
+}
+
+// GetWebAuthnPolicyUserVerificationRequirement returns the WebAuthnPolicyUserVerificationRequirement field if it's non-nil, zero value otherwise.
+func (r *Realm) GetWebAuthnPolicyUserVerificationRequirement() string {
+	if r == nil || r.WebAuthnPolicyUserVerificationRequirement == nil {
+		return ""
+	}
+	return *r.WebAuthnPolicyUserVerificationRequirement
+}
+
+// GetAdminEventsDetailsEnabled returns the AdminEventsDetailsEnabled field if it's non-nil, zero value otherwise.
+func (r *RealmEventsConfig) GetAdminEventsDetailsEnabled() bool {
+	if r == nil || r.AdminEventsDetailsEnabled == nil {
+		return false
+	}
+	return *r.AdminEventsDetailsEnabled
+}
+
+// GetAdminEventsEnabled returns the AdminEventsEnabled field if it's non-nil, zero value otherwise.
+func (r *RealmEventsConfig) GetAdminEventsEnabled() bool {
+	if r == nil || r.AdminEventsEnabled == nil {
+		return false
+	}
+	return *r.AdminEventsEnabled
+}
+
+// GetEventsEnabled returns the EventsEnabled field if it's non-nil, zero value otherwise.
+func (r *RealmEventsConfig) GetEventsEnabled() bool {
+	if r == nil || r.EventsEnabled == nil {
+		return false
+	}
+	return *r.EventsEnabled
+}
+
+// GetEventsExpiration returns the EventsExpiration field if it's non-nil, zero value otherwise.
+func (r *RealmEventsConfig) GetEventsExpiration() int64 {
+	if r == nil || r.EventsExpiration == nil {
+		return 0
+	}
+	return *r.EventsExpiration
+}
+
+// GetAlias returns the Alias field if it's non-nil, zero value otherwise.
+func (r *RequiredAction) GetAlias() string {
+	if r == nil || r.Alias == nil {
+		return ""
+	}
+	return *r.Alias
+}
+
+// GetConfig returns the Config field if it's non-nil, zero value otherwise.
+func (r *RequiredAction) GetConfig() map[string]string {
+	if r == nil || r.Config == nil {
+		return nil
+	}
+	return *r.Config
+}
+
+// GetDefaultAction returns the DefaultAction field if it's non-nil, zero value otherwise.
+func (r *RequiredAction) GetDefaultAction() bool {
+	if r == nil || r.DefaultAction == nil {
+		return false
+	}
+	return *r.DefaultAction
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (r *RequiredAction) GetEnabled() bool {
+	if r == nil || r.Enabled == nil {
+		return false
+	}
+	return *r.Enabled
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RequiredAction) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetPriority returns the Priority field if it's non-nil, zero value otherwise.
+func (r *RequiredAction) GetPriority() int {
+	if r == nil || r.Priority == nil {
+		return 0
+	}
+	return *r.Priority
+}
+
+// GetProviderID returns the ProviderID field if it's non-nil, zero value otherwise.
+func (r *RequiredAction) GetProviderID() string {
+	if r == nil || r.ProviderID == nil {
+		return ""
+	}
+	return *r.ProviderID
+}
+
+// GetAttributes returns the Attributes field if it's non-nil, zero value otherwise.
+func (r *Resource) GetAttributes() map[string][]string {
+	if r == nil || r.Attributes == nil {
+		return nil
+	}
+	return *r.Attributes
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (r *Resource) GetDisplayName() string {
+	if r == nil || r.DisplayName == nil {
+		return ""
+	}
+	return *r.DisplayName
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Resource) GetID() string {
+	if r == nil || r.ID == nil {
+		return ""
+	}
+	return *r.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *Resource) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetOwner returns the Owner field.
+func (r *Resource) GetOwner() *ResourceOwner {
+	if r == nil {
+		return nil
+	}
+	return r.Owner
+}
+
+// GetOwnerManagedAccess returns the OwnerManagedAccess field if it's non-nil, zero value otherwise.
+func (r *Resource) GetOwnerManagedAccess() bool {
+	if r == nil || r.OwnerManagedAccess == nil {
+		return false
+	}
+	return *r.OwnerManagedAccess
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *ResourceOwner) GetID() string {
+	if r == nil || r.ID == nil {
+		return ""
+	}
+	return *r.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *ResourceOwner) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetResourceType returns the ResourceType field if it's non-nil, zero value otherwise.
+func (r *ResourcePermission) GetResourceType() string {
+	if r == nil || r.ResourceType == nil {
+		return ""
+	}
+	return *r.ResourceType
+}
+
+// GetAllowRemoteResourceManagement returns the AllowRemoteResourceManagement field if it's non-nil, zero value otherwise.
+func (r *ResourceServer) GetAllowRemoteResourceManagement() bool {
+	if r == nil || r.AllowRemoteResourceManagement == nil {
+		return false
+	}
+	return *r.AllowRemoteResourceManagement
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (r *ResourceServer) GetClientID() string {
+	if r == nil || r.ClientID == nil {
+		return ""
+	}
+	return *r.ClientID
+}
+
+// GetDecisionStrategy returns the DecisionStrategy field if it's non-nil, zero value otherwise.
+func (r *ResourceServer) GetDecisionStrategy() string {
+	if r == nil || r.DecisionStrategy == nil {
+		return ""
+	}
+	return *r.DecisionStrategy
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *ResourceServer) GetID() string {
+	if r == nil || r.ID == nil {
+		return ""
+	}
+	return *r.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *ResourceServer) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetPolicyEnforcementMode returns the PolicyEnforcementMode field if it's non-nil, zero value otherwise.
+func (r *ResourceServer) GetPolicyEnforcementMode() string {
+	if r == nil || r.PolicyEnforcementMode == nil {
+		return ""
+	}
+	return *r.PolicyEnforcementMode
+}
+
+// GetAttributes returns the Attributes field if it's non-nil, zero value otherwise.
+func (r *Role) GetAttributes() map[string][]string {
+	if r == nil || r.Attributes == nil {
+		return nil
+	}
+	return *r.Attributes
+}
+
+// GetClientRole returns the ClientRole field if it's non-nil, zero value otherwise.
+func (r *Role) GetClientRole() bool {
+	if r == nil || r.ClientRole == nil {
+		return false
+	}
+	return *r.ClientRole
+}
+
+// GetComposite returns the Composite field if it's non-nil, zero value otherwise.
+func (r *Role) GetComposite() bool {
+	if r == nil || r.Composite == nil {
+		return false
+	}
+	return *r.Composite
+}
+
+// GetComposites returns the Composites field.
+func (r *Role) GetComposites() *RoleComposites {
+	if r == nil {
+		return nil
+	}
+	return r.Composites
+}
+
+// GetContainerID returns the ContainerID field if it's non-nil, zero value otherwise.
+func (r *Role) GetContainerID() string {
+	if r == nil || r.ContainerID == nil {
+		return ""
+	}
+	return *r.ContainerID
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *Role) GetDescription() string {
+	if r == nil || r.Description == nil {
+		return ""
+	}
+	return *r.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Role) GetID() string {
+	if r == nil || r.ID == nil {
+		return ""
+	}
+	return *r.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *Role) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetClient returns the Client field if it's non-nil, zero value otherwise.
+func (r *RoleComposites) GetClient() map[string][]string {
+	if r == nil || r.Client == nil {
+		return nil
+	}
+	return *r.Client
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RoleDefinition) GetID() string {
+	if r == nil || r.ID == nil {
+		return ""
+	}
+	return *r.ID
+}
+
+// GetRequired returns the Required field if it's non-nil, zero value otherwise.
+func (r *RoleDefinition) GetRequired() bool {
+	if r == nil || r.Required == nil {
+		return false
+	}
+	return *r.Required
+}
+
+// GetBriefRepresentation returns the BriefRepresentation field if it's non-nil, zero value otherwise.
+func (r *RoleGroupsListOptions) GetBriefRepresentation() bool {
+	if r == nil || r.BriefRepresentation == nil {
+		return false
+	}
+	return *r.BriefRepresentation
+}
+
+// GetAllowECPFlow returns the AllowECPFlow field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetAllowECPFlow() bool {
+	if s == nil || s.AllowECPFlow == nil {
+		return false
+	}
+	return *s.AllowECPFlow
+}
+
+// GetArtifactBinding returns the ArtifactBinding field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetArtifactBinding() bool {
+	if s == nil || s.ArtifactBinding == nil {
+		return false
+	}
+	return *s.ArtifactBinding
+}
+
+// GetArtifactBindingURL returns the ArtifactBindingURL field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetArtifactBindingURL() string {
+	if s == nil || s.ArtifactBindingURL == nil {
+		return ""
+	}
+	return *s.ArtifactBindingURL
+}
+
+// GetAssertionConsumerURLArtifact returns the AssertionConsumerURLArtifact field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetAssertionConsumerURLArtifact() string {
+	if s == nil || s.AssertionConsumerURLArtifact == nil {
+		return ""
+	}
+	return *s.AssertionConsumerURLArtifact
+}
+
+// GetAssertionConsumerURLPost returns the AssertionConsumerURLPost field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetAssertionConsumerURLPost() string {
+	if s == nil || s.AssertionConsumerURLPost == nil {
+		return ""
+	}
+	return *s.AssertionConsumerURLPost
+}
+
+// GetAssertionConsumerURLRedirect returns the AssertionConsumerURLRedirect field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetAssertionConsumerURLRedirect() string {
+	if s == nil || s.AssertionConsumerURLRedirect == nil {
+		return ""
+	}
+	return *s.AssertionConsumerURLRedirect
+}
+
+// GetAssertionLifespan returns the AssertionLifespan field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetAssertionLifespan() int {
+	if s == nil || s.AssertionLifespan == nil {
+		return 0
+	}
+	return *s.AssertionLifespan
+}
+
+// GetAssertionSignature returns the AssertionSignature field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetAssertionSignature() bool {
+	if s == nil || s.AssertionSignature == nil {
+		return false
+	}
+	return *s.AssertionSignature
+}
+
+// GetAuthnStatement returns the AuthnStatement field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetAuthnStatement() bool {
+	if s == nil || s.AuthnStatement == nil {
+		return false
+	}
+	return *s.AuthnStatement
+}
+
+// GetCanonicalizationMethod returns the CanonicalizationMethod field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetCanonicalizationMethod() string {
+	if s == nil || s.CanonicalizationMethod == nil {
+		return ""
+	}
+	return *s.CanonicalizationMethod
+}
+
+// GetClientSignatureRequired returns the ClientSignatureRequired field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetClientSignatureRequired() bool {
+	if s == nil || s.ClientSignatureRequired == nil {
+		return false
+	}
+	return *s.ClientSignatureRequired
+}
+
+// GetEncrypt returns the Encrypt field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetEncrypt() bool {
+	if s == nil || s.Encrypt == nil {
+		return false
+	}
+	return *s.Encrypt
+}
+
+// GetEncryptionCertificate returns the EncryptionCertificate field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetEncryptionCertificate() string {
+	if s == nil || s.EncryptionCertificate == nil {
+		return ""
+	}
+	return *s.EncryptionCertificate
+}
+
+// GetForceNameIDFormat returns the ForceNameIDFormat field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetForceNameIDFormat() bool {
+	if s == nil || s.ForceNameIDFormat == nil {
+		return false
+	}
+	return *s.ForceNameIDFormat
+}
+
+// GetForcePostBinding returns the ForcePostBinding field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetForcePostBinding() bool {
+	if s == nil || s.ForcePostBinding == nil {
+		return false
+	}
+	return *s.ForcePostBinding
+}
+
+// GetIDPInitiatedSSORelayState returns the IDPInitiatedSSORelayState field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetIDPInitiatedSSORelayState() string {
+	if s == nil || s.IDPInitiatedSSORelayState == nil {
+		return ""
+	}
+	return *s.IDPInitiatedSSORelayState
+}
+
+// GetIDPInitiatedSSOURLName returns the IDPInitiatedSSOURLName field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetIDPInitiatedSSOURLName() string {
+	if s == nil || s.IDPInitiatedSSOURLName == nil {
+		return ""
+	}
+	return *s.IDPInitiatedSSOURLName
+}
+
+// GetNameIDFormat returns the NameIDFormat field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetNameIDFormat() string {
+	if s == nil || s.NameIDFormat == nil {
+		return ""
+	}
+	return *s.NameIDFormat
+}
+
+// GetOneTimeUseCondition returns the OneTimeUseCondition field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetOneTimeUseCondition() bool {
+	if s == nil || s.OneTimeUseCondition == nil {
+		return false
+	}
+	return *s.OneTimeUseCondition
+}
+
+// GetServerSignature returns the ServerSignature field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetServerSignature() bool {
+	if s == nil || s.ServerSignature == nil {
+		return false
+	}
+	return *s.ServerSignature
+}
+
+// GetServerSignatureKeyInfoExt returns the ServerSignatureKeyInfoExt field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetServerSignatureKeyInfoExt() bool {
+	if s == nil || s.ServerSignatureKeyInfoExt == nil {
+		return false
+	}
+	return *s.ServerSignatureKeyInfoExt
+}
+
+// GetSignatureAlgorithm returns the SignatureAlgorithm field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetSignatureAlgorithm() string {
+	if s == nil || s.SignatureAlgorithm == nil {
+		return ""
+	}
+	return *s.SignatureAlgorithm
+}
+
+// GetSignatureKeyName returns the SignatureKeyName field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetSignatureKeyName() string {
+	if s == nil || s.SignatureKeyName == nil {
+		return ""
+	}
+	return *s.SignatureKeyName
+}
+
+// GetSigningCertificate returns the SigningCertificate field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetSigningCertificate() string {
+	if s == nil || s.SigningCertificate == nil {
+		return ""
+	}
+	return *s.SigningCertificate
+}
+
+// GetSingleLogoutServiceURLArtifact returns the SingleLogoutServiceURLArtifact field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetSingleLogoutServiceURLArtifact() string {
+	if s == nil || s.SingleLogoutServiceURLArtifact == nil {
+		return ""
+	}
+	return *s.SingleLogoutServiceURLArtifact
+}
+
+// GetSingleLogoutServiceURLPost returns the SingleLogoutServiceURLPost field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetSingleLogoutServiceURLPost() string {
+	if s == nil || s.SingleLogoutServiceURLPost == nil {
+		return ""
+	}
+	return *s.SingleLogoutServiceURLPost
+}
+
+// GetSingleLogoutServiceURLRedirect returns the SingleLogoutServiceURLRedirect field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetSingleLogoutServiceURLRedirect() string {
+	if s == nil || s.SingleLogoutServiceURLRedirect == nil {
+		return ""
+	}
+	return *s.SingleLogoutServiceURLRedirect
+}
+
+// GetSingleLogoutServiceURLSOAP returns the SingleLogoutServiceURLSOAP field if it's non-nil, zero value otherwise.
+func (s *SAMLAttributes) GetSingleLogoutServiceURLSOAP() string {
+	if s == nil || s.SingleLogoutServiceURLSOAP == nil {
+		return ""
+	}
+	return *s.SingleLogoutServiceURLSOAP
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (s *Scope) GetDisplayName() string {
+	if s == nil || s.DisplayName == nil {
+		return ""
+	}
+	return *s.DisplayName
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *Scope) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// GetIconURI returns the IconURI field if it's non-nil, zero value otherwise.
+func (s *Scope) GetIconURI() string {
+	if s == nil || s.IconURI == nil {
+		return ""
+	}
+	return *s.IconURI
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *Scope) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetResourceType returns the ResourceType field if it's non-nil, zero value otherwise.
+func (s *ScopePermission) GetResourceType() string {
+	if s == nil || s.ResourceType == nil {
+		return ""
+	}
+	return *s.ResourceType
+}
+
+// GetBruteForceDetection returns the BruteForceDetection field.
+func (s *SecurityDefenses) GetBruteForceDetection() *BruteForceDetection {
+	if s == nil {
+		return nil
+	}
+	return s.BruteForceDetection
+}
+
+// GetHeaders returns the Headers field.
+func (s *SecurityDefenses) GetHeaders() *BrowserSecurityHeaders {
+	if s == nil {
+		return nil
+	}
+	return s.Headers
+}
+
+// GetAdded returns the Added field if it's non-nil, zero value otherwise.
+func (s *SynchronizationResult) GetAdded() int {
+	if s == nil || s.Added == nil {
+		return 0
+	}
+	return *s.Added
+}
+
+// GetFailed returns the Failed field if it's non-nil, zero value otherwise.
+func (s *SynchronizationResult) GetFailed() int {
+	if s == nil || s.Failed == nil {
+		return 0
+	}
+	return *s.Failed
+}
+
+// GetIgnored returns the Ignored field if it's non-nil, zero value otherwise.
+func (s *SynchronizationResult) GetIgnored() bool {
+	if s == nil || s.Ignored == nil {
+		return false
+	}
+	return *s.Ignored
+}
+
+// GetRemoved returns the Removed field if it's non-nil, zero value otherwise.
+func (s *SynchronizationResult) GetRemoved() int {
+	if s == nil || s.Removed == nil {
+		return 0
+	}
+	return *s.Removed
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (s *SynchronizationResult) GetStatus() string {
+	if s == nil || s.Status == nil {
+		return ""
+	}
+	return *s.Status
+}
+
+// GetUpdated returns the Updated field if it's non-nil, zero value otherwise.
+func (s *SynchronizationResult) GetUpdated() int {
+	if s == nil || s.Updated == nil {
+		return 0
+	}
+	return *s.Updated
+}
+
+// GetDayMonth returns the DayMonth field if it's non-nil, zero value otherwise.
+func (t *TimePolicy) GetDayMonth() string {
+	if t == nil || t.DayMonth == nil {
+		return ""
+	}
+	return *t.DayMonth
+}
+
+// GetDayMonthEnd returns the DayMonthEnd field if it's non-nil, zero value otherwise.
+func (t *TimePolicy) GetDayMonthEnd() string {
+	if t == nil || t.DayMonthEnd == nil {
+		return ""
+	}
+	return *t.DayMonthEnd
+}
+
+// GetHour returns the Hour field if it's non-nil, zero value otherwise.
+func (t *TimePolicy) GetHour() string {
+	if t == nil || t.Hour == nil {
+		return ""
+	}
+	return *t.Hour
+}
+
+// GetHourEnd returns the HourEnd field if it's non-nil, zero value otherwise.
+func (t *TimePolicy) GetHourEnd() string {
+	if t == nil || t.HourEnd == nil {
+		return ""
+	}
+	return *t.HourEnd
+}
+
+// GetMinute returns the Minute field if it's non-nil, zero value otherwise.
+func (t *TimePolicy) GetMinute() string {
+	if t == nil || t.Minute == nil {
+		return ""
+	}
+	return *t.Minute
+}
+
+// GetMinuteEnd returns the MinuteEnd field if it's non-nil, zero value otherwise.
+func (t *TimePolicy) GetMinuteEnd() string {
+	if t == nil || t.MinuteEnd == nil {
+		return ""
+	}
+	return *t.MinuteEnd
+}
+
+// GetMonth returns the Month field if it's non-nil, zero value otherwise.
+func (t *TimePolicy) GetMonth() string {
+	if t == nil || t.Month == nil {
+		return ""
+	}
+	return *t.Month
+}
+
+// GetMonthEnd returns the MonthEnd field if it's non-nil, zero value otherwise.
+func (t *TimePolicy) GetMonthEnd() string {
+	if t == nil || t.MonthEnd == nil {
+		return ""
+	}
+	return *t.MonthEnd
+}
+
+// GetNotBefore returns the NotBefore field if it's non-nil, zero value otherwise.
+func (t *TimePolicy) GetNotBefore() string {
+	if t == nil || t.NotBefore == nil {
+		return ""
+	}
+	return *t.NotBefore
+}
+
+// GetNotOnOrAfter returns the NotOnOrAfter field if it's non-nil, zero value otherwise.
+func (t *TimePolicy) GetNotOnOrAfter() string {
+	if t == nil || t.NotOnOrAfter == nil {
+		return ""
+	}
+	return *t.NotOnOrAfter
+}
+
+// GetYear returns the Year field if it's non-nil, zero value otherwise.
+func (t *TimePolicy) GetYear() string {
+	if t == nil || t.Year == nil {
+		return ""
+	}
+	return *t.Year
+}
+
+// GetYearEnd returns the YearEnd field if it's non-nil, zero value otherwise.
+func (t *TimePolicy) GetYearEnd() string {
+	if t == nil || t.YearEnd == nil {
+		return ""
+	}
+	return *t.YearEnd
+}
+
+// GetCondition returns the Condition field if it's non-nil, zero value otherwise.
+func (u *UMAPolicy) GetCondition() string {
+	if u == nil || u.Condition == nil {
+		return ""
+	}
+	return *u.Condition
+}
+
+// GetDecisionStrategy returns the DecisionStrategy field if it's non-nil, zero value otherwise.
+func (u *UMAPolicy) GetDecisionStrategy() string {
+	if u == nil || u.DecisionStrategy == nil {
+		return ""
+	}
+	return *u.DecisionStrategy
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (u *UMAPolicy) GetDescription() string {
+	if u == nil || u.Description == nil {
+		return ""
+	}
+	return *u.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (u *UMAPolicy) GetID() string {
+	if u == nil || u.ID == nil {
+		return ""
+	}
+	return *u.ID
+}
+
+// GetLogic returns the Logic field if it's non-nil, zero value otherwise.
+func (u *UMAPolicy) GetLogic() string {
+	if u == nil || u.Logic == nil {
+		return ""
+	}
+	return *u.Logic
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (u *UMAPolicy) GetName() string {
+	if u == nil || u.Name == nil {
+		return ""
+	}
+	return *u.Name
+}
+
+// GetOwner returns the Owner field if it's non-nil, zero value otherwise.
+func (u *UMAPolicy) GetOwner() string {
+	if u == nil || u.Owner == nil {
+		return ""
+	}
+	return *u.Owner
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (u *UMAPolicy) GetType() string {
+	if u == nil || u.Type == nil {
+		return ""
+	}
+	return *u.Type
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (u *UPAttribute) GetDisplayName() string {
+	if u == nil || u.DisplayName == nil {
+		return ""
+	}
+	return *u.DisplayName
+}
+
+// GetGroup returns the Group field if it's non-nil, zero value otherwise.
+func (u *UPAttribute) GetGroup() string {
+	if u == nil || u.Group == nil {
+		return ""
+	}
+	return *u.Group
+}
+
+// GetMultivalued returns the Multivalued field if it's non-nil, zero value otherwise.
+func (u *UPAttribute) GetMultivalued() bool {
+	if u == nil || u.Multivalued == nil {
+		return false
+	}
+	return *u.Multivalued
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (u *UPAttribute) GetName() string {
+	if u == nil || u.Name == nil {
+		return ""
+	}
+	return *u.Name
+}
+
+// GetPermissions returns the Permissions field.
+func (u *UPAttribute) GetPermissions() *UPAttributePermissions {
+	if u == nil {
+		return nil
+	}
+	return u.Permissions
+}
+
+// GetRequired returns the Required field.
+func (u *UPAttribute) GetRequired() *UPAttributeRequired {
+	if u == nil {
+		return nil
+	}
+	return u.Required
+}
+
+// GetSelector returns the Selector field.
+func (u *UPAttribute) GetSelector() *UPAttributeSelector {
+	if u == nil {
+		return nil
+	}
+	return u.Selector
+}
+
+// GetUnmanagedAttributePolicy returns the UnmanagedAttributePolicy field if it's non-nil, zero value otherwise.
+func (u *UPConfig) GetUnmanagedAttributePolicy() string {
+	if u == nil || u.UnmanagedAttributePolicy == nil {
+		return ""
+	}
+	return *u.UnmanagedAttributePolicy
+}
+
+// GetDisplayDescription returns the DisplayDescription field if it's non-nil, zero value otherwise.
+func (u *UPGroup) GetDisplayDescription() string {
+	if u == nil || u.DisplayDescription == nil {
+		return ""
+	}
+	return *u.DisplayDescription
+}
+
+// GetDisplayHeader returns the DisplayHeader field if it's non-nil, zero value otherwise.
+func (u *UPGroup) GetDisplayHeader() string {
+	if u == nil || u.DisplayHeader == nil {
+		return ""
+	}
+	return *u.DisplayHeader
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (u *UPGroup) GetName() string {
+	if u == nil || u.Name == nil {
+		return ""
+	}
+	return *u.Name
+}
+
+// GetAccess returns the Access field if it's non-nil, zero value otherwise.
+func (u *User) GetAccess() map[string]bool {
+	if u == nil || u.Access == nil {
+		return nil
+	}
+	return *u.Access
+}
+
+// GetAttributes returns the Attributes field if it's non-nil, zero value otherwise.
+func (u *User) GetAttributes() map[string][]string {
+	if u == nil || u.Attributes == nil {
+		return nil
+	}
+	return *u.Attributes
+}
+
+// GetCreatedTimestamp returns the CreatedTimestamp field if it's non-nil, zero value otherwise.
+func (u *User) GetCreatedTimestamp() int64 {
+	if u == nil || u.CreatedTimestamp == nil {
+		return 0
+	}
+	return *u.CreatedTimestamp
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (u *User) GetEmail() string {
+	if u == nil || u.Email == nil {
+		return ""
+	}
+	return *u.Email
+}
+
+// GetEmailVerified returns the EmailVerified field if it's non-nil, zero value otherwise.
+func (u *User) GetEmailVerified() bool {
+	if u == nil || u.EmailVerified == nil {
+		return false
+	}
+	return *u.EmailVerified
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (u *User) GetEnabled() bool {
+	if u == nil || u.Enabled == nil {
+		return false
+	}
+	return *u.Enabled
+}
+
+// GetFirstName returns the FirstName field if it's non-nil, zero value otherwise.
+func (u *User) GetFirstName() string {
+	if u == nil || u.FirstName == nil {
+		return ""
+	}
+	return *u.FirstName
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (u *User) GetID() string {
+	if u == nil || u.ID == nil {
+		return ""
+	}
+	return *u.ID
+}
+
+// GetLastName returns the LastName field if it's non-nil, zero value otherwise.
+func (u *User) GetLastName() string {
+	if u == nil || u.LastName == nil {
+		return ""
+	}
+	return *u.LastName
+}
+
+// GetNotBefore returns the NotBefore field if it's non-nil, zero value otherwise.
+func (u *User) GetNotBefore() int {
+	if u == nil || u.NotBefore == nil {
+		return 0
+	}
+	return *u.NotBefore
+}
+
+// GetTotp returns the Totp field if it's non-nil, zero value otherwise.
+func (u *User) GetTotp() bool {
+	if u == nil || u.Totp == nil {
+		return false
+	}
+	return *u.Totp
+}
+
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (u *User) GetUsername() string {
+	if u == nil || u.Username == nil {
+		return ""
+	}
+	return *u.Username
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (u *UserConsent) GetClientID() string {
+	if u == nil || u.ClientID == nil {
+		return ""
+	}
+	return *u.ClientID
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (u *UserConsent) GetCreatedDate() int64 {
+	if u == nil || u.CreatedDate == nil {
+		return 0
+	}
+	return *u.CreatedDate
+}
+
+// GetLastUpdatedDate returns the LastUpdatedDate field if it's non-nil, zero value otherwise.
+func (u *UserConsent) GetLastUpdatedDate() int64 {
+	if u == nil || u.LastUpdatedDate == nil {
+		return 0
+	}
+	return *u.LastUpdatedDate
+}
+
+// GetEmailVerified returns the EmailVerified field if it's non-nil, zero value otherwise.
+func (u *UserCountOptions) GetEmailVerified() bool {
+	if u == nil || u.EmailVerified == nil {
+		return false
+	}
+	return *u.EmailVerified
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (u *UserCountOptions) GetEnabled() bool {
+	if u == nil || u.Enabled == nil {
+		return false
+	}
+	return *u.Enabled
+}
+
+// GetBriefRepresentation returns the BriefRepresentation field if it's non-nil, zero value otherwise.
+func (u *UserGroupsListOptions) GetBriefRepresentation() bool {
+	if u == nil || u.BriefRepresentation == nil {
+		return false
+	}
+	return *u.BriefRepresentation
+}
+
+// GetBriefRepresentation returns the BriefRepresentation field if it's non-nil, zero value otherwise.
+func (u *UserListOptions) GetBriefRepresentation() bool {
+	if u == nil || u.BriefRepresentation == nil {
+		return false
+	}
+	return *u.BriefRepresentation
+}
+
+// GetEmailVerified returns the EmailVerified field if it's non-nil, zero value otherwise.
+func (u *UserListOptions) GetEmailVerified() bool {
+	if u == nil || u.EmailVerified == nil {
+		return false
+	}
+	return *u.EmailVerified
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (u *UserListOptions) GetEnabled() bool {
+	if u == nil || u.Enabled == nil {
+		return false
+	}
+	return *u.Enabled
+}
+
+// GetExact returns the Exact field if it's non-nil, zero value otherwise.
+func (u *UserListOptions) GetExact() bool {
+	if u == nil || u.Exact == nil {
+		return false
+	}
+	return *u.Exact
+}
+
+// GetClients returns the Clients field if it's non-nil, zero value otherwise.
+func (u *UserSession) GetClients() map[string]string {
+	if u == nil || u.Clients == nil {
+		return nil
+	}
+	return *u.Clients
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (u *UserSession) GetID() string {
+	if u == nil || u.ID == nil {
+		return ""
+	}
+	return *u.ID
+}
+
+// GetIPAddress returns the IPAddress field if it's non-nil, zero value otherwise.
+func (u *UserSession) GetIPAddress() string {
+	if u == nil || u.IPAddress == nil {
+		return ""
+	}
+	return *u.IPAddress
+}
+
+// GetLastAccess returns the LastAccess field if it's non-nil, zero value otherwise.
+func (u *UserSession) GetLastAccess() int64 {
+	if u == nil || u.LastAccess == nil {
+		return 0
+	}
+	return *u.LastAccess
+}
+
+// GetRememberMe returns the RememberMe field if it's non-nil, zero value otherwise.
+func (u *UserSession) GetRememberMe() bool {
+	if u == nil || u.RememberMe == nil {
+		return false
+	}
+	return *u.RememberMe
+}
+
+// GetStart returns the Start field if it's non-nil, zero value otherwise.
+func (u *UserSession) GetStart() int64 {
+	if u == nil || u.Start == nil {
+		return 0
+	}
+	return *u.Start
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (u *UserSession) GetUserID() string {
+	if u == nil || u.UserID == nil {
+		return ""
+	}
+	return *u.UserID
+}
+
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (u *UserSession) GetUsername() string {
+	if u == nil || u.Username == nil {
+		return ""
+	}
+	return *u.Username
+}
+
+// GetAdminEvent returns the AdminEvent field.
+func (w *WatchEvent) GetAdminEvent() *AdminEvent {
+	if w == nil {
+		return nil
+	}
+	return w.AdminEvent
+}
+
+// GetEvent returns the Event field.
+func (w *WatchEvent) GetEvent() *Event {
+	if w == nil {
+		return nil
+	}
+	return w.Event
+}
+
+// GetAdminEvents returns the AdminEvents field.
+func (w *WatchOptions) GetAdminEvents() *AdminEventListOptions {
+	if w == nil {
+		return nil
+	}
+	return w.AdminEvents
+}
+
+// GetEvents returns the Events field.
+func (w *WatchOptions) GetEvents() *EventListOptions {
+	if w == nil {
+		return nil
+	}
+	return w.Events
+}
+
+// GetAttestationConveyancePreference returns the AttestationConveyancePreference field if it's non-nil, zero value otherwise.
+func (w *WebAuthnPolicy) GetAttestationConveyancePreference() string {
+	if w == nil || w.AttestationConveyancePreference == nil {
+		return ""
+	}
+	return *w.AttestationConveyancePreference
+}
+
+// GetAuthenticatorAttachment returns the AuthenticatorAttachment field if it's non-nil, zero value otherwise.
+func (w *WebAuthnPolicy) GetAuthenticatorAttachment() string {
+	if w == nil || w.AuthenticatorAttachment == nil {
+		return ""
+	}
+	return *w.AuthenticatorAttachment
+}
+
+// GetAvoidSameAuthenticatorRegister returns the AvoidSameAuthenticatorRegister field if it's non-nil, zero value otherwise.
+func (w *WebAuthnPolicy) GetAvoidSameAuthenticatorRegister() bool {
+	if w == nil || w.AvoidSameAuthenticatorRegister == nil {
+		return false
+	}
+	return *w.AvoidSameAuthenticatorRegister
+}
+
+// GetCreateTimeout returns the CreateTimeout field if it's non-nil, zero value otherwise.
+func (w *WebAuthnPolicy) GetCreateTimeout() int {
+	if w == nil || w.CreateTimeout == nil {
+		return 0
+	}
+	return *w.CreateTimeout
+}
+
+// GetRequireResidentKey returns the RequireResidentKey field if it's non-nil, zero value otherwise.
+func (w *WebAuthnPolicy) GetRequireResidentKey() string {
+	if w == nil || w.RequireResidentKey == nil {
+		return ""
+	}
+	return *w.RequireResidentKey
+}
+
+// GetRpEntityName returns the RpEntityName field if it's non-nil, zero value otherwise.
+func (w *WebAuthnPolicy) GetRpEntityName() string {
+	if w == nil || w.RpEntityName == nil {
+		return ""
+	}
+	return *w.RpEntityName
+}
+
+// GetRpID returns the RpID field if it's non-nil, zero value otherwise.
+func (w *WebAuthnPolicy) GetRpID() string {
+	if w == nil || w.RpID == nil {
+		return ""
+	}
+	return *w.RpID
+}
+
+// GetUserVerificationRequirement returns the UserVerificationRequirement field if it's non-nil, zero value otherwise.
+func (w *WebAuthnPolicy) GetUserVerificationRequirement() string {
+	if w == nil || w.UserVerificationRequirement == nil {
+		return ""
+	}
+	return *w.UserVerificationRequirement
+}
@@ -0,0 +1,40 @@
+package keycloak
+
+import (
+	"testing"
+)
+
+func TestAccessors(t *testing.T) {
+	var nilUser *User
+	if got := nilUser.GetUsername(); got != "" {
+		t.Errorf("got: %q, want: %q", got, "")
+	}
+
+	user := &User{}
+	if got := user.GetEnabled(); got {
+		t.Errorf("got: %t, want: %t", got, false)
+	}
+	if got := user.GetAttributes(); got != nil {
+		t.Errorf("got: %v, want: nil", got)
+	}
+
+	user = &User{
+		Username:   String("john"),
+		Enabled:    Bool(true),
+		Attributes: &map[string][]string{"department": {"sales"}},
+	}
+	if got := user.GetUsername(); got != "john" {
+		t.Errorf("got: %q, want: %q", got, "john")
+	}
+	if got := user.GetEnabled(); !got {
+		t.Errorf("got: %t, want: %t", got, true)
+	}
+	if got := user.GetAttributes()["department"]; len(got) != 1 || got[0] != "sales" {
+		t.Errorf("got: %v, want: [sales]", got)
+	}
+
+	var nilRole *Role
+	if got := nilRole.GetComposites(); got != nil {
+		t.Errorf("got: %v, want: nil", got)
+	}
+}
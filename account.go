@@ -0,0 +1,376 @@
+package keycloak
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// AccountService handles communication with the account REST API of a
+// realm, which backs the account console.
+//
+// Unlike the admin API the account API works on the user the token was
+// issued to, so the Keycloak client must be authenticated with a token of
+// the end user that has the manage-account role of the account client, e.g.
+// one obtained with the password grant:
+//
+//	conf := &keycloak.TokenConfig{
+//		BaseURL:  "http://localhost:8080",
+//		Realm:    "myrealm",
+//		ClientID: "my-portal",
+//		Username: "user",
+//		Password: "password",
+//	}
+//	k, err := keycloak.NewKeycloak(conf.Client(ctx), "http://localhost:8080/")
+//	user, _, err := k.Account.GetProfile(ctx, "myrealm")
+//
+// Requests ask for JSON, since the account endpoints otherwise serve the
+// account console.
+//
+// https://github.com/keycloak/keycloak/blob/main/services/src/main/java/org/keycloak/services/resources/account/AccountRestService.java
+type AccountService service
+
+// AccountSession is a session of the user as shown in the account console.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/account/SessionRepresentation.java
+type AccountSession struct {
+	ID         *string                 `json:"id,omitempty"`
+	IPAddress  *string                 `json:"ipAddress,omitempty"`
+	Started    *int64                  `json:"started,omitempty"`
+	LastAccess *int64                  `json:"lastAccess,omitempty"`
+	Expires    *int64                  `json:"expires,omitempty"`
+	Clients    []*AccountSessionClient `json:"clients,omitempty"`
+	Browser    *string                 `json:"browser,omitempty"`
+	Current    *bool                   `json:"current,omitempty"`
+}
+
+// AccountSessionClient is a client the user is logged in to in a session.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/account/ClientRepresentation.java
+type AccountSessionClient struct {
+	ClientID            *string `json:"clientId,omitempty"`
+	ClientName          *string `json:"clientName,omitempty"`
+	UserConsentRequired *bool   `json:"userConsentRequired,omitempty"`
+	InUse               *bool   `json:"inUse,omitempty"`
+	OfflineAccess       *bool   `json:"offlineAccess,omitempty"`
+}
+
+// AccountDevice is a device the user is logged in from, with the sessions
+// started on it.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/account/DeviceRepresentation.java
+type AccountDevice struct {
+	ID         *string           `json:"id,omitempty"`
+	IPAddress  *string           `json:"ipAddress,omitempty"`
+	OS         *string           `json:"os,omitempty"`
+	OSVersion  *string           `json:"osVersion,omitempty"`
+	Browser    *string           `json:"browser,omitempty"`
+	Device     *string           `json:"device,omitempty"`
+	LastAccess *int64            `json:"lastAccess,omitempty"`
+	Current    *bool             `json:"current,omitempty"`
+	Mobile     *bool             `json:"mobile,omitempty"`
+	Sessions   []*AccountSession `json:"sessions,omitempty"`
+}
+
+// AccountApplication is a client the user has access to, is logged in to or
+// granted consent to.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/account/ClientRepresentation.java
+type AccountApplication struct {
+	ClientID            *string         `json:"clientId,omitempty"`
+	ClientName          *string         `json:"clientName,omitempty"`
+	Description         *string         `json:"description,omitempty"`
+	UserConsentRequired *bool           `json:"userConsentRequired,omitempty"`
+	InUse               *bool           `json:"inUse,omitempty"`
+	OfflineAccess       *bool           `json:"offlineAccess,omitempty"`
+	RootURL             *string         `json:"rootUrl,omitempty"`
+	BaseURL             *string         `json:"baseUrl,omitempty"`
+	EffectiveURL        *string         `json:"effectiveUrl,omitempty"`
+	Consent             *AccountConsent `json:"consent,omitempty"`
+	LogoURI             *string         `json:"logoUri,omitempty"`
+	PolicyURI           *string         `json:"policyUri,omitempty"`
+	TosURI              *string         `json:"tosUri,omitempty"`
+}
+
+// AccountConsent is the consent the user granted to an application.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/account/ConsentRepresentation.java
+type AccountConsent struct {
+	GrantedScopes   []*AccountConsentScope `json:"grantedScopes,omitempty"`
+	CreatedDate     *int64                 `json:"createdDate,omitempty"`
+	LastUpdatedDate *int64                 `json:"lastUpdatedDate,omitempty"`
+}
+
+// AccountConsentScope is a client scope the user consented to.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/account/ConsentScopeRepresentation.java
+type AccountConsentScope struct {
+	ID          *string `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	DisplayText *string `json:"displayTest,omitempty"`
+}
+
+// AccountCredentials are the credentials of the user of one type, e.g.
+// "otp", together with how the user can set them up.
+//
+// https://github.com/keycloak/keycloak/blob/main/services/src/main/java/org/keycloak/services/resources/account/AccountCredentialResource.java
+type AccountCredentials struct {
+	Type         *string `json:"type,omitempty"`
+	Category     *string `json:"category,omitempty"`
+	DisplayName  *string `json:"displayName,omitempty"`
+	HelpText     *string `json:"helptext,omitempty"`
+	IconCSSClass *string `json:"iconCssClass,omitempty"`
+
+	// CreateAction and UpdateAction are the required actions the user is
+	// sent through to add or change a credential of the type.
+	CreateAction *string `json:"createAction,omitempty"`
+	UpdateAction *string `json:"updateAction,omitempty"`
+
+	Removeable              *bool                        `json:"removeable,omitempty"`
+	UserCredentialMetadatas []*AccountCredentialMetadata `json:"userCredentialMetadatas,omitempty"`
+}
+
+// AccountCredentialMetadata is a credential of the user. The secret data of
+// the credential is never returned.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/account/CredentialMetadataRepresentation.java
+type AccountCredentialMetadata struct {
+	Credential *Credential `json:"credential,omitempty"`
+}
+
+// LinkedAccount is an identity provider the user can log in with, linked or
+// not.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/account/LinkedAccountRepresentation.java
+type LinkedAccount struct {
+	Connected      *bool   `json:"connected,omitempty"`
+	Social         *bool   `json:"social,omitempty"`
+	ProviderAlias  *string `json:"providerAlias,omitempty"`
+	ProviderName   *string `json:"providerName,omitempty"`
+	DisplayName    *string `json:"displayName,omitempty"`
+	LinkedUsername *string `json:"linkedUsername,omitempty"`
+	GUIOrder       *string `json:"guiOrder,omitempty"`
+}
+
+// AccountCredentialListOptions specifies the optional parameters to the AccountService.ListCredentials method.
+type AccountCredentialListOptions struct {
+	// Type only lists credentials of the type, e.g. "otp".
+	Type string `url:"type,omitempty"`
+
+	// UserCredentials only lists types the user has credentials of.
+	UserCredentials *bool `url:"user-credentials,omitempty"`
+}
+
+// GetProfile gets the user.
+func (s *AccountService) GetProfile(ctx context.Context, realm string) (*User, *Response, error) {
+	u := pathf("realms/%s/account", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	var user User
+	res, err := s.keycloak.Do(ctx, req, &user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &user, res, nil
+}
+
+// UpdateProfile updates the user. Only the fields the user profile lets the
+// user edit are changed, e.g. the name, email and custom attributes.
+func (s *AccountService) UpdateProfile(ctx context.Context, realm string, user *User) (*Response, error) {
+	u := pathf("realms/%s/account", realm)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, user)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListSessions lists the sessions of the user.
+func (s *AccountService) ListSessions(ctx context.Context, realm string) ([]*AccountSession, *Response, error) {
+	u := pathf("realms/%s/account/sessions", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	var sessions []*AccountSession
+	res, err := s.keycloak.Do(ctx, req, &sessions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sessions, res, nil
+}
+
+// ListDevices lists the devices the user is logged in from.
+func (s *AccountService) ListDevices(ctx context.Context, realm string) ([]*AccountDevice, *Response, error) {
+	u := pathf("realms/%s/account/sessions/devices", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	var devices []*AccountDevice
+	res, err := s.keycloak.Do(ctx, req, &devices)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return devices, res, nil
+}
+
+// DeleteSession logs the user out of a session.
+func (s *AccountService) DeleteSession(ctx context.Context, realm, sessionID string) (*Response, error) {
+	u := pathf("realms/%s/account/sessions/%s", realm, sessionID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// DeleteSessions logs the user out of all other sessions and, if current is
+// true, the session of the token as well.
+func (s *AccountService) DeleteSessions(ctx context.Context, realm string, current bool) (*Response, error) {
+	u := pathf("realms/%s/account/sessions", realm)
+	if current {
+		u += "?current=true"
+	}
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListApplications lists the applications of the user.
+func (s *AccountService) ListApplications(ctx context.Context, realm string) ([]*AccountApplication, *Response, error) {
+	u := pathf("realms/%s/account/applications", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	var applications []*AccountApplication
+	res, err := s.keycloak.Do(ctx, req, &applications)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return applications, res, nil
+}
+
+// RevokeConsent revokes the consent and offline tokens the user granted to
+// the application.
+func (s *AccountService) RevokeConsent(ctx context.Context, realm, clientID string) (*Response, error) {
+	u := pathf("realms/%s/account/applications/%s/consent", realm, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListCredentials lists the credentials of the user grouped by type.
+func (s *AccountService) ListCredentials(ctx context.Context, realm string, opts *AccountCredentialListOptions) ([]*AccountCredentials, *Response, error) {
+	u := pathf("realms/%s/account/credentials", realm)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	var credentials []*AccountCredentials
+	res, err := s.keycloak.Do(ctx, req, &credentials)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return credentials, res, nil
+}
+
+// DeleteCredential removes a credential of the user.
+func (s *AccountService) DeleteCredential(ctx context.Context, realm, credentialID string) (*Response, error) {
+	u := pathf("realms/%s/account/credentials/%s", realm, credentialID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// SetCredentialLabel changes the label of a credential of the user.
+func (s *AccountService) SetCredentialLabel(ctx context.Context, realm, credentialID, label string) (*Response, error) {
+	u := pathf("realms/%s/account/credentials/%s/label", realm, credentialID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	// the endpoint only accepts the label as plain text
+	req.ContentLength = int64(len(label))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(label)), nil
+	}
+	req.Body, _ = req.GetBody()
+	req.Header.Set("Content-Type", "text/plain")
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListLinkedAccounts lists the identity providers of the realm and whether
+// the user is linked to them.
+func (s *AccountService) ListLinkedAccounts(ctx context.Context, realm string) ([]*LinkedAccount, *Response, error) {
+	u := pathf("realms/%s/account/linked-accounts", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	var accounts []*LinkedAccount
+	res, err := s.keycloak.Do(ctx, req, &accounts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accounts, res, nil
+}
+
+// UnlinkAccount removes the link of the user to an identity provider. It
+// fails if the user has no other way to log in.
+func (s *AccountService) UnlinkAccount(ctx context.Context, realm, providerAlias string) (*Response, error) {
+	u := pathf("realms/%s/account/linked-accounts/%s", realm, providerAlias)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	return s.keycloak.Do(ctx, req, nil)
+}
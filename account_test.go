@@ -0,0 +1,109 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAccountService(t *testing.T) {
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+
+		if got := r.Header.Get("Accept"); got != "application/json" {
+			t.Errorf("got: %s, want: %s", got, "application/json")
+		}
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /realms/first/account":
+			json.NewEncoder(w).Encode(&User{Username: String("user")})
+		case "GET /realms/first/account/sessions/devices":
+			json.NewEncoder(w).Encode([]*AccountDevice{{
+				OS:       String("Linux"),
+				Sessions: []*AccountSession{{ID: String("session")}},
+			}})
+		case "GET /realms/first/account/credentials":
+			json.NewEncoder(w).Encode([]*AccountCredentials{{
+				Type: String("otp"),
+				UserCredentialMetadatas: []*AccountCredentialMetadata{{
+					Credential: &Credential{ID: String("credential")},
+				}},
+			}})
+		case "PUT /realms/first/account/credentials/credential/label":
+			b, _ := io.ReadAll(r.Body)
+			if string(b) != "phone" {
+				t.Errorf("got: %s, want: %s", b, "phone")
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case "DELETE /realms/first/account/sessions":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	user, _, err := k.Account.GetProfile(ctx, "first")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.GetUsername() != "user" {
+		t.Errorf("got: %s, want: %s", user.GetUsername(), "user")
+	}
+
+	devices, _, err := k.Account.ListDevices(ctx, "first")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(devices) != 1 || len(devices[0].Sessions) != 1 {
+		t.Fatalf("got: %d devices, want: 1 device with 1 session", len(devices))
+	}
+	if devices[0].Sessions[0].GetID() != "session" {
+		t.Errorf("got: %s, want: %s", devices[0].Sessions[0].GetID(), "session")
+	}
+
+	credentials, _, err := k.Account.ListCredentials(ctx, "first", &AccountCredentialListOptions{Type: "otp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(credentials) != 1 || len(credentials[0].UserCredentialMetadatas) != 1 {
+		t.Fatalf("got: %d credential types, want: 1 with 1 credential", len(credentials))
+	}
+
+	id := credentials[0].UserCredentialMetadatas[0].Credential.GetID()
+	if _, err := k.Account.SetCredentialLabel(ctx, "first", id, "phone"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := k.Account.DeleteSessions(ctx, "first", true); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"GET /realms/first/account",
+		"GET /realms/first/account/sessions/devices",
+		"GET /realms/first/account/credentials?type=otp",
+		"PUT /realms/first/account/credentials/credential/label",
+		"DELETE /realms/first/account/sessions?current=true",
+	}
+	if len(requests) != len(want) {
+		t.Fatalf("got: %v, want: %v", requests, want)
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Errorf("got: %s, want: %s", requests[i], want[i])
+		}
+	}
+}
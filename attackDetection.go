@@ -0,0 +1,56 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+)
+
+// AttackDetectionService handles communication with the brute force detection related methods of the Keycloak API.
+type AttackDetectionService service
+
+// BruteForceStatus describes whether a user is temporarily locked by brute force protection.
+type BruteForceStatus struct {
+	NumFailures   *int    `json:"numFailures,omitempty"`
+	Disabled      *bool   `json:"disabled,omitempty"`
+	LastIPFailure *string `json:"lastIPFailure,omitempty"`
+	LastFailure   *int64  `json:"lastFailure,omitempty"`
+}
+
+// GetBruteForceStatus gets the brute force status of the user.
+func (s *AttackDetectionService) GetBruteForceStatus(ctx context.Context, realm, userID string) (*BruteForceStatus, *Response, error) {
+	u := pathf("admin/realms/%s/attack-detection/brute-force/users/%s", realm, userID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var status BruteForceStatus
+	res, err := s.keycloak.Do(ctx, req, &status)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &status, res, nil
+}
+
+// ClearBruteForceForUser clears any login failures of the user and unlocks the user.
+func (s *AttackDetectionService) ClearBruteForceForUser(ctx context.Context, realm, userID string) (*Response, error) {
+	u := pathf("admin/realms/%s/attack-detection/brute-force/users/%s", realm, userID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ClearAllBruteForce clears any login failures of all users and unlocks temporarily locked users.
+func (s *AttackDetectionService) ClearAllBruteForce(ctx context.Context, realm string) (*Response, error) {
+	u := pathf("admin/realms/%s/attack-detection/brute-force/users", realm)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
@@ -0,0 +1,78 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestAttackDetectionService_GetBruteForceStatus(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	ctx := context.Background()
+
+	if _, err := k.Realms.Update(ctx, realm, &Realm{BruteForceProtected: Bool(true)}); err != nil {
+		t.Errorf("Realms.Update returned error: %v", err)
+	}
+
+	userID := createUser(t, k, realm, "user")
+
+	// fail to log in
+	config := oauth2.Config{
+		ClientID: "admin-cli",
+		Endpoint: oauth2.Endpoint{
+			TokenURL: fmt.Sprintf("http://localhost:8080/realms/%s/protocol/openid-connect/token", realm),
+		},
+	}
+	if _, err := config.PasswordCredentialsToken(ctx, "user", "wrong"); err == nil {
+		t.Errorf("got no error, want one")
+	}
+
+	status, res, err := k.AttackDetection.GetBruteForceStatus(ctx, realm, userID)
+	if err != nil {
+		t.Errorf("AttackDetection.GetBruteForceStatus returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if *status.NumFailures != 1 {
+		t.Errorf("got: %d, want: %d", *status.NumFailures, 1)
+	}
+
+	if _, err := k.AttackDetection.ClearBruteForceForUser(ctx, realm, userID); err != nil {
+		t.Errorf("AttackDetection.ClearBruteForceForUser returned error: %v", err)
+	}
+
+	status, _, err = k.AttackDetection.GetBruteForceStatus(ctx, realm, userID)
+	if err != nil {
+		t.Errorf("AttackDetection.GetBruteForceStatus returned error: %v", err)
+	}
+
+	if *status.NumFailures != 0 {
+		t.Errorf("got: %d, want: %d", *status.NumFailures, 0)
+	}
+}
+
+func TestAttackDetectionService_ClearAllBruteForce(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	res, err := k.AttackDetection.ClearAllBruteForce(context.Background(), realm)
+	if err != nil {
+		t.Errorf("AttackDetection.ClearAllBruteForce returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
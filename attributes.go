@@ -0,0 +1,157 @@
+package keycloak
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ErrAttributeNotSet is returned by the typed attribute getters if the
+// attribute has no value.
+var ErrAttributeNotSet = errors.New("keycloak: attribute not set")
+
+// Attributes are custom attributes, each with a list of values, as stored in
+// User.Attributes. Most attributes have a single value, which the Get and
+// Set methods work on.
+type Attributes map[string][]string
+
+// Get returns the first value of the attribute or "" if it has none.
+func (a Attributes) Get(name string) string {
+	if len(a[name]) == 0 {
+		return ""
+	}
+	return a[name][0]
+}
+
+// Values returns all values of the attribute.
+func (a Attributes) Values(name string) []string {
+	return a[name]
+}
+
+// Has reports whether the attribute has a value.
+func (a Attributes) Has(name string) bool {
+	return len(a[name]) > 0
+}
+
+// Set replaces the values of the attribute with value.
+func (a Attributes) Set(name, value string) {
+	a[name] = []string{value}
+}
+
+// SetValues replaces the values of the attribute.
+func (a Attributes) SetValues(name string, values ...string) {
+	a[name] = values
+}
+
+// Delete removes the attribute.
+func (a Attributes) Delete(name string) {
+	delete(a, name)
+}
+
+// GetInt parses the first value of the attribute as an integer.
+func (a Attributes) GetInt(name string) (int, error) {
+	value, err := a.value(name)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("keycloak: attribute %q: %w", name, err)
+	}
+	return n, nil
+}
+
+// SetInt sets the attribute to n.
+func (a Attributes) SetInt(name string, n int) {
+	a.Set(name, strconv.Itoa(n))
+}
+
+// GetBool parses the first value of the attribute as a boolean, e.g.
+// "true" or "false".
+func (a Attributes) GetBool(name string) (bool, error) {
+	value, err := a.value(name)
+	if err != nil {
+		return false, err
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("keycloak: attribute %q: %w", name, err)
+	}
+	return b, nil
+}
+
+// SetBool sets the attribute to "true" or "false".
+func (a Attributes) SetBool(name string, b bool) {
+	a.Set(name, strconv.FormatBool(b))
+}
+
+// GetTime parses the first value of the attribute as an RFC 3339 time.
+func (a Attributes) GetTime(name string) (time.Time, error) {
+	value, err := a.value(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("keycloak: attribute %q: %w", name, err)
+	}
+	return t, nil
+}
+
+// SetTime sets the attribute to t formatted as RFC 3339.
+func (a Attributes) SetTime(name string, t time.Time) {
+	a.Set(name, t.Format(time.RFC3339))
+}
+
+func (a Attributes) value(name string) (string, error) {
+	if !a.Has(name) {
+		return "", fmt.Errorf("keycloak: attribute %q: %w", name, ErrAttributeNotSet)
+	}
+	return a.Get(name), nil
+}
+
+// Attrs returns the attributes of the user. Changes to the returned
+// attributes change the user.
+func (u *User) Attrs() Attributes {
+	if u.Attributes == nil {
+		u.Attributes = &map[string][]string{}
+	}
+	return *u.Attributes
+}
+
+// GetAttributeString returns the first value of the attribute or "" if it
+// has none.
+func (u *User) GetAttributeString(name string) string {
+	return Attributes(u.GetAttributes()).Get(name)
+}
+
+// GetAttributeInt parses the first value of the attribute as an integer.
+// It returns an error matching ErrAttributeNotSet if there is none.
+func (u *User) GetAttributeInt(name string) (int, error) {
+	return Attributes(u.GetAttributes()).GetInt(name)
+}
+
+// GetAttributeBool parses the first value of the attribute as a boolean.
+// It returns an error matching ErrAttributeNotSet if there is none.
+func (u *User) GetAttributeBool(name string) (bool, error) {
+	return Attributes(u.GetAttributes()).GetBool(name)
+}
+
+// GetAttributeTime parses the first value of the attribute as an RFC 3339
+// time. It returns an error matching ErrAttributeNotSet if there is none.
+func (u *User) GetAttributeTime(name string) (time.Time, error) {
+	return Attributes(u.GetAttributes()).GetTime(name)
+}
+
+// SetAttribute sets the attribute to the single value.
+func (u *User) SetAttribute(name, value string) {
+	u.Attrs().Set(name, value)
+}
+
+// DeleteAttribute removes the attribute.
+func (u *User) DeleteAttribute(name string) {
+	if u.Attributes != nil {
+		delete(*u.Attributes, name)
+	}
+}
@@ -0,0 +1,59 @@
+package keycloak
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAttributes(t *testing.T) {
+	user := NewUser().WithAttribute("phone", "+1", "+2")
+
+	attrs := user.Attrs()
+	attrs.SetInt("level", 3)
+	attrs.SetBool("vip", true)
+	attrs.SetTime("since", time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC))
+	user.SetAttribute("department", "sales")
+
+	want := map[string][]string{
+		"phone":      {"+1", "+2"},
+		"level":      {"3"},
+		"vip":        {"true"},
+		"since":      {"2021-01-02T03:04:05Z"},
+		"department": {"sales"},
+	}
+	if !reflect.DeepEqual(user.GetAttributes(), want) {
+		t.Errorf("got: %v, want: %v", user.GetAttributes(), want)
+	}
+
+	if got := user.GetAttributeString("phone"); got != "+1" {
+		t.Errorf("got: %s, want: %s", got, "+1")
+	}
+	if got, err := user.GetAttributeInt("level"); err != nil || got != 3 {
+		t.Errorf("got: %d %v, want: 3", got, err)
+	}
+	if got, err := user.GetAttributeBool("vip"); err != nil || !got {
+		t.Errorf("got: %t %v, want: true", got, err)
+	}
+	if got, err := user.GetAttributeTime("since"); err != nil || got.Year() != 2021 {
+		t.Errorf("got: %v %v, want: 2021", got, err)
+	}
+
+	if _, err := user.GetAttributeInt("department"); err == nil {
+		t.Errorf("got: nil, want: error")
+	}
+	if _, err := user.GetAttributeBool("missing"); !errors.Is(err, ErrAttributeNotSet) {
+		t.Errorf("got: %v, want: %v", err, ErrAttributeNotSet)
+	}
+
+	user.DeleteAttribute("phone")
+	if user.Attrs().Has("phone") {
+		t.Errorf("got: %v, want: phone deleted", user.GetAttributes())
+	}
+
+	// getters work without attributes
+	if got := NewUser().GetAttributeString("phone"); got != "" {
+		t.Errorf("got: %s, want: empty", got)
+	}
+}
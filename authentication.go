@@ -0,0 +1,409 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+)
+
+// AuthenticationService handles communication with the authentication management related methods of the Keycloak API.
+type AuthenticationService service
+
+// AuthenticationFlow representation.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/AuthenticationFlowRepresentation.java
+type AuthenticationFlow struct {
+	ID                       *string                    `json:"id,omitempty"`
+	Alias                    *string                    `json:"alias,omitempty"`
+	Description              *string                    `json:"description,omitempty"`
+	ProviderID               *string                    `json:"providerId,omitempty"`
+	TopLevel                 *bool                      `json:"topLevel,omitempty"`
+	BuiltIn                  *bool                      `json:"builtIn,omitempty"`
+	AuthenticationExecutions []*AuthenticationExecution `json:"authenticationExecutions,omitempty"`
+}
+
+// AuthenticationExecution is an execution as part of an exported authentication flow.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/AuthenticationExecutionExportRepresentation.java
+type AuthenticationExecution struct {
+	Authenticator       *string `json:"authenticator,omitempty"`
+	AuthenticatorConfig *string `json:"authenticatorConfig,omitempty"`
+	AuthenticatorFlow   *bool   `json:"authenticatorFlow,omitempty"`
+	FlowAlias           *string `json:"flowAlias,omitempty"`
+	Requirement         *string `json:"requirement,omitempty"`
+	Priority            *int    `json:"priority,omitempty"`
+	UserSetupAllowed    *bool   `json:"userSetupAllowed,omitempty"`
+}
+
+// AuthenticationExecutionInfo describes an execution of a flow as listed by
+// AuthenticationService.ListExecutions. Sub-flows are flattened into the
+// list, Level and Index give their position.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/AuthenticationExecutionInfoRepresentation.java
+type AuthenticationExecutionInfo struct {
+	ID                   *string  `json:"id,omitempty"`
+	Requirement          *string  `json:"requirement,omitempty"`
+	DisplayName          *string  `json:"displayName,omitempty"`
+	Alias                *string  `json:"alias,omitempty"`
+	Description          *string  `json:"description,omitempty"`
+	RequirementChoices   []string `json:"requirementChoices,omitempty"`
+	Configurable         *bool    `json:"configurable,omitempty"`
+	AuthenticationFlow   *bool    `json:"authenticationFlow,omitempty"`
+	ProviderID           *string  `json:"providerId,omitempty"`
+	AuthenticationConfig *string  `json:"authenticationConfig,omitempty"`
+	FlowID               *string  `json:"flowId,omitempty"`
+	Level                *int     `json:"level,omitempty"`
+	Index                *int     `json:"index,omitempty"`
+}
+
+// AuthenticatorConfig holds the configuration of an execution.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/AuthenticatorConfigRepresentation.java
+type AuthenticatorConfig struct {
+	ID     *string            `json:"id,omitempty"`
+	Alias  *string            `json:"alias,omitempty"`
+	Config *map[string]string `json:"config,omitempty"`
+}
+
+// AuthenticationSubFlow describes a sub-flow to be added to a flow.
+type AuthenticationSubFlow struct {
+	Alias       string `json:"alias"`
+	Type        string `json:"type"`
+	Provider    string `json:"provider"`
+	Description string `json:"description,omitempty"`
+}
+
+// RequiredAction representation.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/RequiredActionProviderRepresentation.java
+type RequiredAction struct {
+	Alias         *string            `json:"alias,omitempty"`
+	Name          *string            `json:"name,omitempty"`
+	ProviderID    *string            `json:"providerId,omitempty"`
+	Enabled       *bool              `json:"enabled,omitempty"`
+	DefaultAction *bool              `json:"defaultAction,omitempty"`
+	Priority      *int               `json:"priority,omitempty"`
+	Config        *map[string]string `json:"config,omitempty"`
+}
+
+// UnregisteredRequiredAction is a required action provider that is not yet registered in the realm.
+type UnregisteredRequiredAction struct {
+	ProviderID string `json:"providerId"`
+	Name       string `json:"name"`
+}
+
+// ListFlows lists the authentication flows of the realm.
+func (s *AuthenticationService) ListFlows(ctx context.Context, realm string) ([]*AuthenticationFlow, *Response, error) {
+	u := pathf("admin/realms/%s/authentication/flows", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var flows []*AuthenticationFlow
+	res, err := s.keycloak.Do(ctx, req, &flows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return flows, res, nil
+}
+
+// CreateFlow creates a new top level authentication flow.
+func (s *AuthenticationService) CreateFlow(ctx context.Context, realm string, flow *AuthenticationFlow) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/flows", realm)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, flow)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// GetFlow gets an authentication flow by its id.
+func (s *AuthenticationService) GetFlow(ctx context.Context, realm, flowID string) (*AuthenticationFlow, *Response, error) {
+	u := pathf("admin/realms/%s/authentication/flows/%s", realm, flowID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var flow AuthenticationFlow
+	res, err := s.keycloak.Do(ctx, req, &flow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &flow, res, nil
+}
+
+// UpdateFlow updates an authentication flow.
+func (s *AuthenticationService) UpdateFlow(ctx context.Context, realm string, flow *AuthenticationFlow) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/flows/%s", realm, *flow.ID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, flow)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// DeleteFlow deletes an authentication flow. Built-in flows cannot be deleted.
+func (s *AuthenticationService) DeleteFlow(ctx context.Context, realm, flowID string) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/flows/%s", realm, flowID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// CopyFlow copies the flow with the given alias, including its executions, to a new flow named newName.
+func (s *AuthenticationService) CopyFlow(ctx context.Context, realm, flowAlias, newName string) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/flows/%s/copy", realm, flowAlias)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, map[string]string{"newName": newName})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListExecutions lists the executions of the flow with the given alias, including those of its sub-flows.
+func (s *AuthenticationService) ListExecutions(ctx context.Context, realm, flowAlias string) ([]*AuthenticationExecutionInfo, *Response, error) {
+	u := pathf("admin/realms/%s/authentication/flows/%s/executions", realm, flowAlias)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var executions []*AuthenticationExecutionInfo
+	res, err := s.keycloak.Do(ctx, req, &executions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return executions, res, nil
+}
+
+// UpdateExecution updates an execution of the flow with the given alias, e.g. its requirement.
+func (s *AuthenticationService) UpdateExecution(ctx context.Context, realm, flowAlias string, execution *AuthenticationExecutionInfo) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/flows/%s/executions", realm, flowAlias)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, execution)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// AddExecution adds an execution of the authenticator provider to the flow with the given alias.
+func (s *AuthenticationService) AddExecution(ctx context.Context, realm, flowAlias, provider string) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/flows/%s/executions/execution", realm, flowAlias)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, map[string]string{"provider": provider})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// AddSubFlow adds a sub-flow to the flow with the given alias.
+func (s *AuthenticationService) AddSubFlow(ctx context.Context, realm, flowAlias string, flow *AuthenticationSubFlow) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/flows/%s/executions/flow", realm, flowAlias)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, flow)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// DeleteExecution removes an execution from its flow.
+func (s *AuthenticationService) DeleteExecution(ctx context.Context, realm, executionID string) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/executions/%s", realm, executionID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// RaiseExecutionPriority moves an execution one position up in its flow.
+func (s *AuthenticationService) RaiseExecutionPriority(ctx context.Context, realm, executionID string) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/executions/%s/raise-priority", realm, executionID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// LowerExecutionPriority moves an execution one position down in its flow.
+func (s *AuthenticationService) LowerExecutionPriority(ctx context.Context, realm, executionID string) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/executions/%s/lower-priority", realm, executionID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// CreateExecutionConfig creates the configuration of an execution.
+func (s *AuthenticationService) CreateExecutionConfig(ctx context.Context, realm, executionID string, config *AuthenticatorConfig) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/executions/%s/config", realm, executionID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// GetConfig gets an authenticator configuration by its id.
+func (s *AuthenticationService) GetConfig(ctx context.Context, realm, configID string) (*AuthenticatorConfig, *Response, error) {
+	u := pathf("admin/realms/%s/authentication/config/%s", realm, configID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var config AuthenticatorConfig
+	res, err := s.keycloak.Do(ctx, req, &config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &config, res, nil
+}
+
+// UpdateConfig updates an authenticator configuration.
+func (s *AuthenticationService) UpdateConfig(ctx context.Context, realm string, config *AuthenticatorConfig) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/config/%s", realm, *config.ID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// DeleteConfig deletes an authenticator configuration.
+func (s *AuthenticationService) DeleteConfig(ctx context.Context, realm, configID string) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/config/%s", realm, configID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// GetRequiredActions lists the required actions registered in the realm.
+func (s *AuthenticationService) GetRequiredActions(ctx context.Context, realm string) ([]*RequiredAction, *Response, error) {
+	u := pathf("admin/realms/%s/authentication/required-actions", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var actions []*RequiredAction
+	res, err := s.keycloak.Do(ctx, req, &actions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return actions, res, nil
+}
+
+// GetRequiredAction gets a required action by its alias.
+func (s *AuthenticationService) GetRequiredAction(ctx context.Context, realm, alias string) (*RequiredAction, *Response, error) {
+	u := pathf("admin/realms/%s/authentication/required-actions/%s", realm, alias)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var action RequiredAction
+	res, err := s.keycloak.Do(ctx, req, &action)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &action, res, nil
+}
+
+// ListUnregisteredRequiredActions lists the required action providers that can be registered in the realm.
+func (s *AuthenticationService) ListUnregisteredRequiredActions(ctx context.Context, realm string) ([]*UnregisteredRequiredAction, *Response, error) {
+	u := pathf("admin/realms/%s/authentication/unregistered-required-actions", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var actions []*UnregisteredRequiredAction
+	res, err := s.keycloak.Do(ctx, req, &actions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return actions, res, nil
+}
+
+// RegisterRequiredAction registers a required action provider in the realm.
+func (s *AuthenticationService) RegisterRequiredAction(ctx context.Context, realm string, action *UnregisteredRequiredAction) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/register-required-action", realm)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// UpdateRequiredAction updates a required action, e.g. to enable it or make it a default action.
+func (s *AuthenticationService) UpdateRequiredAction(ctx context.Context, realm string, action *RequiredAction) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/required-actions/%s", realm, *action.Alias)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// DeleteRequiredAction unregisters a required action.
+func (s *AuthenticationService) DeleteRequiredAction(ctx context.Context, realm, alias string) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/required-actions/%s", realm, alias)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// RaiseRequiredActionPriority moves a required action one position up.
+func (s *AuthenticationService) RaiseRequiredActionPriority(ctx context.Context, realm, alias string) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/required-actions/%s/raise-priority", realm, alias)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// LowerRequiredActionPriority moves a required action one position down.
+func (s *AuthenticationService) LowerRequiredActionPriority(ctx context.Context, realm, alias string) (*Response, error) {
+	u := pathf("admin/realms/%s/authentication/required-actions/%s/lower-priority", realm, alias)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
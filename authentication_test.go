@@ -0,0 +1,262 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// copy the built-in browser flow to a new flow.
+func copyBrowserFlow(t *testing.T, k *Keycloak, realm, name string) {
+	t.Helper()
+
+	if _, err := k.Authentication.CopyFlow(context.Background(), realm, "browser", name); err != nil {
+		t.Errorf("Authentication.CopyFlow returned error: %v", err)
+	}
+}
+
+func TestAuthenticationService_CreateFlow(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	flow := &AuthenticationFlow{
+		Alias:      String("custom"),
+		ProviderID: String("basic-flow"),
+		TopLevel:   Bool(true),
+		BuiltIn:    Bool(false),
+	}
+
+	ctx := context.Background()
+
+	res, err := k.Authentication.CreateFlow(ctx, realm, flow)
+	if err != nil {
+		t.Errorf("Authentication.CreateFlow returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+
+	flows, _, err := k.Authentication.ListFlows(ctx, realm)
+	if err != nil {
+		t.Errorf("Authentication.ListFlows returned error: %v", err)
+	}
+
+	var id string
+	for _, f := range flows {
+		if *f.Alias == "custom" {
+			id = *f.ID
+		}
+	}
+	if id == "" {
+		t.Fatalf("flow %q not found", "custom")
+	}
+
+	res, err = k.Authentication.DeleteFlow(ctx, realm, id)
+	if err != nil {
+		t.Errorf("Authentication.DeleteFlow returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestAuthenticationService_ListExecutions(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	copyBrowserFlow(t, k, realm, "custom browser")
+
+	executions, res, err := k.Authentication.ListExecutions(context.Background(), realm, "custom browser")
+	if err != nil {
+		t.Errorf("Authentication.ListExecutions returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(executions) == 0 {
+		t.Errorf("got no executions, want some")
+	}
+}
+
+func TestAuthenticationService_UpdateExecution(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	copyBrowserFlow(t, k, realm, "custom browser")
+
+	ctx := context.Background()
+
+	executions, _, err := k.Authentication.ListExecutions(ctx, realm, "custom browser")
+	if err != nil {
+		t.Errorf("Authentication.ListExecutions returned error: %v", err)
+	}
+
+	execution := executions[0]
+	execution.Requirement = String("DISABLED")
+
+	res, err := k.Authentication.UpdateExecution(ctx, realm, "custom browser", execution)
+	if err != nil {
+		t.Errorf("Authentication.UpdateExecution returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusAccepted && res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestAuthenticationService_AddExecution(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	copyBrowserFlow(t, k, realm, "custom browser")
+
+	ctx := context.Background()
+
+	res, err := k.Authentication.AddExecution(ctx, realm, "custom browser", "auth-username-password-form")
+	if err != nil {
+		t.Errorf("Authentication.AddExecution returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+
+	executions, _, err := k.Authentication.ListExecutions(ctx, realm, "custom browser")
+	if err != nil {
+		t.Errorf("Authentication.ListExecutions returned error: %v", err)
+	}
+
+	// the new execution is appended to the top level of the flow
+	last := executions[len(executions)-1]
+	for _, e := range executions {
+		if *e.Level == 0 {
+			last = e
+		}
+	}
+
+	res, err = k.Authentication.RaiseExecutionPriority(ctx, realm, *last.ID)
+	if err != nil {
+		t.Errorf("Authentication.RaiseExecutionPriority returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	res, err = k.Authentication.DeleteExecution(ctx, realm, *last.ID)
+	if err != nil {
+		t.Errorf("Authentication.DeleteExecution returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestAuthenticationService_AddSubFlow(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	copyBrowserFlow(t, k, realm, "custom browser")
+
+	flow := &AuthenticationSubFlow{
+		Alias:    "custom sub-flow",
+		Type:     "basic-flow",
+		Provider: "registration-page-form",
+	}
+
+	res, err := k.Authentication.AddSubFlow(context.Background(), realm, "custom browser", flow)
+	if err != nil {
+		t.Errorf("Authentication.AddSubFlow returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+}
+
+func TestAuthenticationService_UpdateRequiredAction(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	ctx := context.Background()
+
+	action, _, err := k.Authentication.GetRequiredAction(ctx, realm, "CONFIGURE_TOTP")
+	if err != nil {
+		t.Errorf("Authentication.GetRequiredAction returned error: %v", err)
+	}
+
+	action.DefaultAction = Bool(true)
+
+	res, err := k.Authentication.UpdateRequiredAction(ctx, realm, action)
+	if err != nil {
+		t.Errorf("Authentication.UpdateRequiredAction returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	action, _, err = k.Authentication.GetRequiredAction(ctx, realm, "CONFIGURE_TOTP")
+	if err != nil {
+		t.Errorf("Authentication.GetRequiredAction returned error: %v", err)
+	}
+
+	if !*action.DefaultAction {
+		t.Errorf("got: %t, want: %t", *action.DefaultAction, true)
+	}
+}
+
+func TestAuthenticationService_DeleteRequiredAction(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	ctx := context.Background()
+
+	res, err := k.Authentication.DeleteRequiredAction(ctx, realm, "CONFIGURE_TOTP")
+	if err != nil {
+		t.Errorf("Authentication.DeleteRequiredAction returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	actions, _, err := k.Authentication.ListUnregisteredRequiredActions(ctx, realm)
+	if err != nil {
+		t.Errorf("Authentication.ListUnregisteredRequiredActions returned error: %v", err)
+	}
+
+	var action *UnregisteredRequiredAction
+	for _, a := range actions {
+		if a.ProviderID == "CONFIGURE_TOTP" {
+			action = a
+		}
+	}
+	if action == nil {
+		t.Fatalf("required action %q not unregistered", "CONFIGURE_TOTP")
+	}
+
+	res, err = k.Authentication.RegisterRequiredAction(ctx, realm, action)
+	if err != nil {
+		t.Errorf("Authentication.RegisterRequiredAction returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
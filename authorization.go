@@ -0,0 +1,149 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+)
+
+// AuthorizationService handles communication with the resource server related methods of the Keycloak API.
+//
+// The resources, scopes, policies and permissions of a resource server are
+// managed by ResourcesService, ScopesService, PoliciesService and
+// PermissionsService.
+type AuthorizationService service
+
+// ResourceServer represents the authorization settings of a client.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/authorization/ResourceServerRepresentation.java
+type ResourceServer struct {
+	ID                            *string     `json:"id,omitempty"`
+	ClientID                      *string     `json:"clientId,omitempty"`
+	Name                          *string     `json:"name,omitempty"`
+	AllowRemoteResourceManagement *bool       `json:"allowRemoteResourceManagement,omitempty"`
+	PolicyEnforcementMode         *string     `json:"policyEnforcementMode,omitempty"`
+	DecisionStrategy              *string     `json:"decisionStrategy,omitempty"`
+	Resources                     []*Resource `json:"resources,omitempty"`
+	Policies                      []*Policy   `json:"policies,omitempty"`
+	Scopes                        []*Scope    `json:"scopes,omitempty"`
+}
+
+// Decision effects of a policy evaluation.
+const (
+	DecisionEffectPermit = "PERMIT"
+	DecisionEffectDeny   = "DENY"
+)
+
+// PolicyEvaluationRequest describes for which user and resources the
+// policies of a resource server are evaluated.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/authorization/PolicyEvaluationRequest.java
+type PolicyEvaluationRequest struct {
+	Context      map[string]map[string]string `json:"context,omitempty"`
+	Resources    []*Resource                  `json:"resources,omitempty"`
+	ClientID     *string                      `json:"clientId,omitempty"`
+	UserID       *string                      `json:"userId,omitempty"`
+	RoleIDs      []string                     `json:"roleIds,omitempty"`
+	Entitlements *bool                        `json:"entitlements,omitempty"`
+}
+
+// PolicyEvaluationResponse is the outcome of a policy evaluation.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/authorization/PolicyEvaluationResponse.java
+type PolicyEvaluationResponse struct {
+	Results      []*EvaluationResult     `json:"results,omitempty"`
+	Entitlements *bool                   `json:"entitlements,omitempty"`
+	Status       *string                 `json:"status,omitempty"`
+	RPT          *map[string]interface{} `json:"rpt,omitempty"`
+}
+
+// EvaluationResult is the decision for a single resource.
+type EvaluationResult struct {
+	Resource      *Resource       `json:"resource,omitempty"`
+	Scopes        []*Scope        `json:"scopes,omitempty"`
+	Policies      []*PolicyResult `json:"policies,omitempty"`
+	Status        *string         `json:"status,omitempty"`
+	AllowedScopes []*Scope        `json:"allowedScopes,omitempty"`
+}
+
+// PolicyResult is the decision of a single policy or permission.
+type PolicyResult struct {
+	Policy             *Policy         `json:"policy,omitempty"`
+	Status             *string         `json:"status,omitempty"`
+	AssociatedPolicies []*PolicyResult `json:"associatedPolicies,omitempty"`
+	Scopes             []string        `json:"scopes,omitempty"`
+}
+
+// GetResourceServer gets the authorization settings of the client.
+func (s *AuthorizationService) GetResourceServer(ctx context.Context, realm, clientID string) (*ResourceServer, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server", realm, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var server ResourceServer
+	res, err := s.keycloak.Do(ctx, req, &server)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &server, res, nil
+}
+
+// UpdateResourceServer updates the authorization settings of the client, e.g. the policy enforcement mode.
+func (s *AuthorizationService) UpdateResourceServer(ctx context.Context, realm, clientID string, server *ResourceServer) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server", realm, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, server)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// GetResourceServerSettings exports the complete authorization configuration
+// of the client, including its resources, scopes, policies and permissions.
+func (s *AuthorizationService) GetResourceServerSettings(ctx context.Context, realm, clientID string) (*ResourceServer, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/settings", realm, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var server ResourceServer
+	res, err := s.keycloak.Do(ctx, req, &server)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &server, res, nil
+}
+
+// ImportResourceServerSettings imports an authorization configuration as
+// returned by GetResourceServerSettings into the client.
+func (s *AuthorizationService) ImportResourceServerSettings(ctx context.Context, realm, clientID string, server *ResourceServer) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/import", realm, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, server)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// EvaluatePolicy evaluates the policies of the client for the user and resources of the request.
+func (s *AuthorizationService) EvaluatePolicy(ctx context.Context, realm, clientID string, request *PolicyEvaluationRequest) (*PolicyEvaluationResponse, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/policy/evaluate", realm, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var evaluation PolicyEvaluationResponse
+	res, err := s.keycloak.Do(ctx, req, &evaluation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &evaluation, res, nil
+}
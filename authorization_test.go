@@ -0,0 +1,134 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestAuthorizationService_UpdateResourceServer(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	clientID := createClient(t, k, realm, "client")
+
+	ctx := context.Background()
+
+	server, _, err := k.Authorization.GetResourceServer(ctx, realm, clientID)
+	if err != nil {
+		t.Errorf("Authorization.GetResourceServer returned error: %v", err)
+	}
+
+	server.PolicyEnforcementMode = String(PolicyEnforcementModePermissive)
+
+	res, err := k.Authorization.UpdateResourceServer(ctx, realm, clientID, server)
+	if err != nil {
+		t.Errorf("Authorization.UpdateResourceServer returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	server, _, err = k.Authorization.GetResourceServer(ctx, realm, clientID)
+	if err != nil {
+		t.Errorf("Authorization.GetResourceServer returned error: %v", err)
+	}
+
+	if *server.PolicyEnforcementMode != PolicyEnforcementModePermissive {
+		t.Errorf("got: %s, want: %s", *server.PolicyEnforcementMode, PolicyEnforcementModePermissive)
+	}
+}
+
+func TestAuthorizationService_ImportResourceServerSettings(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	sourceID := createClient(t, k, realm, "source")
+	targetID := createClient(t, k, realm, "target")
+
+	createResource(t, k, realm, sourceID, "resource")
+
+	ctx := context.Background()
+
+	settings, res, err := k.Authorization.GetResourceServerSettings(ctx, realm, sourceID)
+	if err != nil {
+		t.Errorf("Authorization.GetResourceServerSettings returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	res, err = k.Authorization.ImportResourceServerSettings(ctx, realm, targetID, settings)
+	if err != nil {
+		t.Errorf("Authorization.ImportResourceServerSettings returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	resources, _, err := k.Resources.List(ctx, realm, targetID)
+	if err != nil {
+		t.Errorf("Resources.List returned error: %v", err)
+	}
+
+	found := false
+	for _, r := range resources {
+		if *r.Name == "resource" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("resource %q not imported", "resource")
+	}
+}
+
+func TestAuthorizationService_EvaluatePolicy(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	clientID := createClient(t, k, realm, "client")
+	userID := createUser(t, k, realm, "john")
+
+	resource := createResource(t, k, realm, clientID, "resource")
+	policy := createUserPolicy(t, k, realm, clientID, userID)
+
+	ctx := context.Background()
+
+	permission := &ResourcePermission{
+		Permission: Permission{
+			Name:             String("permission"),
+			Type:             String("resource"),
+			Logic:            String(LogicPositive),
+			DecisionStrategy: String(DecisionStrategyUnanimous),
+			Resources:        []string{*resource.ID},
+			Policies:         []string{*policy.ID},
+		},
+	}
+	if _, _, err := k.Permissions.CreateResourcePermission(ctx, realm, clientID, permission); err != nil {
+		t.Errorf("Permissions.CreateResourcePermission returned error: %v", err)
+	}
+
+	request := &PolicyEvaluationRequest{
+		UserID:    String(userID),
+		Resources: []*Resource{{ID: resource.ID}},
+	}
+
+	evaluation, res, err := k.Authorization.EvaluatePolicy(ctx, realm, clientID, request)
+	if err != nil {
+		t.Errorf("Authorization.EvaluatePolicy returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if *evaluation.Status != DecisionEffectPermit {
+		t.Errorf("got: %s, want: %s", *evaluation.Status, DecisionEffectPermit)
+	}
+}
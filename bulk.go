@@ -0,0 +1,265 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultBulkConcurrency is the number of users BulkCreate creates at the
+// same time unless configured otherwise.
+const DefaultBulkConcurrency = 8
+
+// Actions of a BulkResult.
+const (
+	BulkCreated = "created"
+	BulkUpdated = "updated"
+	BulkFailed  = "failed"
+)
+
+// BulkOptions configures UsersService.BulkCreate.
+type BulkOptions struct {
+	// Concurrency is the maximum number of requests in flight. Defaults to
+	// DefaultBulkConcurrency.
+	Concurrency int
+
+	// Upsert updates users whose username already exists instead of
+	// reporting a conflict.
+	Upsert bool
+
+	// Retry configures how often a user is retried after a transient
+	// failure, i.e. network errors, timeouts, 429 and 5xx responses.
+	// Only MaxAttempts and Backoff are used. Defaults to Keycloak.Retry, or
+	// 3 attempts with exponential backoff if that isn't set.
+	//
+	// This is the only retry layer of a bulk import: its requests bypass
+	// Keycloak.Retry, so attempts don't multiply.
+	Retry *RetryPolicy
+
+	// Progress is called after every user with the running totals. Calls
+	// are serialized, so the callback doesn't need to synchronize.
+	Progress func(BulkProgress)
+}
+
+// BulkProgress reports the progress of a bulk import.
+type BulkProgress struct {
+	// Done is the number of users processed so far, including failures.
+	Done int
+	// Failed is the number of users that could not be imported.
+	Failed int
+	// Total is the number of users to import, or 0 if unknown because
+	// they are read from a channel.
+	Total int
+}
+
+// BulkResult is the outcome of importing a single user.
+type BulkResult struct {
+	// Index of the user in the input, in the order received.
+	Index int
+	User  *User
+	// ID of the created or updated user, if known.
+	ID       string
+	Action   string
+	Attempts int
+	Err      error
+}
+
+// BulkCreate creates users with bounded concurrency and returns one result
+// per user in input order. Failures of single users are reported in their
+// results; the returned error is only set when ctx is done.
+//
+// Importing a user isn't atomic. When a create is retried after the
+// response was lost, Keycloak may already have created the user and the
+// retry fails with a conflict, which is resolved like any other conflict.
+func (s *UsersService) BulkCreate(ctx context.Context, realm string, users []*User, opts *BulkOptions) ([]*BulkResult, error) {
+	in := make(chan *User)
+	go func() {
+		defer close(in)
+		for _, user := range users {
+			select {
+			case in <- user:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	results := make([]*BulkResult, len(users))
+	for result := range s.bulkCreate(ctx, realm, in, len(users), opts) {
+		results[result.Index] = result
+	}
+	if err := ctx.Err(); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// BulkCreateStream is like BulkCreate but reads users from a channel, e.g.
+// while parsing a large export, and sends results as soon as they are
+// available. The returned channel is closed once users is closed and all
+// users were processed, or ctx is done.
+func (s *UsersService) BulkCreateStream(ctx context.Context, realm string, users <-chan *User, opts *BulkOptions) <-chan *BulkResult {
+	return s.bulkCreate(ctx, realm, users, 0, opts)
+}
+
+func (s *UsersService) bulkCreate(ctx context.Context, realm string, users <-chan *User, total int, opts *BulkOptions) <-chan *BulkResult {
+	var o BulkOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = DefaultBulkConcurrency
+	}
+	if o.Retry == nil {
+		o.Retry = s.keycloak.Retry
+	}
+	if o.Retry == nil {
+		o.Retry = &RetryPolicy{}
+	}
+	ctx = withoutRetry(ctx)
+
+	type job struct {
+		index int
+		user  *User
+	}
+	jobs := make(chan job)
+	go func() {
+		defer close(jobs)
+		index := 0
+		for {
+			select {
+			case user, ok := <-users:
+				if !ok {
+					return
+				}
+				select {
+				case jobs <- job{index, user}:
+					index++
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var (
+		mu       sync.Mutex
+		progress = BulkProgress{Total: total}
+	)
+	out := make(chan *BulkResult)
+	var wg sync.WaitGroup
+	for i := 0; i < o.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				result := s.importUser(ctx, realm, j.user, &o)
+				result.Index = j.index
+
+				mu.Lock()
+				progress.Done++
+				if result.Err != nil {
+					progress.Failed++
+				}
+				if o.Progress != nil {
+					o.Progress(progress)
+				}
+				mu.Unlock()
+
+				select {
+				case out <- result:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// importUser creates or, with Upsert, updates a single user and retries
+// transient failures.
+func (s *UsersService) importUser(ctx context.Context, realm string, user *User, opts *BulkOptions) *BulkResult {
+	result := &BulkResult{User: user, Action: BulkFailed}
+	for {
+		result.Attempts++
+		action, id, res, err := s.importOnce(ctx, realm, user, opts.Upsert)
+		if err == nil {
+			result.Action, result.ID = action, id
+			return result
+		}
+		result.Err = err
+
+		if !transient(res, err) || result.Attempts >= opts.Retry.maxAttempts() {
+			return result
+		}
+		timer := time.NewTimer(opts.Retry.backoff(result.Attempts))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return result
+		}
+		result.Err = nil
+	}
+}
+
+// importOnce makes a single import attempt. The returned response is the one
+// of the failed request, if any.
+func (s *UsersService) importOnce(ctx context.Context, realm string, user *User, upsert bool) (string, string, *Response, error) {
+	res, createErr := s.Create(ctx, realm, user)
+	if createErr == nil {
+		return BulkCreated, res.LocationID, nil, nil
+	}
+	if !upsert || !IsConflict(createErr) {
+		return BulkFailed, "", res, createErr
+	}
+
+	// the username or email is taken, update the user with the username
+	live, err := s.getByUsername(ctx, realm, user.GetUsername())
+	if err != nil {
+		// the lookup is idempotent and safe to retry
+		return BulkFailed, "", nil, err
+	}
+	if live == nil {
+		// the email belongs to another user
+		return BulkFailed, "", nil, createErr
+	}
+
+	update := *user
+	update.ID = live.ID
+	if res, err := s.Update(ctx, realm, &update); err != nil {
+		return BulkFailed, live.GetID(), res, err
+	}
+	return BulkUpdated, live.GetID(), nil, nil
+}
+
+// transient reports whether a failed request is worth retrying. Errors
+// responses are retried on 429 and 5xx. Other errors are only retried if no
+// response was received, since a request whose response body couldn't be
+// read or decoded was processed by Keycloak.
+func transient(res *Response, err error) bool {
+	var e *ErrorResponse
+	if errors.As(err, &e) {
+		switch ErrorClass(e.Response, err) {
+		case ErrorClassServer, ErrorClassRateLimited:
+			return true
+		}
+		return false
+	}
+	if res != nil {
+		return false
+	}
+	switch ErrorClass(nil, err) {
+	case ErrorClassTimeout, ErrorClassNetwork:
+		return true
+	}
+	return false
+}
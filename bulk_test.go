@@ -0,0 +1,213 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestUsersService_BulkCreate(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		attempts = map[string]int{}
+		updated  []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/admin/realms/first/users":
+			var user User
+			if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+				t.Error(err)
+			}
+			attempts[user.GetUsername()]++
+			switch {
+			case user.GetUsername() == "flaky" && attempts["flaky"] == 1:
+				w.WriteHeader(http.StatusServiceUnavailable)
+			case user.GetUsername() == "existing":
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"errorMessage":"User exists with same username"}`))
+			case user.GetUsername() == "invalid":
+				w.WriteHeader(http.StatusBadRequest)
+			default:
+				w.Header().Set("Location", "http://"+r.Host+r.URL.Path+"/id-"+user.GetUsername())
+				w.WriteHeader(http.StatusCreated)
+			}
+		case r.Method == http.MethodGet && r.URL.Path == "/admin/realms/first/users":
+			w.Write([]byte(`[{"id":"id-existing","username":"existing"}]`))
+		case r.Method == http.MethodPut && r.URL.Path == "/admin/realms/first/users/id-existing":
+			updated = append(updated, "id-existing")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	users := []*User{
+		{Username: String("new")},
+		{Username: String("flaky")},
+		{Username: String("existing")},
+		{Username: String("invalid")},
+	}
+
+	var progress []BulkProgress
+	opts := &BulkOptions{
+		Concurrency: 2,
+		Upsert:      true,
+		Retry: &RetryPolicy{
+			Backoff: func(int) time.Duration { return time.Millisecond },
+		},
+		Progress: func(p BulkProgress) {
+			progress = append(progress, p)
+		},
+	}
+	results, err := k.Users.BulkCreate(context.Background(), "first", users, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		action   string
+		id       string
+		attempts int
+		failed   bool
+	}{
+		{BulkCreated, "id-new", 1, false},
+		{BulkCreated, "id-flaky", 2, false},
+		{BulkUpdated, "id-existing", 1, false},
+		{BulkFailed, "", 1, true},
+	}
+	for i, tt := range tests {
+		result := results[i]
+		if result.Index != i || result.User != users[i] {
+			t.Errorf("%d: got result for user %d", i, result.Index)
+		}
+		if result.Action != tt.action {
+			t.Errorf("%d: got: %s, want: %s", i, result.Action, tt.action)
+		}
+		if result.ID != tt.id {
+			t.Errorf("%d: got: %s, want: %s", i, result.ID, tt.id)
+		}
+		if result.Attempts != tt.attempts {
+			t.Errorf("%d: got: %d, want: %d", i, result.Attempts, tt.attempts)
+		}
+		if (result.Err != nil) != tt.failed {
+			t.Errorf("%d: unexpected error: %v", i, result.Err)
+		}
+	}
+
+	if len(updated) != 1 {
+		t.Errorf("got: %d, want: %d", len(updated), 1)
+	}
+	if len(progress) != len(users) {
+		t.Fatalf("got: %d, want: %d", len(progress), len(users))
+	}
+	if last := progress[len(progress)-1]; last != (BulkProgress{Done: 4, Failed: 1, Total: 4}) {
+		t.Errorf("got: %+v", last)
+	}
+}
+
+func TestUsersService_BulkCreateStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	users := make(chan *User)
+	go func() {
+		defer close(users)
+		for i := 0; i < 10; i++ {
+			users <- &User{Username: String("john")}
+		}
+	}()
+
+	n := 0
+	for result := range k.Users.BulkCreateStream(context.Background(), "first", users, nil) {
+		n++
+		if !IsConflict(result.Err) {
+			t.Errorf("got: %v, want conflict", result.Err)
+		}
+	}
+	if n != 10 {
+		t.Errorf("got: %d, want: %d", n, 10)
+	}
+}
+
+func TestUsersService_BulkCreate_SingleRetryLayer(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.Retry = &RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     func(int) time.Duration { return time.Millisecond },
+	}
+
+	opts := &BulkOptions{
+		Retry: &RetryPolicy{
+			MaxAttempts: 2,
+			Backoff:     func(int) time.Duration { return time.Millisecond },
+		},
+	}
+	results, err := k.Users.BulkCreate(context.Background(), "first", []*User{{Username: String("john")}}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Attempts != 2 {
+		t.Errorf("got: %d, want: %d", results[0].Attempts, 2)
+	}
+	if requests != 2 {
+		t.Errorf("got: %d, want: %d", requests, 2)
+	}
+}
+
+func TestTransient(t *testing.T) {
+	tests := []struct {
+		res  *Response
+		err  error
+		want bool
+	}{
+		{nil, context.DeadlineExceeded, true},
+		{nil, &net.OpError{Op: "dial", Err: errors.New("connection refused")}, true},
+		{&Response{Response: &http.Response{StatusCode: http.StatusCreated}}, io.ErrUnexpectedEOF, false},
+		{nil, &ErrorResponse{Response: &http.Response{StatusCode: http.StatusServiceUnavailable}}, true},
+		{nil, &ErrorResponse{Response: &http.Response{StatusCode: http.StatusBadRequest}}, false},
+	}
+	for i, tt := range tests {
+		if got := transient(tt.res, tt.err); got != tt.want {
+			t.Errorf("%d: got: %t, want: %t", i, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package keycloak
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+)
+
+// ClientRegistrationService handles communication with the client
+// registration endpoints of a realm.
+//
+// Like the OpenID Connect endpoints these are not part of the admin API.
+// Clients are created with an initial access token, see
+// ClientsService.CreateInitialAccessToken, and managed afterwards with the
+// registration access token returned on creation. Every update returns a
+// new registration access token which replaces the previous one.
+type ClientRegistrationService service
+
+// Client registration providers.
+const (
+	ClientRegistrationProviderDefault = "default"
+	ClientRegistrationProviderOIDC    = "openid-connect"
+	ClientRegistrationProviderSAML    = "saml2-entity-descriptor"
+)
+
+// OIDCClient represents client metadata as defined by OpenID Connect Dynamic
+// Client Registration.
+//
+// https://openid.net/specs/openid-connect-registration-1_0.html#ClientMetadata
+type OIDCClient struct {
+	ClientID                string   `json:"client_id,omitempty"`
+	ClientSecret            string   `json:"client_secret,omitempty"`
+	ClientIDIssuedAt        int64    `json:"client_id_issued_at,omitempty"`
+	ClientSecretExpiresAt   int64    `json:"client_secret_expires_at,omitempty"`
+	RegistrationAccessToken string   `json:"registration_access_token,omitempty"`
+	RegistrationClientURI   string   `json:"registration_client_uri,omitempty"`
+	ClientName              string   `json:"client_name,omitempty"`
+	ClientURI               string   `json:"client_uri,omitempty"`
+	LogoURI                 string   `json:"logo_uri,omitempty"`
+	PolicyURI               string   `json:"policy_uri,omitempty"`
+	TosURI                  string   `json:"tos_uri,omitempty"`
+	Contacts                []string `json:"contacts,omitempty"`
+	RedirectURIs            []string `json:"redirect_uris,omitempty"`
+	PostLogoutRedirectURIs  []string `json:"post_logout_redirect_uris,omitempty"`
+	GrantTypes              []string `json:"grant_types,omitempty"`
+	ResponseTypes           []string `json:"response_types,omitempty"`
+	Scope                   string   `json:"scope,omitempty"`
+	TokenEndpointAuthMethod string   `json:"token_endpoint_auth_method,omitempty"`
+	JWKSURI                 string   `json:"jwks_uri,omitempty"`
+	SoftwareID              string   `json:"software_id,omitempty"`
+	SoftwareVersion         string   `json:"software_version,omitempty"`
+}
+
+// Create registers a client with the default provider. The returned client
+// carries the registration access token.
+func (s *ClientRegistrationService) Create(ctx context.Context, realm, initialAccessToken string, client *Client) (*Client, *Response, error) {
+	u := pathf("realms/%s/clients-registrations/default", realm)
+	var created Client
+	res, err := s.do(ctx, http.MethodPost, u, initialAccessToken, client, &created)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &created, res, nil
+}
+
+// Get returns a client registered with the default provider.
+func (s *ClientRegistrationService) Get(ctx context.Context, realm, registrationAccessToken, clientID string) (*Client, *Response, error) {
+	u := pathf("realms/%s/clients-registrations/default/%s", realm, clientID)
+	var client Client
+	res, err := s.do(ctx, http.MethodGet, u, registrationAccessToken, nil, &client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &client, res, nil
+}
+
+// Update updates a client registered with the default provider. The
+// returned client carries the new registration access token.
+func (s *ClientRegistrationService) Update(ctx context.Context, realm, registrationAccessToken string, client *Client) (*Client, *Response, error) {
+	u := pathf("realms/%s/clients-registrations/default/%s", realm, *client.ClientID)
+	var updated Client
+	res, err := s.do(ctx, http.MethodPut, u, registrationAccessToken, client, &updated)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &updated, res, nil
+}
+
+// Delete removes a registered client.
+func (s *ClientRegistrationService) Delete(ctx context.Context, realm, registrationAccessToken, clientID string) (*Response, error) {
+	u := pathf("realms/%s/clients-registrations/default/%s", realm, clientID)
+	return s.do(ctx, http.MethodDelete, u, registrationAccessToken, nil, nil)
+}
+
+// CreateOIDC registers a client with the OpenID Connect provider.
+func (s *ClientRegistrationService) CreateOIDC(ctx context.Context, realm, initialAccessToken string, client *OIDCClient) (*OIDCClient, *Response, error) {
+	u := pathf("realms/%s/clients-registrations/openid-connect", realm)
+	var created OIDCClient
+	res, err := s.do(ctx, http.MethodPost, u, initialAccessToken, client, &created)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &created, res, nil
+}
+
+// GetOIDC returns a client registered with the OpenID Connect provider.
+func (s *ClientRegistrationService) GetOIDC(ctx context.Context, realm, registrationAccessToken, clientID string) (*OIDCClient, *Response, error) {
+	u := pathf("realms/%s/clients-registrations/openid-connect/%s", realm, clientID)
+	var client OIDCClient
+	res, err := s.do(ctx, http.MethodGet, u, registrationAccessToken, nil, &client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &client, res, nil
+}
+
+// UpdateOIDC updates a client registered with the OpenID Connect provider.
+// The returned client carries the new registration access token.
+func (s *ClientRegistrationService) UpdateOIDC(ctx context.Context, realm, registrationAccessToken string, client *OIDCClient) (*OIDCClient, *Response, error) {
+	u := pathf("realms/%s/clients-registrations/openid-connect/%s", realm, client.ClientID)
+	var updated OIDCClient
+	res, err := s.do(ctx, http.MethodPut, u, registrationAccessToken, client, &updated)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &updated, res, nil
+}
+
+// CreateSAML registers a SAML client from its SAML entity descriptor.
+func (s *ClientRegistrationService) CreateSAML(ctx context.Context, realm, initialAccessToken string, entityDescriptor []byte) (*Client, *Response, error) {
+	u := pathf("realms/%s/clients-registrations/saml2-entity-descriptor", realm)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the endpoint only accepts the entity descriptor as xml
+	req.ContentLength = int64(len(entityDescriptor))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(entityDescriptor)), nil
+	}
+	req.Body, _ = req.GetBody()
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("Authorization", "Bearer "+initialAccessToken)
+
+	var client Client
+	res, err := s.keycloak.Do(ctx, req, &client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &client, res, nil
+}
+
+func (s *ClientRegistrationService) do(ctx context.Context, method, u, token string, body, v interface{}) (*Response, error) {
+	req, err := s.keycloak.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return s.keycloak.Do(ctx, req, v)
+}
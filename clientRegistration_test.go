@@ -0,0 +1,99 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func initialAccessToken(t *testing.T, k *Keycloak, realm string) string {
+	t.Helper()
+
+	token, _, err := k.Clients.CreateInitialAccessToken(context.Background(), realm, &ClientInitialAccessCreate{
+		Expiration: Int(3600),
+		Count:      Int(1),
+	})
+	if err != nil {
+		t.Fatalf("Clients.CreateInitialAccessToken returned error: %v", err)
+	}
+
+	return *token.Token
+}
+
+func TestClientRegistrationService_Create(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	token := initialAccessToken(t, k, realm)
+
+	anonymous, err := NewKeycloak(nil, "http://localhost:8080/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	created, res, err := anonymous.ClientRegistration.Create(ctx, realm, token, &Client{
+		ClientID: String("registered"),
+	})
+	if err != nil {
+		t.Fatalf("ClientRegistration.Create returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+
+	if created.RegistrationAccessToken == nil {
+		t.Fatal("got no registration access token")
+	}
+
+	created.Name = String("Registered")
+	updated, _, err := anonymous.ClientRegistration.Update(ctx, realm, *created.RegistrationAccessToken, created)
+	if err != nil {
+		t.Fatalf("ClientRegistration.Update returned error: %v", err)
+	}
+
+	if *updated.Name != "Registered" {
+		t.Errorf("got: %s, want: %s", *updated.Name, "Registered")
+	}
+
+	res, err = anonymous.ClientRegistration.Delete(ctx, realm, *updated.RegistrationAccessToken, "registered")
+	if err != nil {
+		t.Errorf("ClientRegistration.Delete returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestClientRegistrationService_CreateOIDC(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	token := initialAccessToken(t, k, realm)
+
+	anonymous, err := NewKeycloak(nil, "http://localhost:8080/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, res, err := anonymous.ClientRegistration.CreateOIDC(context.Background(), realm, token, &OIDCClient{
+		ClientName:   "registered",
+		RedirectURIs: []string{"http://localhost/callback"},
+	})
+	if err != nil {
+		t.Fatalf("ClientRegistration.CreateOIDC returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+
+	if created.ClientID == "" || created.RegistrationAccessToken == "" {
+		t.Errorf("got: %+v, want client id and registration access token", created)
+	}
+}
@@ -2,16 +2,16 @@ package keycloak
 
 import (
 	"context"
-	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // ClientRolesService handles communication with the client roles related methods of the Keycloak API.
 type ClientRolesService service
 
 // Create creates a new client role.
-func (s *ClientRolesService) Create(ctx context.Context, realm, id string, role *Role) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/roles", realm, id)
+func (s *ClientRolesService) Create(ctx context.Context, realm, id string, role *Role) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/roles", realm, id)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, role)
 	if err != nil {
 		return nil, err
@@ -21,8 +21,8 @@ func (s *ClientRolesService) Create(ctx context.Context, realm, id string, role
 }
 
 // List lists all client roles.
-func (s *ClientRolesService) List(ctx context.Context, realm, id string) ([]*Role, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/roles", realm, id)
+func (s *ClientRolesService) List(ctx context.Context, realm, id string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/roles", realm, id)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -38,8 +38,8 @@ func (s *ClientRolesService) List(ctx context.Context, realm, id string) ([]*Rol
 }
 
 // Get retrieves a single client role.
-func (s *ClientRolesService) Get(ctx context.Context, realm, id, roleName string) (*Role, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/roles/%s", realm, id, roleName)
+func (s *ClientRolesService) Get(ctx context.Context, realm, id, roleName string) (*Role, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/roles/%s", realm, id, roleName)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -55,8 +55,8 @@ func (s *ClientRolesService) Get(ctx context.Context, realm, id, roleName string
 }
 
 // GetUsers returns a stream of users that have the specified role name.
-func (s *ClientRolesService) GetUsers(ctx context.Context, realm, clientID, role string, opts *Options) ([]*User, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/roles/%s/users", realm, clientID, role)
+func (s *ClientRolesService) GetUsers(ctx context.Context, realm, clientID, role string, opts *Options) ([]*User, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/roles/%s/users", realm, clientID, role)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -77,8 +77,13 @@ func (s *ClientRolesService) GetUsers(ctx context.Context, realm, clientID, role
 }
 
 // GetByID gets client role by id.
-func (s *ClientRolesService) GetByID(ctx context.Context, realm, roleID, clientID string) (*Role, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/roles-by-id/%s?client=%s", realm, roleID, clientID)
+func (s *ClientRolesService) GetByID(ctx context.Context, realm, roleID, clientID string) (*Role, *Response, error) {
+	u := pathf("admin/realms/%s/roles-by-id/%s", realm, roleID)
+	u, err := addOptions(u, url.Values{"client": {clientID}})
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -94,23 +99,164 @@ func (s *ClientRolesService) GetByID(ctx context.Context, realm, roleID, clientI
 }
 
 // DeleteByID deletes client role by id.
-func (s *ClientRolesService) DeleteByID(ctx context.Context, realm, roleID, clientID string) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/roles-by-id/%s?client=%s", realm, roleID, clientID)
+func (s *ClientRolesService) DeleteByID(ctx context.Context, realm, roleID, clientID string) (*Response, error) {
+	u := pathf("admin/realms/%s/roles-by-id/%s", realm, roleID)
+	u, err := addOptions(u, url.Values{"client": {clientID}})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// Update updates the client role with the given name.
+func (s *ClientRolesService) Update(ctx context.Context, realm, id, name string, role *Role) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/roles/%s", realm, id, name)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// Delete deletes the client role with the given name.
+func (s *ClientRolesService) Delete(ctx context.Context, realm, id, name string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/roles/%s", realm, id, name)
 	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
 	if err != nil {
 		return nil, err
 	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// AddComposites adds roles to the composite of the role.
+func (s *ClientRolesService) AddComposites(ctx context.Context, realm, id, name string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/roles/%s/composites", realm, id, name)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListComposites lists the roles the composite role consists of.
+func (s *ClientRolesService) ListComposites(ctx context.Context, realm, id, name string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/roles/%s/composites", realm, id, name)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// RemoveComposites removes roles from the composite of the role.
+func (s *ClientRolesService) RemoveComposites(ctx context.Context, realm, id, name string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/roles/%s/composites", realm, id, name)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
 	return s.keycloak.Do(ctx, req, nil)
 }
 
-// Returns a stream of groups that have the specified role name
-// GET /{realm}/clients/{id}/roles/{role-name}/groups
+// GetGroups returns the groups that have the role.
+func (s *ClientRolesService) GetGroups(ctx context.Context, realm, id, name string, opts *RoleGroupsListOptions) ([]*Group, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/roles/%s/groups", realm, id, name)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groups []*Group
+	res, err := s.keycloak.Do(ctx, req, &groups)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return groups, res, nil
+}
+
+// ListUsersWithRole lists all users that have the client role by requesting
+// one page after another until the result set is exhausted. opts.First is
+// the offset to start at and opts.Max the page size, opts may be nil. Users
+// holding the role through a group or composite role are not included.
+func (s *ClientRolesService) ListUsersWithRole(ctx context.Context, realm, id, name string, opts *Options) ([]*User, error) {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
 
-// Add a composite to the role
-// POST /{realm}/clients/{id}/roles/{role-name}/composites
+	var users []*User
+	err := Paginate(ctx, o, func(ctx context.Context, page Options) (int, error) {
+		next, _, err := s.GetUsers(ctx, realm, id, name, &page)
+		if err != nil {
+			return 0, err
+		}
+		users = append(users, next...)
+		return len(next), nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-// Get composites of the role
-// GET /{realm}/clients/{id}/roles/{role-name}/composites
+	return users, nil
+}
 
-// Remove roles from the role’s composite
-// DELETE /{realm}/clients/{id}/roles/{role-name}/composites
+// ListGroupsWithRole lists all groups that have the client role by requesting
+// one page after another until the result set is exhausted. opts may be nil.
+func (s *ClientRolesService) ListGroupsWithRole(ctx context.Context, realm, id, name string, opts *RoleGroupsListOptions) ([]*Group, error) {
+	var o RoleGroupsListOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	var groups []*Group
+	err := Paginate(ctx, o.Options, func(ctx context.Context, page Options) (int, error) {
+		o.Options = page
+		next, _, err := s.GetGroups(ctx, realm, id, name, &o)
+		if err != nil {
+			return 0, err
+		}
+		groups = append(groups, next...)
+		return len(next), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// GetManagementPermissions returns whether fine-grained admin permissions are
+// enabled for the client role.
+func (s *ClientRolesService) GetManagementPermissions(ctx context.Context, realm, id, name string) (*ManagementPermissionReference, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/roles/%s/management/permissions", realm, id, name)
+	return getManagementPermissions(ctx, s.keycloak, u)
+}
+
+// SetManagementPermissions enables or disables fine-grained admin permissions
+// for the client role.
+func (s *ClientRolesService) SetManagementPermissions(ctx context.Context, realm, id, name string, ref *ManagementPermissionReference) (*ManagementPermissionReference, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/roles/%s/management/permissions", realm, id, name)
+	return setManagementPermissions(ctx, s.keycloak, u, ref)
+}
@@ -2,7 +2,10 @@ package keycloak
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
 )
 
@@ -70,3 +73,121 @@ func TestClientRolesService_List(t *testing.T) {
 		t.Errorf("got: %d, want: %d", len(roles), 3)
 	}
 }
+
+func TestClientRolesService_Delete(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	clientID := createClient(t, k, realm, "client")
+	createClientRole(t, k, realm, clientID, "role")
+
+	res, err := k.ClientRoles.Delete(context.Background(), realm, clientID, "role")
+	if err != nil {
+		t.Errorf("ClientRoles.Delete returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestClientRolesService_Composites(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	clientID := createClient(t, k, realm, "client")
+	createClientRole(t, k, realm, clientID, "composite")
+	createClientRole(t, k, realm, clientID, "child")
+
+	ctx := context.Background()
+
+	child, _, err := k.ClientRoles.Get(ctx, realm, clientID, "child")
+	if err != nil {
+		t.Errorf("ClientRoles.Get returned error: %v", err)
+	}
+
+	if _, err := k.ClientRoles.AddComposites(ctx, realm, clientID, "composite", []*Role{child}); err != nil {
+		t.Errorf("ClientRoles.AddComposites returned error: %v", err)
+	}
+
+	roles, res, err := k.ClientRoles.ListComposites(ctx, realm, clientID, "composite")
+	if err != nil {
+		t.Errorf("ClientRoles.ListComposites returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(roles) != 1 {
+		t.Errorf("got: %d, want: %d", len(roles), 1)
+	}
+
+	if _, err := k.ClientRoles.RemoveComposites(ctx, realm, clientID, "composite", []*Role{child}); err != nil {
+		t.Errorf("ClientRoles.RemoveComposites returned error: %v", err)
+	}
+}
+
+func TestClientRolesService_ListWithRole(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		first, _ := strconv.Atoi(r.URL.Query().Get("first"))
+		max, _ := strconv.Atoi(r.URL.Query().Get("max"))
+		switch r.URL.Path {
+		case "/admin/realms/first/clients/id/roles/admin/users":
+			pages = append(pages, r.URL.RawQuery)
+			var users []*User
+			for i := first; i < first+max && i < 50; i++ {
+				users = append(users, &User{Username: String(strconv.Itoa(i))})
+			}
+			json.NewEncoder(w).Encode(users)
+		case "/admin/realms/first/clients/id/roles/admin/groups":
+			if got := r.URL.Query().Get("briefRepresentation"); got != "false" {
+				t.Errorf("got: %s, want: %s", got, "false")
+			}
+			var groups []*Group
+			for i := first; i < first+max && i < 3; i++ {
+				groups = append(groups, &Group{Name: String(strconv.Itoa(i))})
+			}
+			json.NewEncoder(w).Encode(groups)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	users, err := k.ClientRoles.ListUsersWithRole(ctx, "first", "id", "admin", &Options{First: 10, Max: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(users) != 40 {
+		t.Errorf("got: %d, want: %d", len(users), 40)
+	}
+
+	if got := users[0].GetUsername(); got != "10" {
+		t.Errorf("got: %s, want: %s", got, "10")
+	}
+
+	if len(pages) != 3 || pages[2] != "first=50&max=20" {
+		t.Errorf("got: %v", pages)
+	}
+
+	groups, err := k.ClientRoles.ListGroupsWithRole(ctx, "first", "id", "admin", &RoleGroupsListOptions{BriefRepresentation: Bool(false)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(groups) != 3 {
+		t.Errorf("got: %d, want: %d", len(groups), 3)
+	}
+}
@@ -2,7 +2,6 @@ package keycloak
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 )
 
@@ -10,19 +9,31 @@ import (
 //
 // https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/ClientScopeRepresentation.java
 type ClientScope struct {
-	ID          *string            `json:"id,omitempty"`
-	Name        *string            `json:"name,omitempty"`
-	Description *string            `json:"description,omitempty"`
-	Protocol    *string            `json:"protocol,omitempty"`
-	Attributes  *map[string]string `json:"attributes,omitempty"`
+	ID              *string            `json:"id,omitempty"`
+	Name            *string            `json:"name,omitempty"`
+	Description     *string            `json:"description,omitempty"`
+	Protocol        *string            `json:"protocol,omitempty"`
+	Attributes      *map[string]string `json:"attributes,omitempty"`
+	ProtocolMappers []*ProtocolMapper  `json:"protocolMappers,omitempty"`
+}
+
+// ProtocolMapper representation.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/ProtocolMapperRepresentation.java
+type ProtocolMapper struct {
+	ID             *string            `json:"id,omitempty"`
+	Name           *string            `json:"name,omitempty"`
+	Protocol       *string            `json:"protocol,omitempty"`
+	ProtocolMapper *string            `json:"protocolMapper,omitempty"`
+	Config         *map[string]string `json:"config,omitempty"`
 }
 
 // ClientScopesService ...
 type ClientScopesService service
 
 // List all client scopes in realm.
-func (s *ClientScopesService) List(ctx context.Context, realm string) ([]*ClientScope, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/client-scopes", realm)
+func (s *ClientScopesService) List(ctx context.Context, realm string) ([]*ClientScope, *Response, error) {
+	u := pathf("admin/realms/%s/client-scopes", realm)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -38,8 +49,8 @@ func (s *ClientScopesService) List(ctx context.Context, realm string) ([]*Client
 }
 
 // Create a new client scope.
-func (s *ClientScopesService) Create(ctx context.Context, realm string, clientScope *ClientScope) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/client-scopes", realm)
+func (s *ClientScopesService) Create(ctx context.Context, realm string, clientScope *ClientScope) (*Response, error) {
+	u := pathf("admin/realms/%s/client-scopes", realm)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, clientScope)
 	if err != nil {
 		return nil, err
@@ -49,8 +60,8 @@ func (s *ClientScopesService) Create(ctx context.Context, realm string, clientSc
 }
 
 // Get client scope.
-func (s *ClientScopesService) Get(ctx context.Context, realm, clientScopeID string) (*ClientScope, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/client-scopes/%s", realm, clientScopeID)
+func (s *ClientScopesService) Get(ctx context.Context, realm, clientScopeID string) (*ClientScope, *Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s", realm, clientScopeID)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -65,9 +76,126 @@ func (s *ClientScopesService) Get(ctx context.Context, realm, clientScopeID stri
 	return &clientScope, res, nil
 }
 
+// Update client scope.
+func (s *ClientScopesService) Update(ctx context.Context, realm string, clientScope *ClientScope) (*Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s", realm, *clientScope.ID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, clientScope)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
 // Delete client scope.
-func (s *ClientScopesService) Delete(ctx context.Context, realm, clientScopeID string) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/client-scopes/%s", realm, clientScopeID)
+func (s *ClientScopesService) Delete(ctx context.Context, realm, clientScopeID string) (*Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s", realm, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListProtocolMappers lists all protocol mappers of the client scope.
+func (s *ClientScopesService) ListProtocolMappers(ctx context.Context, realm, clientScopeID string) ([]*ProtocolMapper, *Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/protocol-mappers/models", realm, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mappers []*ProtocolMapper
+	res, err := s.keycloak.Do(ctx, req, &mappers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mappers, res, nil
+}
+
+// CreateProtocolMapper creates a new protocol mapper in the client scope.
+func (s *ClientScopesService) CreateProtocolMapper(ctx context.Context, realm, clientScopeID string, mapper *ProtocolMapper) (*Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/protocol-mappers/models", realm, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, mapper)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// GetProtocolMapper gets a single protocol mapper of the client scope.
+func (s *ClientScopesService) GetProtocolMapper(ctx context.Context, realm, clientScopeID, mapperID string) (*ProtocolMapper, *Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/protocol-mappers/models/%s", realm, clientScopeID, mapperID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mapper ProtocolMapper
+	res, err := s.keycloak.Do(ctx, req, &mapper)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mapper, res, nil
+}
+
+// UpdateProtocolMapper updates a protocol mapper of the client scope.
+func (s *ClientScopesService) UpdateProtocolMapper(ctx context.Context, realm, clientScopeID string, mapper *ProtocolMapper) (*Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/protocol-mappers/models/%s", realm, clientScopeID, *mapper.ID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, mapper)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// DeleteProtocolMapper deletes a protocol mapper of the client scope.
+func (s *ClientScopesService) DeleteProtocolMapper(ctx context.Context, realm, clientScopeID, mapperID string) (*Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/protocol-mappers/models/%s", realm, clientScopeID, mapperID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListRealmDefaultScopes lists the realm default client scopes that are assigned to new clients.
+func (s *ClientScopesService) ListRealmDefaultScopes(ctx context.Context, realm string) ([]*ClientScope, *Response, error) {
+	u := pathf("admin/realms/%s/default-default-client-scopes", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var clientScopes []*ClientScope
+	res, err := s.keycloak.Do(ctx, req, &clientScopes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientScopes, res, nil
+}
+
+// AddRealmDefaultScope adds the client scope to the realm default client scopes.
+func (s *ClientScopesService) AddRealmDefaultScope(ctx context.Context, realm, clientScopeID string) (*Response, error) {
+	u := pathf("admin/realms/%s/default-default-client-scopes/%s", realm, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// RemoveRealmDefaultScope removes the client scope from the realm default client scopes.
+func (s *ClientScopesService) RemoveRealmDefaultScope(ctx context.Context, realm, clientScopeID string) (*Response, error) {
+	u := pathf("admin/realms/%s/default-default-client-scopes/%s", realm, clientScopeID)
 	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
 	if err != nil {
 		return nil, err
@@ -75,3 +203,288 @@ func (s *ClientScopesService) Delete(ctx context.Context, realm, clientScopeID s
 
 	return s.keycloak.Do(ctx, req, nil)
 }
+
+// ListRealmOptionalScopes lists the realm optional client scopes that are assigned to new clients.
+func (s *ClientScopesService) ListRealmOptionalScopes(ctx context.Context, realm string) ([]*ClientScope, *Response, error) {
+	u := pathf("admin/realms/%s/default-optional-client-scopes", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var clientScopes []*ClientScope
+	res, err := s.keycloak.Do(ctx, req, &clientScopes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientScopes, res, nil
+}
+
+// AddRealmOptionalScope adds the client scope to the realm optional client scopes.
+func (s *ClientScopesService) AddRealmOptionalScope(ctx context.Context, realm, clientScopeID string) (*Response, error) {
+	u := pathf("admin/realms/%s/default-optional-client-scopes/%s", realm, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// RemoveRealmOptionalScope removes the client scope from the realm optional client scopes.
+func (s *ClientScopesService) RemoveRealmOptionalScope(ctx context.Context, realm, clientScopeID string) (*Response, error) {
+	u := pathf("admin/realms/%s/default-optional-client-scopes/%s", realm, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListClientDefaultScopes lists the default client scopes of the client.
+func (s *ClientScopesService) ListClientDefaultScopes(ctx context.Context, realm, clientID string) ([]*ClientScope, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/default-client-scopes", realm, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var clientScopes []*ClientScope
+	res, err := s.keycloak.Do(ctx, req, &clientScopes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientScopes, res, nil
+}
+
+// AddClientDefaultScope assigns the client scope as default client scope to the client.
+func (s *ClientScopesService) AddClientDefaultScope(ctx context.Context, realm, clientID, clientScopeID string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/default-client-scopes/%s", realm, clientID, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// RemoveClientDefaultScope removes the default client scope from the client.
+func (s *ClientScopesService) RemoveClientDefaultScope(ctx context.Context, realm, clientID, clientScopeID string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/default-client-scopes/%s", realm, clientID, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListClientOptionalScopes lists the optional client scopes of the client.
+func (s *ClientScopesService) ListClientOptionalScopes(ctx context.Context, realm, clientID string) ([]*ClientScope, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/optional-client-scopes", realm, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var clientScopes []*ClientScope
+	res, err := s.keycloak.Do(ctx, req, &clientScopes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientScopes, res, nil
+}
+
+// AddClientOptionalScope assigns the client scope as optional client scope to the client.
+func (s *ClientScopesService) AddClientOptionalScope(ctx context.Context, realm, clientID, clientScopeID string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/optional-client-scopes/%s", realm, clientID, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// RemoveClientOptionalScope removes the optional client scope from the client.
+func (s *ClientScopesService) RemoveClientOptionalScope(ctx context.Context, realm, clientID, clientScopeID string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/optional-client-scopes/%s", realm, clientID, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// GetAllScopeMappings returns the realm and client roles in the scope of the client scope.
+func (s *ClientScopesService) GetAllScopeMappings(ctx context.Context, realm, clientScopeID string) (*RoleMappings, *Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/scope-mappings", realm, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mappings RoleMappings
+	res, err := s.keycloak.Do(ctx, req, &mappings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mappings, res, nil
+}
+
+// ListRealmScopeMappings lists the realm roles in the scope of the client scope.
+func (s *ClientScopesService) ListRealmScopeMappings(ctx context.Context, realm, clientScopeID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/scope-mappings/realm", realm, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// AddRealmScopeMappings adds realm roles to the scope of the client scope.
+func (s *ClientScopesService) AddRealmScopeMappings(ctx context.Context, realm, clientScopeID string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/scope-mappings/realm", realm, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// RemoveRealmScopeMappings removes realm roles from the scope of the client scope.
+func (s *ClientScopesService) RemoveRealmScopeMappings(ctx context.Context, realm, clientScopeID string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/scope-mappings/realm", realm, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListAvailableRealmScopeMappings lists the realm roles that can still be added to the scope of the client scope.
+func (s *ClientScopesService) ListAvailableRealmScopeMappings(ctx context.Context, realm, clientScopeID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/scope-mappings/realm/available", realm, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// ListRealmScopeMappingsComposite lists the effective realm roles in the scope of the client scope,
+// including composite roles.
+func (s *ClientScopesService) ListRealmScopeMappingsComposite(ctx context.Context, realm, clientScopeID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/scope-mappings/realm/composite", realm, clientScopeID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// ListClientScopeMappings lists the roles of client roleClientID in the scope of the
+// client scope.
+func (s *ClientScopesService) ListClientScopeMappings(ctx context.Context, realm, clientScopeID, roleClientID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/scope-mappings/clients/%s", realm, clientScopeID, roleClientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// AddClientScopeMappings adds roles of client roleClientID to the scope of the client scope.
+func (s *ClientScopesService) AddClientScopeMappings(ctx context.Context, realm, clientScopeID, roleClientID string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/scope-mappings/clients/%s", realm, clientScopeID, roleClientID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// RemoveClientScopeMappings removes roles of client roleClientID from the scope of the
+// client scope.
+func (s *ClientScopesService) RemoveClientScopeMappings(ctx context.Context, realm, clientScopeID, roleClientID string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/scope-mappings/clients/%s", realm, clientScopeID, roleClientID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListAvailableClientScopeMappings lists the roles of client roleClientID that can
+// still be added to the scope of the client scope.
+func (s *ClientScopesService) ListAvailableClientScopeMappings(ctx context.Context, realm, clientScopeID, roleClientID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/scope-mappings/clients/%s/available", realm, clientScopeID, roleClientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// ListClientScopeMappingsComposite lists the effective roles of client roleClientID
+// in the scope of the client scope, including composite roles.
+func (s *ClientScopesService) ListClientScopeMappingsComposite(ctx context.Context, realm, clientScopeID, roleClientID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/client-scopes/%s/scope-mappings/clients/%s/composite", realm, clientScopeID, roleClientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
@@ -109,3 +109,217 @@ func TestClientScopesService_Delete(t *testing.T) {
 		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
 	}
 }
+
+func TestClientScopesService_Update(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	clientScopeID := createClientScope(t, k, realm, "my-client-scope")
+
+	ctx := context.Background()
+
+	clientScope, _, err := k.ClientScopes.Get(ctx, realm, clientScopeID)
+	if err != nil {
+		t.Errorf("ClientScopes.Get returned error: %v", err)
+	}
+
+	clientScope.Description = String("new description")
+
+	res, err := k.ClientScopes.Update(ctx, realm, clientScope)
+	if err != nil {
+		t.Errorf("ClientScopes.Update returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestClientScopesService_ProtocolMappers(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	clientScopeID := createClientScope(t, k, realm, "my-client-scope")
+
+	ctx := context.Background()
+
+	mapper := &ProtocolMapper{
+		Name:           String("department"),
+		Protocol:       String("openid-connect"),
+		ProtocolMapper: String("oidc-usermodel-attribute-mapper"),
+		Config: &map[string]string{
+			"user.attribute":       "department",
+			"claim.name":           "department",
+			"jsonType.label":       "String",
+			"access.token.claim":   "true",
+			"id.token.claim":       "true",
+			"userinfo.token.claim": "true",
+		},
+	}
+
+	res, err := k.ClientScopes.CreateProtocolMapper(ctx, realm, clientScopeID, mapper)
+	if err != nil {
+		t.Errorf("ClientScopes.CreateProtocolMapper returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+
+	mappers, _, err := k.ClientScopes.ListProtocolMappers(ctx, realm, clientScopeID)
+	if err != nil {
+		t.Errorf("ClientScopes.ListProtocolMappers returned error: %v", err)
+	}
+
+	if len(mappers) != 1 {
+		t.Fatalf("got: %d, want: %d", len(mappers), 1)
+	}
+
+	mapper, _, err = k.ClientScopes.GetProtocolMapper(ctx, realm, clientScopeID, *mappers[0].ID)
+	if err != nil {
+		t.Errorf("ClientScopes.GetProtocolMapper returned error: %v", err)
+	}
+
+	(*mapper.Config)["claim.name"] = "dept"
+
+	res, err = k.ClientScopes.UpdateProtocolMapper(ctx, realm, clientScopeID, mapper)
+	if err != nil {
+		t.Errorf("ClientScopes.UpdateProtocolMapper returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	res, err = k.ClientScopes.DeleteProtocolMapper(ctx, realm, clientScopeID, *mapper.ID)
+	if err != nil {
+		t.Errorf("ClientScopes.DeleteProtocolMapper returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestClientScopesService_RealmDefaultScopes(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	clientScopeID := createClientScope(t, k, realm, "my-client-scope")
+
+	ctx := context.Background()
+
+	res, err := k.ClientScopes.AddRealmOptionalScope(ctx, realm, clientScopeID)
+	if err != nil {
+		t.Errorf("ClientScopes.AddRealmOptionalScope returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	clientScopes, _, err := k.ClientScopes.ListRealmOptionalScopes(ctx, realm)
+	if err != nil {
+		t.Errorf("ClientScopes.ListRealmOptionalScopes returned error: %v", err)
+	}
+
+	found := false
+	for _, clientScope := range clientScopes {
+		found = found || *clientScope.ID == clientScopeID
+	}
+	if !found {
+		t.Errorf("client scope %s is not a realm optional client scope", clientScopeID)
+	}
+
+	res, err = k.ClientScopes.RemoveRealmOptionalScope(ctx, realm, clientScopeID)
+	if err != nil {
+		t.Errorf("ClientScopes.RemoveRealmOptionalScope returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestClientScopesService_ClientDefaultScopes(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	clientID := createClient(t, k, realm, "client")
+	clientScopeID := createClientScope(t, k, realm, "my-client-scope")
+
+	ctx := context.Background()
+
+	res, err := k.ClientScopes.AddClientDefaultScope(ctx, realm, clientID, clientScopeID)
+	if err != nil {
+		t.Errorf("ClientScopes.AddClientDefaultScope returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	clientScopes, _, err := k.ClientScopes.ListClientDefaultScopes(ctx, realm, clientID)
+	if err != nil {
+		t.Errorf("ClientScopes.ListClientDefaultScopes returned error: %v", err)
+	}
+
+	found := false
+	for _, clientScope := range clientScopes {
+		found = found || *clientScope.ID == clientScopeID
+	}
+	if !found {
+		t.Errorf("client scope %s is not a default client scope", clientScopeID)
+	}
+
+	res, err = k.ClientScopes.RemoveClientDefaultScope(ctx, realm, clientID, clientScopeID)
+	if err != nil {
+		t.Errorf("ClientScopes.RemoveClientDefaultScope returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestClientScopesService_AddRealmScopeMappings(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	clientScopeID := createClientScope(t, k, realm, "scope")
+	createRealmRole(t, k, realm, "role")
+
+	ctx := context.Background()
+
+	role, _, err := k.RealmRoles.GetByName(ctx, realm, "role")
+	if err != nil {
+		t.Errorf("RealmRoles.GetByName returned error: %v", err)
+	}
+
+	res, err := k.ClientScopes.AddRealmScopeMappings(ctx, realm, clientScopeID, []*Role{role})
+	if err != nil {
+		t.Errorf("ClientScopes.AddRealmScopeMappings returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	roles, _, err := k.ClientScopes.ListRealmScopeMappingsComposite(ctx, realm, clientScopeID)
+	if err != nil {
+		t.Errorf("ClientScopes.ListRealmScopeMappingsComposite returned error: %v", err)
+	}
+
+	if len(roles) != 1 || *roles[0].Name != "role" {
+		t.Errorf("got: %v, want: [role]", roles)
+	}
+}
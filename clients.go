@@ -1,8 +1,9 @@
 package keycloak
 
 import (
+	"bytes"
 	"context"
-	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -36,18 +37,57 @@ type Client struct {
 	PublicClient                       *bool              `json:"publicClient,omitempty"`
 	FrontchannelLogout                 *bool              `json:"frontchannelLogout,omitempty"`
 	Protocol                           *string            `json:"protocol,omitempty"`
+	ProtocolMappers                    []*ProtocolMapper  `json:"protocolMappers,omitempty"`
 	Attributes                         *map[string]string `json:"attributes,omitempty"`
 	AuthenticationFlowBindingOverrides *map[string]string `json:"authenticationFlowBindingOverrides,omitempty"`
 	FullScopeAllowed                   *bool              `json:"fullScopeAllowed,omitempty"`
 	NodeReRegistrationTimeout          *int               `json:"nodeReRegistrationTimeout,omitempty"`
+	RegisteredNodes                    *map[string]int    `json:"registeredNodes,omitempty"`
 	DefaultClientScopes                []string           `json:"defaultClientScopes,omitempty"`
 	OptionalClientScopes               []string           `json:"optionalClientScopes,omitempty"`
 	Access                             *map[string]bool   `json:"access,omitempty"`
+	RegistrationAccessToken            *string            `json:"registrationAccessToken,omitempty"`
+}
+
+// ClientInitialAccessCreate specifies how long an initial access token is
+// valid and how many clients may be registered with it.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/ClientInitialAccessCreatePresentation.java
+type ClientInitialAccessCreate struct {
+	Expiration *int `json:"expiration,omitempty"`
+	Count      *int `json:"count,omitempty"`
+}
+
+// ClientInitialAccess represents an initial access token. The token itself
+// is only returned on creation.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/ClientInitialAccessPresentation.java
+type ClientInitialAccess struct {
+	ID             *string `json:"id,omitempty"`
+	Token          *string `json:"token,omitempty"`
+	Timestamp      *int    `json:"timestamp,omitempty"`
+	Expiration     *int    `json:"expiration,omitempty"`
+	Count          *int    `json:"count,omitempty"`
+	RemainingCount *int    `json:"remainingCount,omitempty"`
+}
+
+// ClientListOptions specifies the optional parameters to the ClientsService.List method.
+type ClientListOptions struct {
+	ClientID     string `url:"clientId,omitempty"`
+	Q            string `url:"q,omitempty"`
+	Search       *bool  `url:"search,omitempty"`
+	ViewableOnly *bool  `url:"viewableOnly,omitempty"`
+	Options
 }
 
 // List all clients in realm.
-func (s *ClientsService) List(ctx context.Context, realm string) ([]*Client, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients", realm)
+func (s *ClientsService) List(ctx context.Context, realm string, opts *ClientListOptions) ([]*Client, *Response, error) {
+	u := pathf("admin/realms/%s/clients", realm)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -62,9 +102,34 @@ func (s *ClientsService) List(ctx context.Context, realm string) ([]*Client, *ht
 	return clients, res, nil
 }
 
+// ListAll lists all clients matching opts by requesting one page after
+// another until the result set is exhausted.
+func (s *ClientsService) ListAll(ctx context.Context, realm string, opts *ClientListOptions) ([]*Client, error) {
+	var o ClientListOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	var clients []*Client
+	err := Paginate(ctx, o.Options, func(ctx context.Context, page Options) (int, error) {
+		o.Options = page
+		next, _, err := s.List(ctx, realm, &o)
+		if err != nil {
+			return 0, err
+		}
+		clients = append(clients, next...)
+		return len(next), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return clients, nil
+}
+
 // Create a new client.
-func (s *ClientsService) Create(ctx context.Context, realm string, client *Client) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients", realm)
+func (s *ClientsService) Create(ctx context.Context, realm string, client *Client) (*Response, error) {
+	u := pathf("admin/realms/%s/clients", realm)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, client)
 	if err != nil {
 		return nil, err
@@ -74,8 +139,8 @@ func (s *ClientsService) Create(ctx context.Context, realm string, client *Clien
 }
 
 // Update a new client.
-func (s *ClientsService) Update(ctx context.Context, realm string, client *Client) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s", realm, *client.ID)
+func (s *ClientsService) Update(ctx context.Context, realm string, client *Client) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s", realm, *client.ID)
 	req, err := s.keycloak.NewRequest(http.MethodPut, u, client)
 	if err != nil {
 		return nil, err
@@ -85,8 +150,8 @@ func (s *ClientsService) Update(ctx context.Context, realm string, client *Clien
 }
 
 // Get client.
-func (s *ClientsService) Get(ctx context.Context, realm, id string) (*Client, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s", realm, id)
+func (s *ClientsService) Get(ctx context.Context, realm, id string) (*Client, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s", realm, id)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -101,14 +166,74 @@ func (s *ClientsService) Get(ctx context.Context, realm, id string) (*Client, *h
 	return &client, res, nil
 }
 
-// Delete ...
-func (s *ClientsService) Delete() {
+// GetByClientID gets a client by its client ID, i.e. the name used in
+// authentication requests. It returns a nil client if none matches.
+func (s *ClientsService) GetByClientID(ctx context.Context, realm, clientID string) (*Client, *Response, error) {
+	opts := &ClientListOptions{
+		ClientID: clientID,
+		Search:   Bool(false),
+	}
+
+	clients, res, err := s.List(ctx, realm, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, client := range clients {
+		if client.ClientID != nil && *client.ClientID == clientID {
+			return client, res, nil
+		}
+	}
+
+	return nil, res, nil
+}
+
+// ConvertClientDescription converts a client description into a client
+// representation ready to be passed to Create. The description is the JSON
+// of an OpenID Connect client registration or a Keycloak client, or the XML
+// of a SAML entity descriptor (SP metadata).
+func (s *ClientsService) ConvertClientDescription(ctx context.Context, realm string, description []byte) (*Client, *Response, error) {
+	u := pathf("admin/realms/%s/client-description-converter", realm)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the description is sent as is, Keycloak detects its format
+	req.ContentLength = int64(len(description))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(description)), nil
+	}
+	req.Body, _ = req.GetBody()
+	if bytes.HasPrefix(bytes.TrimSpace(description), []byte("<")) {
+		req.Header.Set("Content-Type", "application/xml")
+	} else {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	var client Client
+	res, err := s.keycloak.Do(ctx, req, &client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &client, res, nil
+}
+
+// Delete client.
+func (s *ClientsService) Delete(ctx context.Context, realm, id string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
 
+	return s.keycloak.Do(ctx, req, nil)
 }
 
 // GetSecret gets client secret.
-func (s *ClientsService) GetSecret(ctx context.Context, realm, id string) (*Credential, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/client-secret", realm, id)
+func (s *ClientsService) GetSecret(ctx context.Context, realm, id string) (*Credential, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/client-secret", realm, id)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -123,9 +248,16 @@ func (s *ClientsService) GetSecret(ctx context.Context, realm, id string) (*Cred
 	return &credential, res, nil
 }
 
-// CreateSecret generates a new secret for the client
-func (s *ClientsService) CreateSecret(ctx context.Context, realm, id string) (*Credential, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/client-secret", realm, id)
+// CreateSecret generates a new secret for the client.
+//
+// Deprecated: use RegenerateSecret instead.
+func (s *ClientsService) CreateSecret(ctx context.Context, realm, id string) (*Credential, *Response, error) {
+	return s.RegenerateSecret(ctx, realm, id)
+}
+
+// RegenerateSecret generates a new secret for the client.
+func (s *ClientsService) RegenerateSecret(ctx context.Context, realm, id string) (*Credential, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/client-secret", realm, id)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -140,8 +272,548 @@ func (s *ClientsService) CreateSecret(ctx context.Context, realm, id string) (*C
 	return &credential, res, nil
 }
 
-// Options ...
+// GetRotatedSecret gets the previous secret of the client, which remains
+// valid after a regeneration while the secret rotation client policy is
+// active. It returns ErrNotFound if there is no rotated secret.
+func (s *ClientsService) GetRotatedSecret(ctx context.Context, realm, id string) (*Credential, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/client-secret/rotated", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var credential Credential
+	res, err := s.keycloak.Do(ctx, req, &credential)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &credential, res, nil
+}
+
+// InvalidateRotatedSecret invalidates the previous secret of the client
+// before its rotation period ends, e.g. once all consumers use the new one.
+func (s *ClientsService) InvalidateRotatedSecret(ctx context.Context, realm, id string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/client-secret/rotated", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ClientSecrets are the secrets of a client after RotateSecret.
+type ClientSecrets struct {
+	Current *Credential
+
+	// Rotated is the previous secret, nil if the realm has no active secret
+	// rotation policy and the previous secret is already invalid.
+	Rotated *Credential
+}
+
+// RotateSecret regenerates the secret of the client and returns the new
+// secret together with the rotated one. The returned response is the one of
+// the regeneration.
+func (s *ClientsService) RotateSecret(ctx context.Context, realm, id string) (*ClientSecrets, *Response, error) {
+	current, res, err := s.RegenerateSecret(ctx, realm, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rotated, _, err := s.GetRotatedSecret(ctx, realm, id)
+	if err != nil && !IsNotFound(err) {
+		return nil, nil, err
+	}
+
+	return &ClientSecrets{Current: current, Rotated: rotated}, res, nil
+}
+
+// GetServiceAccountUser gets the user dedicated to the service account of the client.
+func (s *ClientsService) GetServiceAccountUser(ctx context.Context, realm, id string) (*User, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/service-account-user", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user User
+	res, err := s.keycloak.Do(ctx, req, &user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &user, res, nil
+}
+
+// ListUserSessions lists the active user sessions of the client. To log the
+// users out pass the session ids to SessionsService.Delete, which ends the
+// whole SSO session, not only the one of the client.
+func (s *ClientsService) ListUserSessions(ctx context.Context, realm, id string, opts *Options) ([]*UserSession, *Response, error) {
+	return s.listSessions(ctx, pathf("admin/realms/%s/clients/%s/user-sessions", realm, id), opts)
+}
+
+// ListOfflineSessions lists the offline sessions of the client, i.e. the
+// sessions of its offline tokens.
+func (s *ClientsService) ListOfflineSessions(ctx context.Context, realm, id string, opts *Options) ([]*UserSession, *Response, error) {
+	return s.listSessions(ctx, pathf("admin/realms/%s/clients/%s/offline-sessions", realm, id), opts)
+}
+
+func (s *ClientsService) listSessions(ctx context.Context, u string, opts *Options) ([]*UserSession, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sessions []*UserSession
+	res, err := s.keycloak.Do(ctx, req, &sessions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sessions, res, nil
+}
+
+// GetSessionCount returns the number of active user sessions of the client.
+func (s *ClientsService) GetSessionCount(ctx context.Context, realm, id string) (int, *Response, error) {
+	return s.count(ctx, pathf("admin/realms/%s/clients/%s/session-count", realm, id))
+}
+
+// GetOfflineSessionCount returns the number of offline sessions of the
+// client.
+func (s *ClientsService) GetOfflineSessionCount(ctx context.Context, realm, id string) (int, *Response, error) {
+	return s.count(ctx, pathf("admin/realms/%s/clients/%s/offline-session-count", realm, id))
+}
+
+func (s *ClientsService) count(ctx context.Context, u string) (int, *Response, error) {
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var count struct {
+		Count int `json:"count"`
+	}
+	res, err := s.keycloak.Do(ctx, req, &count)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return count.Count, res, nil
+}
+
+// AddServiceAccountRealmRoles looks up the realm roles by name and assigns
+// them to the service account user of the client.
+func (s *ClientsService) AddServiceAccountRealmRoles(ctx context.Context, realm, id string, roleNames ...string) (*Response, error) {
+	user, _, err := s.GetServiceAccountUser(ctx, realm, id)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]*Role, 0, len(roleNames))
+	for _, name := range roleNames {
+		role, _, err := s.keycloak.RealmRoles.GetByName(ctx, realm, name)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+
+	return s.keycloak.Users.AddRealmRoles(ctx, realm, *user.ID, roles)
+}
+
+// AddServiceAccountClientRoles looks up the roles of the client roleClientID
+// by name and assigns them to the service account user of the client id.
+func (s *ClientsService) AddServiceAccountClientRoles(ctx context.Context, realm, id, roleClientID string, roleNames ...string) (*Response, error) {
+	user, _, err := s.GetServiceAccountUser(ctx, realm, id)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]*Role, 0, len(roleNames))
+	for _, name := range roleNames {
+		role, _, err := s.keycloak.ClientRoles.Get(ctx, realm, roleClientID, name)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+
+	return s.keycloak.Users.AddClientRoles(ctx, realm, *user.ID, roleClientID, roles)
+}
+
+// Options specifies the optional pagination parameters shared by list methods.
 type Options struct {
-	First int    `url:"first,omitempty"`
-	Max   string `url:"max,omitempty"`
+	First int `url:"first,omitempty"`
+	Max   int `url:"max,omitempty"`
+}
+
+// ListProtocolMappers lists all protocol mappers of the client.
+func (s *ClientsService) ListProtocolMappers(ctx context.Context, realm, id string) ([]*ProtocolMapper, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/protocol-mappers/models", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mappers []*ProtocolMapper
+	res, err := s.keycloak.Do(ctx, req, &mappers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mappers, res, nil
+}
+
+// CreateProtocolMapper creates a new protocol mapper in the client.
+func (s *ClientsService) CreateProtocolMapper(ctx context.Context, realm, id string, mapper *ProtocolMapper) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/protocol-mappers/models", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, mapper)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// AddProtocolMappers creates multiple protocol mappers in the client at once.
+func (s *ClientsService) AddProtocolMappers(ctx context.Context, realm, id string, mappers []*ProtocolMapper) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/protocol-mappers/add-models", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, mappers)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// GetProtocolMapper gets a single protocol mapper of the client.
+func (s *ClientsService) GetProtocolMapper(ctx context.Context, realm, id, mapperID string) (*ProtocolMapper, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/protocol-mappers/models/%s", realm, id, mapperID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mapper ProtocolMapper
+	res, err := s.keycloak.Do(ctx, req, &mapper)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mapper, res, nil
+}
+
+// UpdateProtocolMapper updates a protocol mapper of the client.
+func (s *ClientsService) UpdateProtocolMapper(ctx context.Context, realm, id string, mapper *ProtocolMapper) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/protocol-mappers/models/%s", realm, id, *mapper.ID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, mapper)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// DeleteProtocolMapper deletes a protocol mapper of the client.
+func (s *ClientsService) DeleteProtocolMapper(ctx context.Context, realm, id, mapperID string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/protocol-mappers/models/%s", realm, id, mapperID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// GetManagementPermissions returns whether fine-grained admin permissions are
+// enabled for the client.
+func (s *ClientsService) GetManagementPermissions(ctx context.Context, realm, id string) (*ManagementPermissionReference, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/management/permissions", realm, id)
+	return getManagementPermissions(ctx, s.keycloak, u)
+}
+
+// SetManagementPermissions enables or disables fine-grained admin permissions
+// for the client.
+func (s *ClientsService) SetManagementPermissions(ctx context.Context, realm, id string, ref *ManagementPermissionReference) (*ManagementPermissionReference, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/management/permissions", realm, id)
+	return setManagementPermissions(ctx, s.keycloak, u, ref)
+}
+
+// CreateInitialAccessToken creates an initial access token used to register
+// clients with the ClientRegistrationService.
+func (s *ClientsService) CreateInitialAccessToken(ctx context.Context, realm string, opts *ClientInitialAccessCreate) (*ClientInitialAccess, *Response, error) {
+	u := pathf("admin/realms/%s/clients-initial-access", realm)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var token ClientInitialAccess
+	res, err := s.keycloak.Do(ctx, req, &token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &token, res, nil
+}
+
+// ListInitialAccessTokens lists the initial access tokens of the realm.
+func (s *ClientsService) ListInitialAccessTokens(ctx context.Context, realm string) ([]*ClientInitialAccess, *Response, error) {
+	u := pathf("admin/realms/%s/clients-initial-access", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tokens []*ClientInitialAccess
+	res, err := s.keycloak.Do(ctx, req, &tokens)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tokens, res, nil
+}
+
+// DeleteInitialAccessToken deletes an initial access token.
+func (s *ClientsService) DeleteInitialAccessToken(ctx context.Context, realm, id string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients-initial-access/%s", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// RegisterNode registers a cluster node of the client manually. Nodes are
+// usually registered by the adapter of the application at startup.
+func (s *ClientsService) RegisterNode(ctx context.Context, realm, id, node string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/nodes", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, map[string]string{"node": node})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// UnregisterNode unregisters a cluster node of the client.
+func (s *ClientsService) UnregisterNode(ctx context.Context, realm, id, node string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/nodes/%s", realm, id, node)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// TestNodesAvailable tests whether the registered cluster nodes of the
+// client are reachable through its admin url.
+func (s *ClientsService) TestNodesAvailable(ctx context.Context, realm, id string) (*GlobalRequestResult, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/test-nodes-available", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result GlobalRequestResult
+	res, err := s.keycloak.Do(ctx, req, &result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &result, res, nil
+}
+
+// RegenerateRegistrationAccessToken invalidates the registration access
+// token of the client and returns the client with a new one.
+func (s *ClientsService) RegenerateRegistrationAccessToken(ctx context.Context, realm, id string) (*Client, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/registration-access-token", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var client Client
+	res, err := s.keycloak.Do(ctx, req, &client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &client, res, nil
+}
+
+// GetAllScopeMappings returns the realm and client roles in the scope of the client.
+func (s *ClientsService) GetAllScopeMappings(ctx context.Context, realm, id string) (*RoleMappings, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/scope-mappings", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mappings RoleMappings
+	res, err := s.keycloak.Do(ctx, req, &mappings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mappings, res, nil
+}
+
+// ListRealmScopeMappings lists the realm roles in the scope of the client.
+func (s *ClientsService) ListRealmScopeMappings(ctx context.Context, realm, id string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/scope-mappings/realm", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// AddRealmScopeMappings adds realm roles to the scope of the client.
+func (s *ClientsService) AddRealmScopeMappings(ctx context.Context, realm, id string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/scope-mappings/realm", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// RemoveRealmScopeMappings removes realm roles from the scope of the client.
+func (s *ClientsService) RemoveRealmScopeMappings(ctx context.Context, realm, id string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/scope-mappings/realm", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListAvailableRealmScopeMappings lists the realm roles that can still be added to the scope of the client.
+func (s *ClientsService) ListAvailableRealmScopeMappings(ctx context.Context, realm, id string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/scope-mappings/realm/available", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// ListRealmScopeMappingsComposite lists the effective realm roles in the scope of the client,
+// including composite roles.
+func (s *ClientsService) ListRealmScopeMappingsComposite(ctx context.Context, realm, id string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/scope-mappings/realm/composite", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// ListClientScopeMappings lists the roles of client roleClientID in the scope of the
+// client.
+func (s *ClientsService) ListClientScopeMappings(ctx context.Context, realm, id, roleClientID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/scope-mappings/clients/%s", realm, id, roleClientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// AddClientScopeMappings adds roles of client roleClientID to the scope of the client.
+func (s *ClientsService) AddClientScopeMappings(ctx context.Context, realm, id, roleClientID string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/scope-mappings/clients/%s", realm, id, roleClientID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// RemoveClientScopeMappings removes roles of client roleClientID from the scope of the
+// client.
+func (s *ClientsService) RemoveClientScopeMappings(ctx context.Context, realm, id, roleClientID string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/scope-mappings/clients/%s", realm, id, roleClientID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListAvailableClientScopeMappings lists the roles of client roleClientID that can
+// still be added to the scope of the client.
+func (s *ClientsService) ListAvailableClientScopeMappings(ctx context.Context, realm, id, roleClientID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/scope-mappings/clients/%s/available", realm, id, roleClientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// ListClientScopeMappingsComposite lists the effective roles of client roleClientID
+// in the scope of the client, including composite roles.
+func (s *ClientsService) ListClientScopeMappingsComposite(ctx context.Context, realm, id, roleClientID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/scope-mappings/clients/%s/composite", realm, id, roleClientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
 }
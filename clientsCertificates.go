@@ -0,0 +1,194 @@
+package keycloak
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// Certificate attributes of clients, i.e. which of the keys of a client a
+// certificate method works on.
+const (
+	// CertificateAttributeJWT is the key used to verify the signed JWTs of
+	// clients authenticating with "Signed Jwt".
+	CertificateAttributeJWT = "jwt.credential"
+
+	CertificateAttributeSAMLSigning    = "saml.signing"
+	CertificateAttributeSAMLEncryption = "saml.encryption"
+)
+
+// Formats of keystores and certificates. Only the keystore formats can be
+// used with UploadCertificateAndKey and DownloadKeystore.
+const (
+	KeyStoreFormatJKS    = "JKS"
+	KeyStoreFormatPKCS12 = "PKCS12"
+	KeyStoreFormatBCFKS  = "BCFKS"
+
+	CertificateFormatPEM  = "Certificate PEM"
+	PublicKeyFormatPEM    = "Public Key PEM"
+	CertificateFormatJWKS = "JSON Web Key Set"
+)
+
+// CertificateInfo is a key of a client. The private key is only returned
+// when it was generated by Keycloak.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/idm/CertificateRepresentation.java
+type CertificateInfo struct {
+	PrivateKey  *string `json:"privateKey,omitempty"`
+	PublicKey   *string `json:"publicKey,omitempty"`
+	Certificate *string `json:"certificate,omitempty"`
+	Kid         *string `json:"kid,omitempty"`
+}
+
+// KeyStoreConfig specifies the keystore created by DownloadKeystore.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/KeyStoreConfig.java
+type KeyStoreConfig struct {
+	// RealmCertificate adds the certificate of the realm under RealmAlias.
+	RealmCertificate *bool   `json:"realmCertificate,omitempty"`
+	StorePassword    *string `json:"storePassword,omitempty"`
+	KeyPassword      *string `json:"keyPassword,omitempty"`
+	KeyAlias         *string `json:"keyAlias,omitempty"`
+	RealmAlias       *string `json:"realmAlias,omitempty"`
+
+	// Format is e.g. KeyStoreFormatPKCS12.
+	Format *string `json:"format,omitempty"`
+}
+
+// CertificateUpload is a certificate or keystore uploaded to a client.
+type CertificateUpload struct {
+	// Format is a keystore format like KeyStoreFormatPKCS12 or, when only
+	// the certificate is uploaded, one of CertificateFormatPEM,
+	// PublicKeyFormatPEM and CertificateFormatJWKS.
+	Format string
+
+	// KeyAlias, KeyPassword and StorePassword are only needed for keystores.
+	KeyAlias      string
+	KeyPassword   string
+	StorePassword string
+
+	File []byte
+}
+
+// GetKeyInfo gets the key of the client stored under attr, e.g.
+// CertificateAttributeJWT.
+func (s *ClientsService) GetKeyInfo(ctx context.Context, realm, id, attr string) (*CertificateInfo, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/certificates/%s", realm, id, attr)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var info CertificateInfo
+	res, err := s.keycloak.Do(ctx, req, &info)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &info, res, nil
+}
+
+// GenerateCertificate generates a new key pair and self signed certificate
+// for the client, replacing the one stored under attr. The returned info
+// contains the private key, which Keycloak does not keep.
+func (s *ClientsService) GenerateCertificate(ctx context.Context, realm, id, attr string) (*CertificateInfo, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/certificates/%s/generate", realm, id, attr)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var info CertificateInfo
+	res, err := s.keycloak.Do(ctx, req, &info)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &info, res, nil
+}
+
+// UploadCertificate replaces the certificate stored under attr. Only the
+// certificate or public key is taken from the upload, the private key of a
+// keystore is ignored.
+func (s *ClientsService) UploadCertificate(ctx context.Context, realm, id, attr string, upload *CertificateUpload) (*CertificateInfo, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/certificates/%s/upload-certificate", realm, id, attr)
+	return s.uploadCertificate(ctx, u, upload)
+}
+
+// UploadCertificateAndKey replaces the certificate and private key stored
+// under attr with the ones from a keystore.
+func (s *ClientsService) UploadCertificateAndKey(ctx context.Context, realm, id, attr string, upload *CertificateUpload) (*CertificateInfo, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/certificates/%s/upload", realm, id, attr)
+	return s.uploadCertificate(ctx, u, upload)
+}
+
+func (s *ClientsService) uploadCertificate(ctx context.Context, u string, upload *CertificateUpload) (*CertificateInfo, *Response, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	fields := [][2]string{
+		{"keystoreFormat", upload.Format},
+		{"keyAlias", upload.KeyAlias},
+		{"keyPassword", upload.KeyPassword},
+		{"storePassword", upload.StorePassword},
+	}
+	for _, field := range fields {
+		if field[1] == "" {
+			continue
+		}
+		if err := w.WriteField(field[0], field[1]); err != nil {
+			return nil, nil, err
+		}
+	}
+	file, err := w.CreateFormFile("file", "file")
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := file.Write(upload.File); err != nil {
+		return nil, nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := body.Bytes()
+	req.ContentLength = int64(len(b))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(b)), nil
+	}
+	req.Body, _ = req.GetBody()
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	var info CertificateInfo
+	res, err := s.keycloak.Do(ctx, req, &info)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &info, res, nil
+}
+
+// DownloadKeystore returns a keystore with the certificate and, if Keycloak
+// has it, the private key stored under attr.
+func (s *ClientsService) DownloadKeystore(ctx context.Context, realm, id, attr string, config *KeyStoreConfig) ([]byte, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/certificates/%s/download", realm, id, attr)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, config)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+
+	var keystore bytes.Buffer
+	res, err := s.keycloak.Do(ctx, req, &keystore)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return keystore.Bytes(), res, nil
+}
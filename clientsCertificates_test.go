@@ -0,0 +1,133 @@
+package keycloak
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientsService_GenerateCertificate(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	id := createClient(t, k, realm, "client")
+
+	ctx := context.Background()
+
+	generated, res, err := k.Clients.GenerateCertificate(ctx, realm, id, CertificateAttributeJWT)
+	if err != nil {
+		t.Errorf("Clients.GenerateCertificate returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if generated.GetPrivateKey() == "" {
+		t.Errorf("got: empty private key, want: generated private key")
+	}
+
+	info, _, err := k.Clients.GetKeyInfo(ctx, realm, id, CertificateAttributeJWT)
+	if err != nil {
+		t.Errorf("Clients.GetKeyInfo returned error: %v", err)
+	}
+
+	if info.GetCertificate() != generated.GetCertificate() {
+		t.Errorf("got: %s, want: %s", info.GetCertificate(), generated.GetCertificate())
+	}
+
+	keystore, _, err := k.Clients.DownloadKeystore(ctx, realm, id, CertificateAttributeJWT, &KeyStoreConfig{
+		Format:        String(KeyStoreFormatPKCS12),
+		KeyAlias:      String("client"),
+		KeyPassword:   String("password"),
+		StorePassword: String("password"),
+	})
+	if err != nil {
+		t.Errorf("Clients.DownloadKeystore returned error: %v", err)
+	}
+
+	if len(keystore) == 0 {
+		t.Errorf("got: empty keystore, want: keystore")
+	}
+}
+
+func TestClientsService_UploadCertificateAndKey(t *testing.T) {
+	keystore := []byte{0x30, 0x82, 0x0a, 0x00}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/admin/realms/first/clients/id/certificates/jwt.credential/upload":
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Error(err)
+				return
+			}
+			for key, want := range map[string]string{
+				"keystoreFormat": KeyStoreFormatPKCS12,
+				"keyAlias":       "client",
+				"storePassword":  "secret",
+			} {
+				if got := r.FormValue(key); got != want {
+					t.Errorf("%s: got: %s, want: %s", key, got, want)
+				}
+			}
+			if _, ok := r.MultipartForm.Value["keyPassword"]; ok {
+				t.Errorf("got: keyPassword, want: empty fields omitted")
+			}
+			file, _, err := r.FormFile("file")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			b, _ := io.ReadAll(file)
+			if !bytes.Equal(b, keystore) {
+				t.Errorf("got: %x, want: %x", b, keystore)
+			}
+			json.NewEncoder(w).Encode(&CertificateInfo{Certificate: String("certificate")})
+		case "/admin/realms/first/clients/id/certificates/jwt.credential/download":
+			var config KeyStoreConfig
+			json.NewDecoder(r.Body).Decode(&config)
+			if config.GetFormat() != KeyStoreFormatJKS {
+				t.Errorf("got: %s, want: %s", config.GetFormat(), KeyStoreFormatJKS)
+			}
+			w.Write(keystore)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	info, _, err := k.Clients.UploadCertificateAndKey(ctx, "first", "id", CertificateAttributeJWT, &CertificateUpload{
+		Format:        KeyStoreFormatPKCS12,
+		KeyAlias:      "client",
+		StorePassword: "secret",
+		File:          keystore,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.GetCertificate() != "certificate" {
+		t.Errorf("got: %s, want: %s", info.GetCertificate(), "certificate")
+	}
+
+	downloaded, _, err := k.Clients.DownloadKeystore(ctx, "first", "id", CertificateAttributeJWT, &KeyStoreConfig{
+		Format: String(KeyStoreFormatJKS),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(downloaded, keystore) {
+		t.Errorf("got: %x, want: %x", downloaded, keystore)
+	}
+}
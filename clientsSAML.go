@@ -0,0 +1,178 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Name ID formats of SAML clients.
+const (
+	SAMLNameIDFormatUsername   = "username"
+	SAMLNameIDFormatEmail      = "email"
+	SAMLNameIDFormatTransient  = "transient"
+	SAMLNameIDFormatPersistent = "persistent"
+)
+
+// Signature algorithms of SAML clients.
+const (
+	SAMLSignatureAlgorithmRSASHA1   = "RSA_SHA1"
+	SAMLSignatureAlgorithmRSASHA256 = "RSA_SHA256"
+	SAMLSignatureAlgorithmRSASHA512 = "RSA_SHA512"
+	SAMLSignatureAlgorithmDSASHA1   = "DSA_SHA1"
+)
+
+// SAMLAttributes are the SAML settings of a client, which Keycloak stores in
+// the client attributes. Unset fields are left unchanged by
+// Client.SetSAMLAttributes.
+type SAMLAttributes struct {
+	// AuthnStatement includes the authentication method and time in the
+	// assertion.
+	AuthnStatement *bool `saml:"saml.authnstatement"`
+
+	// ServerSignature signs the SAML documents.
+	ServerSignature *bool `saml:"saml.server.signature"`
+
+	// ServerSignatureKeyInfoExt adds the KeyName extension to signed
+	// documents.
+	ServerSignatureKeyInfoExt *bool `saml:"saml.server.signature.keyinfo.ext"`
+
+	// AssertionSignature signs the assertions.
+	AssertionSignature *bool `saml:"saml.assertion.signature"`
+
+	// SignatureAlgorithm is e.g. SAMLSignatureAlgorithmRSASHA256.
+	SignatureAlgorithm *string `saml:"saml.signature.algorithm"`
+
+	// SignatureKeyName is NONE, KEY_ID or CERT_SUBJECT.
+	SignatureKeyName *string `saml:"saml_signature_key_name"`
+
+	CanonicalizationMethod *string `saml:"saml_signature_canonicalization_method"`
+
+	// ClientSignatureRequired requires the requests of the client to be
+	// signed with SigningCertificate.
+	ClientSignatureRequired *bool `saml:"saml.client.signature"`
+
+	// SigningCertificate is the base64 encoded DER certificate of the
+	// client.
+	SigningCertificate *string `saml:"saml.signing.certificate"`
+
+	// Encrypt encrypts the assertions with EncryptionCertificate.
+	Encrypt *bool `saml:"saml.encrypt"`
+
+	// EncryptionCertificate is the base64 encoded DER certificate of the
+	// client.
+	EncryptionCertificate *string `saml:"saml.encryption.certificate"`
+
+	ForcePostBinding *bool `saml:"saml.force.post.binding"`
+
+	// ForceNameIDFormat ignores the name id policy of requests.
+	ForceNameIDFormat *bool `saml:"saml_force_name_id_format"`
+
+	// NameIDFormat is e.g. SAMLNameIDFormatEmail.
+	NameIDFormat *string `saml:"saml_name_id_format"`
+
+	OneTimeUseCondition *bool `saml:"saml.onetimeuse.condition"`
+	ArtifactBinding     *bool `saml:"saml.artifact.binding"`
+	AllowECPFlow        *bool `saml:"saml.allow.ecp.flow"`
+
+	// AssertionLifespan in seconds.
+	AssertionLifespan *int `saml:"saml.assertion.lifespan"`
+
+	// Assertion consumer service URLs per binding.
+	AssertionConsumerURLPost     *string `saml:"saml_assertion_consumer_url_post"`
+	AssertionConsumerURLRedirect *string `saml:"saml_assertion_consumer_url_redirect"`
+	AssertionConsumerURLArtifact *string `saml:"saml_assertion_consumer_url_artifact"`
+
+	// Single logout service URLs per binding.
+	SingleLogoutServiceURLPost     *string `saml:"saml_single_logout_service_url_post"`
+	SingleLogoutServiceURLRedirect *string `saml:"saml_single_logout_service_url_redirect"`
+	SingleLogoutServiceURLArtifact *string `saml:"saml_single_logout_service_url_artifact"`
+	SingleLogoutServiceURLSOAP     *string `saml:"saml_single_logout_service_url_soap"`
+
+	ArtifactBindingURL *string `saml:"saml_artifact_binding_url"`
+
+	// IDPInitiatedSSOURLName enables IdP initiated login at
+	// /realms/{realm}/protocol/saml/clients/{name}.
+	IDPInitiatedSSOURLName    *string `saml:"saml_idp_initiated_sso_url_name"`
+	IDPInitiatedSSORelayState *string `saml:"saml_idp_initiated_sso_relay_state"`
+}
+
+// SAMLAttributes returns the SAML settings stored in the client attributes.
+// Settings missing from the attributes are nil.
+func (c *Client) SAMLAttributes() *SAMLAttributes {
+	a := &SAMLAttributes{}
+	attributes := c.GetAttributes()
+
+	v := reflect.ValueOf(a).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		value, ok := attributes[v.Type().Field(i).Tag.Get("saml")]
+		if !ok {
+			continue
+		}
+		var parsed interface{}
+		switch v.Field(i).Interface().(type) {
+		case *bool:
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				continue
+			}
+			parsed = &b
+		case *int:
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				continue
+			}
+			parsed = &n
+		case *string:
+			parsed = &value
+		}
+		v.Field(i).Set(reflect.ValueOf(parsed))
+	}
+	return a
+}
+
+// SetSAMLAttributes stores the set fields of a in the client attributes.
+func (c *Client) SetSAMLAttributes(a *SAMLAttributes) {
+	if c.Attributes == nil {
+		c.Attributes = &map[string]string{}
+	}
+
+	v := reflect.ValueOf(a).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).IsNil() {
+			continue
+		}
+		key := v.Type().Field(i).Tag.Get("saml")
+		(*c.Attributes)[key] = fmt.Sprint(v.Field(i).Elem().Interface())
+	}
+}
+
+// CreateSAMLFromMetadata creates a SAML client from the SP metadata XML of a
+// service provider. The ACS and logout URLs, certificates and signing
+// settings are taken from the metadata, use modify to adjust the client
+// before it is created, e.g. to set its name. Modify may be nil.
+func (s *ClientsService) CreateSAMLFromMetadata(ctx context.Context, realm string, metadata []byte, modify func(*Client)) (*Client, *Response, error) {
+	client, _, err := s.ConvertClientDescription(ctx, realm, metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+	if client.GetProtocol() != ProtocolSAML {
+		return nil, nil, fmt.Errorf("keycloak: metadata describes a %q client, not a SAML service provider", client.GetProtocol())
+	}
+	if modify != nil {
+		modify(client)
+	}
+
+	res, err := s.Create(ctx, realm, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created, _, err := s.Get(ctx, realm, res.LocationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return created, res, nil
+}
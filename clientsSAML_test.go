@@ -0,0 +1,107 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+const spMetadata = `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp.example.com">
+  <md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/acs" index="0"/>
+  </md:SPSSODescriptor>
+</md:EntityDescriptor>`
+
+func TestClient_SAMLAttributes(t *testing.T) {
+	client := &Client{Attributes: &map[string]string{
+		"saml.assertion.signature":         "true",
+		"saml_name_id_format":              "email",
+		"saml.assertion.lifespan":          "300",
+		"saml_assertion_consumer_url_post": "https://sp.example.com/acs",
+		"saml.encrypt":                     "invalid",
+		"pkce.code.challenge.method":       "S256",
+	}}
+
+	got := client.SAMLAttributes()
+	want := &SAMLAttributes{
+		AssertionSignature:       Bool(true),
+		NameIDFormat:             String(SAMLNameIDFormatEmail),
+		AssertionLifespan:        Int(300),
+		AssertionConsumerURLPost: String("https://sp.example.com/acs"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %+v, want: %+v", got, want)
+	}
+
+	client.SetSAMLAttributes(&SAMLAttributes{
+		AssertionSignature:      Bool(false),
+		ClientSignatureRequired: Bool(true),
+		AssertionLifespan:       Int(60),
+	})
+	attributes := client.GetAttributes()
+	for key, want := range map[string]string{
+		"saml.assertion.signature":   "false",
+		"saml.client.signature":      "true",
+		"saml.assertion.lifespan":    "60",
+		"saml_name_id_format":        "email",
+		"pkce.code.challenge.method": "S256",
+	} {
+		if got := attributes[key]; got != want {
+			t.Errorf("%s: got: %s, want: %s", key, got, want)
+		}
+	}
+}
+
+func TestClientsService_CreateSAMLFromMetadata(t *testing.T) {
+	var created *Client
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/admin/realms/first/client-description-converter":
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != spMetadata {
+				t.Errorf("got: %s, want: %s", body, spMetadata)
+			}
+			if got := r.Header.Get("Content-Type"); got != "application/xml" {
+				t.Errorf("got: %s, want: %s", got, "application/xml")
+			}
+			json.NewEncoder(w).Encode(&Client{
+				ClientID:   String("https://sp.example.com"),
+				Protocol:   String(ProtocolSAML),
+				Attributes: &map[string]string{"saml_assertion_consumer_url_post": "https://sp.example.com/acs"},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/admin/realms/first/clients":
+			created = &Client{}
+			json.NewDecoder(r.Body).Decode(created)
+			created.ID = String("id")
+			w.Header().Set("Location", "http://"+r.Host+"/admin/realms/first/clients/id")
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/admin/realms/first/clients/id":
+			json.NewEncoder(w).Encode(created)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, _, err := k.Clients.CreateSAMLFromMetadata(context.Background(), "first", []byte(spMetadata), func(c *Client) {
+		c.Name = String("Service Provider")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.GetID() != "id" || client.GetName() != "Service Provider" {
+		t.Errorf("got: %+v", client)
+	}
+	if got := client.SAMLAttributes().GetAssertionConsumerURLPost(); got != "https://sp.example.com/acs" {
+		t.Errorf("got: %s, want: %s", got, "https://sp.example.com/acs")
+	}
+}
@@ -59,7 +59,7 @@ func TestClientsService_List(t *testing.T) {
 	realm := "first"
 	createRealm(t, k, realm)
 
-	clients, res, err := k.Clients.List(context.Background(), realm)
+	clients, res, err := k.Clients.List(context.Background(), realm, nil)
 	if err != nil {
 		t.Errorf("Clients.List returned error: %v", err)
 	}
@@ -96,6 +96,103 @@ func TestClientsService_Get(t *testing.T) {
 	}
 }
 
+func TestClientsService_GetByClientID(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	id := createClient(t, k, realm, "client")
+
+	client, res, err := k.Clients.GetByClientID(context.Background(), realm, "client")
+	if err != nil {
+		t.Errorf("Clients.GetByClientID returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if *client.ID != id {
+		t.Errorf("got: %s, want: %s", *client.ID, id)
+	}
+
+	client, _, err = k.Clients.GetByClientID(context.Background(), realm, "unknown")
+	if err != nil {
+		t.Errorf("Clients.GetByClientID returned error: %v", err)
+	}
+
+	if client != nil {
+		t.Errorf("got: %v, want: nil", client)
+	}
+}
+
+func TestClientsService_ConvertClientDescription(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	description := `{"client_name": "converted", "redirect_uris": ["http://localhost:4200/*"]}`
+	client, res, err := k.Clients.ConvertClientDescription(context.Background(), realm, []byte(description))
+	if err != nil {
+		t.Errorf("Clients.ConvertClientDescription returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if client.GetName() != "converted" || client.GetProtocol() != ProtocolOpenIDConnect {
+		t.Errorf("got: %s %s, want: converted %s", client.GetName(), client.GetProtocol(), ProtocolOpenIDConnect)
+	}
+}
+
+func TestClientsService_Update(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	id := createClient(t, k, realm, "client")
+
+	ctx := context.Background()
+
+	client, _, err := k.Clients.Get(ctx, realm, id)
+	if err != nil {
+		t.Errorf("Clients.Get returned error: %v", err)
+	}
+
+	client.Name = String("name")
+
+	res, err := k.Clients.Update(ctx, realm, client)
+	if err != nil {
+		t.Errorf("Clients.Update returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestClientsService_Delete(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	id := createClient(t, k, realm, "client")
+
+	res, err := k.Clients.Delete(context.Background(), realm, id)
+	if err != nil {
+		t.Errorf("Clients.Delete returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
 func TestClientsService_GetSecret(t *testing.T) {
 	k := client(t)
 
@@ -155,3 +252,512 @@ func TestClientsService_CreateSecret(t *testing.T) {
 		t.Errorf("got: %t, want: %t", credential.Value == next.Value, credential.Value != next.Value)
 	}
 }
+
+func TestClientsService_RegenerateSecret(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	clientID := createClient(t, k, realm, "client")
+
+	ctx := context.Background()
+	credential, _, err := k.Clients.GetSecret(ctx, realm, clientID)
+	if err != nil {
+		t.Errorf("Clients.GetSecret returned error: %v", err)
+	}
+
+	next, res, err := k.Clients.RegenerateSecret(ctx, realm, clientID)
+	if err != nil {
+		t.Errorf("Clients.RegenerateSecret returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if *credential.Value == *next.Value {
+		t.Errorf("got: %s, want a different secret", *next.Value)
+	}
+}
+
+func TestClientsService_RotateSecret(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	clientID := createClient(t, k, realm, "client")
+
+	ctx := context.Background()
+	credential, _, err := k.Clients.GetSecret(ctx, realm, clientID)
+	if err != nil {
+		t.Errorf("Clients.GetSecret returned error: %v", err)
+	}
+
+	secrets, res, err := k.Clients.RotateSecret(ctx, realm, clientID)
+	if err != nil {
+		t.Errorf("Clients.RotateSecret returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if secrets.Current.GetValue() == credential.GetValue() {
+		t.Errorf("got: %s, want a different secret", secrets.Current.GetValue())
+	}
+
+	// without a secret rotation policy the previous secret is not kept
+	if secrets.Rotated != nil {
+		t.Errorf("got: %v, want: nil", secrets.Rotated)
+	}
+
+	if _, _, err := k.Clients.GetRotatedSecret(ctx, realm, clientID); !IsNotFound(err) {
+		t.Errorf("got: %v, want: %v", err, ErrNotFound)
+	}
+
+	res, err = k.Clients.InvalidateRotatedSecret(ctx, realm, clientID)
+	if err != nil {
+		t.Errorf("Clients.InvalidateRotatedSecret returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestClientsService_GetServiceAccountUser(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	clientID := createClient(t, k, realm, "client")
+
+	user, res, err := k.Clients.GetServiceAccountUser(context.Background(), realm, clientID)
+	if err != nil {
+		t.Errorf("Clients.GetServiceAccountUser returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if *user.Username != "service-account-client" {
+		t.Errorf("got: %s, want: %s", *user.Username, "service-account-client")
+	}
+}
+
+func TestClientsService_ProtocolMappers(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	id := createClient(t, k, realm, "client")
+
+	ctx := context.Background()
+
+	mapper := &ProtocolMapper{
+		Name:           String("department"),
+		Protocol:       String("openid-connect"),
+		ProtocolMapper: String("oidc-usermodel-attribute-mapper"),
+		Config: &map[string]string{
+			"user.attribute":       "department",
+			"claim.name":           "department",
+			"jsonType.label":       "String",
+			"access.token.claim":   "true",
+			"id.token.claim":       "true",
+			"userinfo.token.claim": "true",
+		},
+	}
+
+	res, err := k.Clients.CreateProtocolMapper(ctx, realm, id, mapper)
+	if err != nil {
+		t.Errorf("Clients.CreateProtocolMapper returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+
+	mappers, _, err := k.Clients.ListProtocolMappers(ctx, realm, id)
+	if err != nil {
+		t.Errorf("Clients.ListProtocolMappers returned error: %v", err)
+	}
+
+	if len(mappers) != 1 {
+		t.Fatalf("got: %d, want: %d", len(mappers), 1)
+	}
+
+	mapper, _, err = k.Clients.GetProtocolMapper(ctx, realm, id, *mappers[0].ID)
+	if err != nil {
+		t.Errorf("Clients.GetProtocolMapper returned error: %v", err)
+	}
+
+	(*mapper.Config)["claim.name"] = "dept"
+
+	res, err = k.Clients.UpdateProtocolMapper(ctx, realm, id, mapper)
+	if err != nil {
+		t.Errorf("Clients.UpdateProtocolMapper returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	res, err = k.Clients.DeleteProtocolMapper(ctx, realm, id, *mapper.ID)
+	if err != nil {
+		t.Errorf("Clients.DeleteProtocolMapper returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestClientsService_AddProtocolMappers(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	id := createClient(t, k, realm, "client")
+
+	ctx := context.Background()
+
+	mappers := []*ProtocolMapper{
+		{
+			Name:           String("department"),
+			Protocol:       String("openid-connect"),
+			ProtocolMapper: String("oidc-usermodel-attribute-mapper"),
+			Config: &map[string]string{
+				"user.attribute": "department",
+				"claim.name":     "department",
+			},
+		},
+		{
+			Name:           String("team"),
+			Protocol:       String("openid-connect"),
+			ProtocolMapper: String("oidc-usermodel-attribute-mapper"),
+			Config: &map[string]string{
+				"user.attribute": "team",
+				"claim.name":     "team",
+			},
+		},
+	}
+
+	res, err := k.Clients.AddProtocolMappers(ctx, realm, id, mappers)
+	if err != nil {
+		t.Errorf("Clients.AddProtocolMappers returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	list, _, err := k.Clients.ListProtocolMappers(ctx, realm, id)
+	if err != nil {
+		t.Errorf("Clients.ListProtocolMappers returned error: %v", err)
+	}
+
+	if len(list) != 2 {
+		t.Errorf("got: %d, want: %d", len(list), 2)
+	}
+}
+
+func TestClientsService_AddServiceAccountRealmRoles(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	createRealmRole(t, k, realm, "role")
+
+	id := createClient(t, k, realm, "client")
+
+	ctx := context.Background()
+
+	res, err := k.Clients.AddServiceAccountRealmRoles(ctx, realm, id, "role")
+	if err != nil {
+		t.Errorf("Clients.AddServiceAccountRealmRoles returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	user, _, err := k.Clients.GetServiceAccountUser(ctx, realm, id)
+	if err != nil {
+		t.Errorf("Clients.GetServiceAccountUser returned error: %v", err)
+	}
+
+	roles, _, err := k.Users.ListRealmRoles(ctx, realm, *user.ID)
+	if err != nil {
+		t.Errorf("Users.ListRealmRoles returned error: %v", err)
+	}
+
+	found := false
+	for _, r := range roles {
+		if *r.Name == "role" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("role %q not assigned", "role")
+	}
+}
+
+func TestClientsService_AddServiceAccountClientRoles(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	id := createClient(t, k, realm, "client")
+	roleClientID := createClient(t, k, realm, "api")
+	createClientRole(t, k, realm, roleClientID, "read")
+
+	res, err := k.Clients.AddServiceAccountClientRoles(context.Background(), realm, id, roleClientID, "read")
+	if err != nil {
+		t.Errorf("Clients.AddServiceAccountClientRoles returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestClientsService_SetManagementPermissions(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+	id := createClient(t, k, "first", "client")
+
+	ref, res, err := k.Clients.SetManagementPermissions(context.Background(), "first", id, &ManagementPermissionReference{
+		Enabled: Bool(true),
+	})
+	if err != nil {
+		t.Errorf("Clients.SetManagementPermissions returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if !*ref.Enabled {
+		t.Errorf("got: %t, want: %t", *ref.Enabled, true)
+	}
+
+	ref, _, err = k.Clients.GetManagementPermissions(context.Background(), "first", id)
+	if err != nil {
+		t.Errorf("Clients.GetManagementPermissions returned error: %v", err)
+	}
+
+	if _, ok := (*ref.ScopePermissions)["manage"]; !ok {
+		t.Errorf("got: %v, want permission for scope manage", *ref.ScopePermissions)
+	}
+}
+
+func TestClientsService_CreateInitialAccessToken(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+
+	token, res, err := k.Clients.CreateInitialAccessToken(context.Background(), "first", &ClientInitialAccessCreate{
+		Expiration: Int(3600),
+		Count:      Int(2),
+	})
+	if err != nil {
+		t.Errorf("Clients.CreateInitialAccessToken returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if *token.Count != 2 {
+		t.Errorf("got: %d, want: %d", *token.Count, 2)
+	}
+
+	if *token.Token == "" {
+		t.Error("got empty token")
+	}
+}
+
+func TestClientsService_ListInitialAccessTokens(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+	initialAccessToken(t, k, "first")
+
+	tokens, res, err := k.Clients.ListInitialAccessTokens(context.Background(), "first")
+	if err != nil {
+		t.Errorf("Clients.ListInitialAccessTokens returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(tokens) != 1 {
+		t.Errorf("got: %d, want: %d", len(tokens), 1)
+	}
+}
+
+func TestClientsService_DeleteInitialAccessToken(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+	initialAccessToken(t, k, "first")
+
+	tokens, _, err := k.Clients.ListInitialAccessTokens(context.Background(), "first")
+	if err != nil {
+		t.Errorf("Clients.ListInitialAccessTokens returned error: %v", err)
+	}
+
+	res, err := k.Clients.DeleteInitialAccessToken(context.Background(), "first", *tokens[0].ID)
+	if err != nil {
+		t.Errorf("Clients.DeleteInitialAccessToken returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestClientsService_AddRealmScopeMappings(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	id := createClient(t, k, realm, "client")
+	createRealmRole(t, k, realm, "role")
+
+	ctx := context.Background()
+
+	role, _, err := k.RealmRoles.GetByName(ctx, realm, "role")
+	if err != nil {
+		t.Errorf("RealmRoles.GetByName returned error: %v", err)
+	}
+
+	res, err := k.Clients.AddRealmScopeMappings(ctx, realm, id, []*Role{role})
+	if err != nil {
+		t.Errorf("Clients.AddRealmScopeMappings returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	roles, _, err := k.Clients.ListRealmScopeMappings(ctx, realm, id)
+	if err != nil {
+		t.Errorf("Clients.ListRealmScopeMappings returned error: %v", err)
+	}
+
+	if len(roles) != 1 || *roles[0].Name != "role" {
+		t.Errorf("got: %v, want: [role]", roles)
+	}
+
+	available, _, err := k.Clients.ListAvailableRealmScopeMappings(ctx, realm, id)
+	if err != nil {
+		t.Errorf("Clients.ListAvailableRealmScopeMappings returned error: %v", err)
+	}
+
+	for _, r := range available {
+		if *r.Name == "role" {
+			t.Error("mapped role is still available")
+		}
+	}
+
+	res, err = k.Clients.RemoveRealmScopeMappings(ctx, realm, id, []*Role{role})
+	if err != nil {
+		t.Errorf("Clients.RemoveRealmScopeMappings returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestClientsService_AddClientScopeMappings(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	id := createClient(t, k, realm, "client")
+	roleClientID := createClient(t, k, realm, "other")
+	createClientRole(t, k, realm, roleClientID, "role")
+
+	ctx := context.Background()
+
+	role, _, err := k.ClientRoles.Get(ctx, realm, roleClientID, "role")
+	if err != nil {
+		t.Errorf("ClientRoles.Get returned error: %v", err)
+	}
+
+	res, err := k.Clients.AddClientScopeMappings(ctx, realm, id, roleClientID, []*Role{role})
+	if err != nil {
+		t.Errorf("Clients.AddClientScopeMappings returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	mappings, _, err := k.Clients.GetAllScopeMappings(ctx, realm, id)
+	if err != nil {
+		t.Errorf("Clients.GetAllScopeMappings returned error: %v", err)
+	}
+
+	if _, ok := mappings.ClientMappings["other"]; !ok {
+		t.Errorf("got: %v, want mappings for client other", mappings.ClientMappings)
+	}
+}
+
+func TestClientsService_RegisterNode(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	id := createClient(t, k, realm, "client")
+
+	ctx := context.Background()
+
+	res, err := k.Clients.RegisterNode(ctx, realm, id, "node1.example.com")
+	if err != nil {
+		t.Errorf("Clients.RegisterNode returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	client, _, err := k.Clients.Get(ctx, realm, id)
+	if err != nil {
+		t.Errorf("Clients.Get returned error: %v", err)
+	}
+
+	if _, ok := client.GetRegisteredNodes()["node1.example.com"]; !ok {
+		t.Errorf("got: %v, want node1.example.com", client.GetRegisteredNodes())
+	}
+
+	// the client has no admin url, so the node can't be reached
+	result, _, err := k.Clients.TestNodesAvailable(ctx, realm, id)
+	if err != nil {
+		t.Errorf("Clients.TestNodesAvailable returned error: %v", err)
+	}
+
+	if len(result.SuccessRequests) != 0 {
+		t.Errorf("got: %v, want no successful requests", result.SuccessRequests)
+	}
+
+	res, err = k.Clients.UnregisterNode(ctx, realm, id, "node1.example.com")
+	if err != nil {
+		t.Errorf("Clients.UnregisterNode returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
@@ -0,0 +1,155 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+)
+
+// ComponentsService handles communication with the component related methods of the Keycloak API.
+//
+// Components configure pluggable providers of a realm, e.g. user federation
+// providers, their mappers and key providers.
+type ComponentsService service
+
+// Component representation.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/ComponentRepresentation.java
+type Component struct {
+	ID           *string              `json:"id,omitempty"`
+	Name         *string              `json:"name,omitempty"`
+	ProviderID   *string              `json:"providerId,omitempty"`
+	ProviderType *string              `json:"providerType,omitempty"`
+	ParentID     *string              `json:"parentId,omitempty"`
+	SubType      *string              `json:"subType,omitempty"`
+	Config       *map[string][]string `json:"config,omitempty"`
+}
+
+// ConfigProperty describes a single configuration property of a provider.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/ConfigPropertyRepresentation.java
+type ConfigProperty struct {
+	Name         *string     `json:"name,omitempty"`
+	Label        *string     `json:"label,omitempty"`
+	HelpText     *string     `json:"helpText,omitempty"`
+	Type         *string     `json:"type,omitempty"`
+	DefaultValue interface{} `json:"defaultValue,omitempty"`
+	Options      []string    `json:"options,omitempty"`
+	Secret       *bool       `json:"secret,omitempty"`
+	Required     *bool       `json:"required,omitempty"`
+	ReadOnly     *bool       `json:"readOnly,omitempty"`
+}
+
+// ComponentType describes a provider that can be configured as a component.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/ComponentTypeRepresentation.java
+type ComponentType struct {
+	ID         *string                 `json:"id,omitempty"`
+	HelpText   *string                 `json:"helpText,omitempty"`
+	Properties []*ConfigProperty       `json:"properties,omitempty"`
+	Metadata   *map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ComponentListOptions specifies the optional parameters to the ComponentsService.List method.
+type ComponentListOptions struct {
+	Name   string `url:"name,omitempty"`
+	Parent string `url:"parent,omitempty"`
+	Type   string `url:"type,omitempty"`
+}
+
+// Create a new component.
+func (s *ComponentsService) Create(ctx context.Context, realm string, component *Component) (*Response, error) {
+	u := pathf("admin/realms/%s/components", realm)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, component)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// List components.
+func (s *ComponentsService) List(ctx context.Context, realm string, opts *ComponentListOptions) ([]*Component, *Response, error) {
+	u := pathf("admin/realms/%s/components", realm)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var components []*Component
+	res, err := s.keycloak.Do(ctx, req, &components)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return components, res, nil
+}
+
+// Get component.
+func (s *ComponentsService) Get(ctx context.Context, realm, componentID string) (*Component, *Response, error) {
+	u := pathf("admin/realms/%s/components/%s", realm, componentID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var component Component
+	res, err := s.keycloak.Do(ctx, req, &component)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &component, res, nil
+}
+
+// Update component.
+func (s *ComponentsService) Update(ctx context.Context, realm string, component *Component) (*Response, error) {
+	u := pathf("admin/realms/%s/components/%s", realm, *component.ID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, component)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// Delete component.
+func (s *ComponentsService) Delete(ctx context.Context, realm, componentID string) (*Response, error) {
+	u := pathf("admin/realms/%s/components/%s", realm, componentID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListSubComponentTypes lists the provider types that can be configured as
+// children of the component, e.g. the mapper types of an LDAP provider
+// ("org.keycloak.storage.ldap.mappers.LDAPStorageMapper").
+func (s *ComponentsService) ListSubComponentTypes(ctx context.Context, realm, componentID, providerType string) ([]*ComponentType, *Response, error) {
+	u := pathf("admin/realms/%s/components/%s/sub-component-types", realm, componentID)
+	u, err := addOptions(u, &struct {
+		Type string `url:"type"`
+	}{providerType})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var types []*ComponentType
+	res, err := s.keycloak.Do(ctx, req, &types)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return types, res, nil
+}
@@ -0,0 +1,132 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// create a new rsa key provider component.
+func createComponent(t *testing.T, k *Keycloak, realm, name string) string {
+	t.Helper()
+
+	component := &Component{
+		Name:         String(name),
+		ProviderID:   String("rsa-generated"),
+		ProviderType: String("org.keycloak.keys.KeyProvider"),
+		ParentID:     String(realm),
+		Config: &map[string][]string{
+			"priority": {"100"},
+		},
+	}
+
+	res, err := k.Components.Create(context.Background(), realm, component)
+	if err != nil {
+		t.Errorf("Components.Create returned error: %v", err)
+	}
+
+	parts := strings.Split(res.Header.Get("Location"), "/")
+	return parts[len(parts)-1]
+}
+
+func TestComponentsService_Create(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	component := &Component{
+		Name:         String("rsa"),
+		ProviderID:   String("rsa-generated"),
+		ProviderType: String("org.keycloak.keys.KeyProvider"),
+		ParentID:     String(realm),
+	}
+
+	res, err := k.Components.Create(context.Background(), realm, component)
+	if err != nil {
+		t.Errorf("Components.Create returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+}
+
+func TestComponentsService_List(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	createComponent(t, k, realm, "rsa")
+
+	opts := &ComponentListOptions{
+		Name: "rsa",
+		Type: "org.keycloak.keys.KeyProvider",
+	}
+
+	components, res, err := k.Components.List(context.Background(), realm, opts)
+	if err != nil {
+		t.Errorf("Components.List returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(components) != 1 {
+		t.Errorf("got: %d, want: %d", len(components), 1)
+	}
+}
+
+func TestComponentsService_Update(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	id := createComponent(t, k, realm, "rsa")
+
+	ctx := context.Background()
+
+	component, _, err := k.Components.Get(ctx, realm, id)
+	if err != nil {
+		t.Errorf("Components.Get returned error: %v", err)
+	}
+
+	(*component.Config)["priority"] = []string{"200"}
+
+	res, err := k.Components.Update(ctx, realm, component)
+	if err != nil {
+		t.Errorf("Components.Update returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	component, _, err = k.Components.Get(ctx, realm, id)
+	if err != nil {
+		t.Errorf("Components.Get returned error: %v", err)
+	}
+
+	if (*component.Config)["priority"][0] != "200" {
+		t.Errorf("got: %s, want: %s", (*component.Config)["priority"][0], "200")
+	}
+}
+
+func TestComponentsService_Delete(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	id := createComponent(t, k, realm, "rsa")
+
+	res, err := k.Components.Delete(context.Background(), realm, id)
+	if err != nil {
+		t.Errorf("Components.Delete returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
@@ -1,2 +1,4 @@
 // Package keycloak provides a client for using the Keycloak API.
 package keycloak
+
+//go:generate go run genAccessors.go
@@ -0,0 +1,158 @@
+package keycloak
+
+import (
+	"context"
+	"strings"
+)
+
+// Ensure creates the user or, if a user with the same username exists,
+// updates it. It returns the id of the user. The id of user is ignored.
+func (s *UsersService) Ensure(ctx context.Context, realm string, user *User) (string, error) {
+	live, err := s.getByUsername(ctx, realm, user.GetUsername())
+	if err != nil {
+		return "", err
+	}
+	if live == nil {
+		res, createErr := s.Create(ctx, realm, user)
+		if createErr == nil {
+			return res.LocationID, nil
+		}
+		if !IsConflict(createErr) {
+			return "", createErr
+		}
+		// created concurrently, or the email is taken by another user
+		if live, err = s.getByUsername(ctx, realm, user.GetUsername()); err != nil {
+			return "", err
+		}
+		if live == nil {
+			return "", createErr
+		}
+	}
+
+	update := *user
+	update.ID = live.ID
+	if _, err := s.Update(ctx, realm, &update); err != nil {
+		return "", err
+	}
+	return live.GetID(), nil
+}
+
+// getByUsername returns the user with exactly the given username, or nil.
+// The search is exact so that prefix matches like "alice2" don't count, but
+// Keycloak stores usernames in lower case, hence the case-insensitive
+// comparison.
+func (s *UsersService) getByUsername(ctx context.Context, realm, username string) (*User, error) {
+	users, _, err := s.List(ctx, realm, &UserListOptions{Username: username, Exact: Bool(true)})
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if strings.EqualFold(user.GetUsername(), username) {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+// Ensure creates the group at path or updates it if it exists. Missing
+// parent groups are created along the way, e.g. "/org" and "/org/team" for
+// "/org/team/dev". The name of the group is set from the last segment of
+// path. It returns the id of the group. Sub groups of group are ignored.
+func (s *GroupsService) Ensure(ctx context.Context, realm, path string, group *Group) (string, error) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	var parentID string
+	for i := range segments {
+		current := "/" + strings.Join(segments[:i+1], "/")
+		last := i == len(segments)-1
+
+		live, _, err := s.GetByPath(ctx, realm, current)
+		if err != nil && !IsNotFound(err) {
+			return "", err
+		}
+
+		g := &Group{Name: String(segments[i])}
+		if last && group != nil {
+			update := *group
+			update.Name = g.Name
+			update.Path = nil
+			update.SubGroups = nil
+			g = &update
+		}
+
+		switch {
+		case live != nil && !last:
+			parentID = live.GetID()
+			continue
+		case live != nil:
+			g.ID = live.ID
+			if _, err := s.Update(ctx, realm, g); err != nil {
+				return "", err
+			}
+			return live.GetID(), nil
+		}
+
+		g.ID = nil
+		var res *Response
+		if parentID == "" {
+			res, err = s.Create(ctx, realm, g)
+		} else {
+			res, err = s.CreateChild(ctx, realm, parentID, g)
+		}
+		if err != nil {
+			return "", err
+		}
+		parentID = res.LocationID
+	}
+	return parentID, nil
+}
+
+// Ensure creates the client or, if a client with the same client ID exists,
+// updates it. It returns the id of the client. The id of client is ignored.
+func (s *ClientsService) Ensure(ctx context.Context, realm string, client *Client) (string, error) {
+	live, _, err := s.GetByClientID(ctx, realm, client.GetClientID())
+	if err != nil {
+		return "", err
+	}
+	if live == nil {
+		res, err := s.Create(ctx, realm, client)
+		if err != nil {
+			return "", err
+		}
+		return res.LocationID, nil
+	}
+
+	update := *client
+	update.ID = live.ID
+	if _, err := s.Update(ctx, realm, &update); err != nil {
+		return "", err
+	}
+	return live.GetID(), nil
+}
+
+// Ensure creates the realm role or, if a role with the same name exists,
+// updates it. It returns the id of the role.
+func (s *RealmRolesService) Ensure(ctx context.Context, realm string, role *Role) (string, error) {
+	live, _, err := s.GetByName(ctx, realm, role.GetName())
+	if err != nil && !IsNotFound(err) {
+		return "", err
+	}
+	if live == nil {
+		if _, err := s.Create(ctx, realm, role); err != nil {
+			return "", err
+		}
+		// the location of a created role holds its name, not the id
+		live, _, err = s.GetByName(ctx, realm, role.GetName())
+		if err != nil {
+			return "", err
+		}
+		return live.GetID(), nil
+	}
+
+	update := *role
+	update.ID = live.ID
+	if _, err := s.Update(ctx, realm, role.GetName(), &update); err != nil {
+		return "", err
+	}
+	return live.GetID(), nil
+}
@@ -0,0 +1,150 @@
+package keycloak_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zemirco/keycloak/v2"
+	"github.com/zemirco/keycloak/v2/keycloaktest"
+)
+
+func TestEnsure(t *testing.T) {
+	server := keycloaktest.NewServer()
+	defer server.Close()
+	server.AddRealm("first")
+
+	k := server.Keycloak()
+	ctx := context.Background()
+
+	t.Run("user", func(t *testing.T) {
+		id, err := k.Users.Ensure(ctx, "first", &keycloak.User{Username: keycloak.String("john"), FirstName: keycloak.String("John")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		again, err := k.Users.Ensure(ctx, "first", &keycloak.User{Username: keycloak.String("john"), FirstName: keycloak.String("Johnny")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if again != id {
+			t.Errorf("got: %s, want: %s", again, id)
+		}
+
+		user, _, err := k.Users.GetByID(ctx, "first", id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if user.GetFirstName() != "Johnny" {
+			t.Errorf("got: %s, want: %s", user.GetFirstName(), "Johnny")
+		}
+	})
+
+	t.Run("group", func(t *testing.T) {
+		id, err := k.Groups.Ensure(ctx, "first", "/org/team", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		attributes := map[string][]string{"lead": {"john"}}
+		again, err := k.Groups.Ensure(ctx, "first", "/org/team", &keycloak.Group{Attributes: &attributes})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if again != id {
+			t.Errorf("got: %s, want: %s", again, id)
+		}
+
+		group, _, err := k.Groups.GetByPath(ctx, "first", "/org/team")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if group.GetID() != id {
+			t.Errorf("got: %s, want: %s", group.GetID(), id)
+		}
+		if group.GetAttributes()["lead"][0] != "john" {
+			t.Errorf("got: %v", group.GetAttributes())
+		}
+	})
+
+	t.Run("client", func(t *testing.T) {
+		id, err := k.Clients.Ensure(ctx, "first", &keycloak.Client{ClientID: keycloak.String("app")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		again, err := k.Clients.Ensure(ctx, "first", &keycloak.Client{ClientID: keycloak.String("app"), Name: keycloak.String("App")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if again != id {
+			t.Errorf("got: %s, want: %s", again, id)
+		}
+
+		client, _, err := k.Clients.Get(ctx, "first", id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if client.GetName() != "App" {
+			t.Errorf("got: %s, want: %s", client.GetName(), "App")
+		}
+	})
+
+	t.Run("realm role", func(t *testing.T) {
+		id, err := k.RealmRoles.Ensure(ctx, "first", &keycloak.Role{Name: keycloak.String("admin")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		again, err := k.RealmRoles.Ensure(ctx, "first", &keycloak.Role{Name: keycloak.String("admin"), Description: keycloak.String("Administrators")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id == "" || again != id {
+			t.Errorf("got: %s, want: %s", again, id)
+		}
+
+		role, _, err := k.RealmRoles.GetByID(ctx, "first", id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if role.GetDescription() != "Administrators" {
+			t.Errorf("got: %s, want: %s", role.GetDescription(), "Administrators")
+		}
+	})
+}
+
+func TestEnsure_UsernamePrefix(t *testing.T) {
+	var updated string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/admin/realms/first/users":
+			// a prefix search returns "alice2" first and "alice" only on a
+			// later page
+			if r.URL.Query().Get("exact") == "true" {
+				w.Write([]byte(`[{"id":"id-alice","username":"alice"}]`))
+				return
+			}
+			w.Write([]byte(`[{"id":"id-alice2","username":"alice2"}]`))
+		case r.Method == http.MethodPut:
+			updated = r.URL.Path
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	k, err := keycloak.NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := k.Users.Ensure(context.Background(), "first", &keycloak.User{Username: keycloak.String("Alice")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "id-alice" {
+		t.Errorf("got: %s, want: %s", id, "id-alice")
+	}
+	if want := "/admin/realms/first/users/id-alice"; updated != want {
+		t.Errorf("got: %s, want: %s", updated, want)
+	}
+}
@@ -0,0 +1,120 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ErrorResponse reports an error caused by an API request.
+//
+// Keycloak reports admin API errors as {"errorMessage": "..."} and OAuth 2.0
+// errors as {"error": "...", "error_description": "..."}. Whichever form is
+// returned is decoded into the matching fields.
+type ErrorResponse struct {
+	// Response is the HTTP response that caused this error.
+	Response *http.Response `json:"-"`
+
+	// Body is the raw response body.
+	Body []byte `json:"-"`
+
+	ErrorMessage     string `json:"errorMessage,omitempty"`
+	Err              string `json:"error,omitempty"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// StatusCode returns the HTTP status code of the response.
+func (r *ErrorResponse) StatusCode() int {
+	if r.Response == nil {
+		return 0
+	}
+	return r.Response.StatusCode
+}
+
+// Message returns the most specific error message sent by Keycloak.
+func (r *ErrorResponse) Message() string {
+	switch {
+	case r.ErrorMessage != "":
+		return r.ErrorMessage
+	case r.ErrorDescription != "":
+		return r.ErrorDescription
+	default:
+		return r.Err
+	}
+}
+
+func (r *ErrorResponse) Error() string {
+	msg := r.Message()
+	if msg == "" {
+		msg = http.StatusText(r.StatusCode())
+	}
+
+	if r.Response == nil || r.Response.Request == nil {
+		return fmt.Sprintf("%d %s", r.StatusCode(), msg)
+	}
+
+	return fmt.Sprintf("%s %s: %d %s", r.Response.Request.Method, r.Response.Request.URL, r.StatusCode(), msg)
+}
+
+// Is reports whether the status code of the response matches one of the
+// sentinel errors, e.g. errors.Is(err, ErrNotFound).
+func (r *ErrorResponse) Is(target error) bool {
+	code, ok := sentinels[target]
+	return ok && code == r.StatusCode()
+}
+
+// Sentinel errors matched by ErrorResponse.Is.
+var (
+	ErrBadRequest   = errors.New("keycloak: bad request")
+	ErrUnauthorized = errors.New("keycloak: unauthorized")
+	ErrForbidden    = errors.New("keycloak: forbidden")
+	ErrNotFound     = errors.New("keycloak: not found")
+	ErrConflict     = errors.New("keycloak: conflict")
+)
+
+var sentinels = map[error]int{
+	ErrBadRequest:   http.StatusBadRequest,
+	ErrUnauthorized: http.StatusUnauthorized,
+	ErrForbidden:    http.StatusForbidden,
+	ErrNotFound:     http.StatusNotFound,
+	ErrConflict:     http.StatusConflict,
+}
+
+// ErrMissingID is returned by methods that need the ID of a representation
+// when it is not set.
+var ErrMissingID = errors.New("keycloak: missing id")
+
+// IsNotFound reports whether err was caused by a 404 Not Found response.
+func IsNotFound(err error) bool { return errors.Is(err, ErrNotFound) }
+
+// IsConflict reports whether err was caused by a 409 Conflict response,
+// e.g. when creating a user with an existing username.
+func IsConflict(err error) bool { return errors.Is(err, ErrConflict) }
+
+// IsUnauthorized reports whether err was caused by a 401 Unauthorized response.
+func IsUnauthorized(err error) bool { return errors.Is(err, ErrUnauthorized) }
+
+// IsForbidden reports whether err was caused by a 403 Forbidden response.
+func IsForbidden(err error) bool { return errors.Is(err, ErrForbidden) }
+
+// CheckResponse checks the API response for errors and returns them if
+// present. A response is considered an error if it has a status code outside
+// the 200 range. The response body is consumed but not closed.
+func CheckResponse(res *http.Response) error {
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+
+	errorResponse := &ErrorResponse{Response: res}
+
+	body, err := io.ReadAll(res.Body)
+	if err == nil && len(body) > 0 {
+		errorResponse.Body = body
+		// not every error body is JSON, keep the raw body in that case
+		_ = json.Unmarshal(body, errorResponse)
+	}
+
+	return errorResponse
+}
@@ -0,0 +1,89 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestKeycloak_Do_ErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"errorMessage":"User exists with same username"}`))
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := k.Users.Create(context.Background(), "first", &User{Username: String("john")})
+	if err == nil {
+		t.Fatal("got no error, want one")
+	}
+
+	if res.StatusCode != http.StatusConflict {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusConflict)
+	}
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("got %T, want *ErrorResponse", err)
+	}
+
+	if errorResponse.ErrorMessage != "User exists with same username" {
+		t.Errorf("got: %s, want: %s", errorResponse.ErrorMessage, "User exists with same username")
+	}
+
+	if string(errorResponse.Body) != `{"errorMessage":"User exists with same username"}` {
+		t.Errorf("got: %s", errorResponse.Body)
+	}
+
+	if !IsConflict(err) {
+		t.Errorf("IsConflict got: false, want: true")
+	}
+
+	if IsNotFound(err) {
+		t.Errorf("IsNotFound got: true, want: false")
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	tests := []struct {
+		code int
+		body string
+		is   error
+		msg  string
+	}{
+		{http.StatusNotFound, `{"error":"Realm not found."}`, ErrNotFound, "Realm not found."},
+		{http.StatusUnauthorized, `{"error":"invalid_grant","error_description":"Invalid user credentials"}`, ErrUnauthorized, "Invalid user credentials"},
+		{http.StatusForbidden, ``, ErrForbidden, ""},
+		{http.StatusBadRequest, `not json`, ErrBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		rec.WriteHeader(tt.code)
+		rec.WriteString(tt.body)
+
+		err := CheckResponse(rec.Result())
+		if !errors.Is(err, tt.is) {
+			t.Errorf("got: %v, want: %v", err, tt.is)
+		}
+
+		var errorResponse *ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.Message() != tt.msg {
+			t.Errorf("got: %s, want: %s", errorResponse.Message(), tt.msg)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	rec.WriteHeader(http.StatusNoContent)
+	if err := CheckResponse(rec.Result()); err != nil {
+		t.Errorf("got: %v, want: nil", err)
+	}
+}
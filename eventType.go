@@ -0,0 +1,47 @@
+package keycloak
+
+// Event types of login events, used by EventListOptions.Type and RealmEventsConfig.EnabledEventTypes.
+// Every type has a matching error type with the suffix _ERROR, e.g. LOGIN_ERROR.
+//
+// https://github.com/keycloak/keycloak/blob/main/server-spi-private/src/main/java/org/keycloak/events/EventType.java
+const (
+	EventTypeLogin                = "LOGIN"
+	EventTypeLoginError           = "LOGIN_ERROR"
+	EventTypeRegister             = "REGISTER"
+	EventTypeRegisterError        = "REGISTER_ERROR"
+	EventTypeLogout               = "LOGOUT"
+	EventTypeLogoutError          = "LOGOUT_ERROR"
+	EventTypeCodeToToken          = "CODE_TO_TOKEN"
+	EventTypeCodeToTokenError     = "CODE_TO_TOKEN_ERROR"
+	EventTypeClientLogin          = "CLIENT_LOGIN"
+	EventTypeClientLoginError     = "CLIENT_LOGIN_ERROR"
+	EventTypeRefreshToken         = "REFRESH_TOKEN"
+	EventTypeRefreshTokenError    = "REFRESH_TOKEN_ERROR"
+	EventTypeIntrospectToken      = "INTROSPECT_TOKEN"
+	EventTypeIntrospectTokenError = "INTROSPECT_TOKEN_ERROR"
+	EventTypeTokenExchange        = "TOKEN_EXCHANGE"
+	EventTypeTokenExchangeError   = "TOKEN_EXCHANGE_ERROR"
+	EventTypeUpdateEmail          = "UPDATE_EMAIL"
+	EventTypeUpdateProfile        = "UPDATE_PROFILE"
+	EventTypeUpdatePassword       = "UPDATE_PASSWORD"
+	EventTypeUpdateTOTP           = "UPDATE_TOTP"
+	EventTypeRemoveTOTP           = "REMOVE_TOTP"
+	EventTypeVerifyEmail          = "VERIFY_EMAIL"
+	EventTypeSendVerifyEmail      = "SEND_VERIFY_EMAIL"
+	EventTypeSendResetPassword    = "SEND_RESET_PASSWORD"
+	EventTypeResetPassword        = "RESET_PASSWORD"
+	EventTypeExecuteActions       = "EXECUTE_ACTIONS"
+	EventTypeImpersonate          = "IMPERSONATE"
+	EventTypeUserInfoRequest      = "USER_INFO_REQUEST"
+	EventTypeDeleteAccount        = "DELETE_ACCOUNT"
+)
+
+// Operation types of admin events, used by AdminEventListOptions.OperationTypes.
+//
+// https://github.com/keycloak/keycloak/blob/main/server-spi-private/src/main/java/org/keycloak/events/admin/OperationType.java
+const (
+	OperationTypeCreate = "CREATE"
+	OperationTypeUpdate = "UPDATE"
+	OperationTypeDelete = "DELETE"
+	OperationTypeAction = "ACTION"
+)
@@ -0,0 +1,236 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+)
+
+// EventsService handles communication with the event related methods of the Keycloak API.
+type EventsService service
+
+// Event represents a login event.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/EventRepresentation.java
+type Event struct {
+	ID        *string            `json:"id,omitempty"`
+	Time      *int64             `json:"time,omitempty"`
+	Type      *string            `json:"type,omitempty"`
+	RealmID   *string            `json:"realmId,omitempty"`
+	ClientID  *string            `json:"clientId,omitempty"`
+	UserID    *string            `json:"userId,omitempty"`
+	SessionID *string            `json:"sessionId,omitempty"`
+	IPAddress *string            `json:"ipAddress,omitempty"`
+	Error     *string            `json:"error,omitempty"`
+	Details   *map[string]string `json:"details,omitempty"`
+}
+
+// AuthDetails describes who performed an admin operation.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/AuthDetailsRepresentation.java
+type AuthDetails struct {
+	RealmID   *string `json:"realmId,omitempty"`
+	ClientID  *string `json:"clientId,omitempty"`
+	UserID    *string `json:"userId,omitempty"`
+	IPAddress *string `json:"ipAddress,omitempty"`
+}
+
+// AdminEvent represents an admin event.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/AdminEventRepresentation.java
+type AdminEvent struct {
+	ID             *string            `json:"id,omitempty"`
+	Time           *int64             `json:"time,omitempty"`
+	RealmID        *string            `json:"realmId,omitempty"`
+	AuthDetails    *AuthDetails       `json:"authDetails,omitempty"`
+	OperationType  *string            `json:"operationType,omitempty"`
+	ResourceType   *string            `json:"resourceType,omitempty"`
+	ResourcePath   *string            `json:"resourcePath,omitempty"`
+	Representation *string            `json:"representation,omitempty"`
+	Error          *string            `json:"error,omitempty"`
+	Details        *map[string]string `json:"details,omitempty"`
+}
+
+// RealmEventsConfig configures which events are stored and for how long.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/RealmEventsConfigRepresentation.java
+type RealmEventsConfig struct {
+	EventsEnabled             *bool    `json:"eventsEnabled,omitempty"`
+	EventsExpiration          *int64   `json:"eventsExpiration,omitempty"`
+	EventsListeners           []string `json:"eventsListeners,omitempty"`
+	EnabledEventTypes         []string `json:"enabledEventTypes,omitempty"`
+	AdminEventsEnabled        *bool    `json:"adminEventsEnabled,omitempty"`
+	AdminEventsDetailsEnabled *bool    `json:"adminEventsDetailsEnabled,omitempty"`
+}
+
+// EventListOptions specifies the optional parameters to the EventsService.List method.
+//
+// DateFrom and DateTo are dates formatted as yyyy-MM-dd.
+type EventListOptions struct {
+	Client    string   `url:"client,omitempty"`
+	DateFrom  string   `url:"dateFrom,omitempty"`
+	DateTo    string   `url:"dateTo,omitempty"`
+	IPAddress string   `url:"ipAddress,omitempty"`
+	Type      []string `url:"type,omitempty"`
+	User      string   `url:"user,omitempty"`
+	Options
+}
+
+// AdminEventListOptions specifies the optional parameters to the EventsService.ListAdminEvents method.
+//
+// DateFrom and DateTo are dates formatted as yyyy-MM-dd.
+type AdminEventListOptions struct {
+	AuthClient     string   `url:"authClient,omitempty"`
+	AuthIPAddress  string   `url:"authIpAddress,omitempty"`
+	AuthRealm      string   `url:"authRealm,omitempty"`
+	AuthUser       string   `url:"authUser,omitempty"`
+	DateFrom       string   `url:"dateFrom,omitempty"`
+	DateTo         string   `url:"dateTo,omitempty"`
+	OperationTypes []string `url:"operationTypes,omitempty"`
+	ResourcePath   string   `url:"resourcePath,omitempty"`
+	ResourceTypes  []string `url:"resourceTypes,omitempty"`
+	Options
+}
+
+// List login events, most recent first.
+func (s *EventsService) List(ctx context.Context, realm string, opts *EventListOptions) ([]*Event, *Response, error) {
+	u := pathf("admin/realms/%s/events", realm)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var events []*Event
+	res, err := s.keycloak.Do(ctx, req, &events)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return events, res, nil
+}
+
+// ListAll lists all login events matching opts by requesting one page after
+// another until the result set is exhausted.
+func (s *EventsService) ListAll(ctx context.Context, realm string, opts *EventListOptions) ([]*Event, error) {
+	var o EventListOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	var events []*Event
+	err := Paginate(ctx, o.Options, func(ctx context.Context, page Options) (int, error) {
+		o.Options = page
+		next, _, err := s.List(ctx, realm, &o)
+		if err != nil {
+			return 0, err
+		}
+		events = append(events, next...)
+		return len(next), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// Delete all login events.
+func (s *EventsService) Delete(ctx context.Context, realm string) (*Response, error) {
+	u := pathf("admin/realms/%s/events", realm)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListAdminEvents lists admin events, most recent first.
+func (s *EventsService) ListAdminEvents(ctx context.Context, realm string, opts *AdminEventListOptions) ([]*AdminEvent, *Response, error) {
+	u := pathf("admin/realms/%s/admin-events", realm)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var events []*AdminEvent
+	res, err := s.keycloak.Do(ctx, req, &events)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return events, res, nil
+}
+
+// ListAllAdminEvents lists all admin events matching opts by requesting one
+// page after another until the result set is exhausted.
+func (s *EventsService) ListAllAdminEvents(ctx context.Context, realm string, opts *AdminEventListOptions) ([]*AdminEvent, error) {
+	var o AdminEventListOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	var events []*AdminEvent
+	err := Paginate(ctx, o.Options, func(ctx context.Context, page Options) (int, error) {
+		o.Options = page
+		next, _, err := s.ListAdminEvents(ctx, realm, &o)
+		if err != nil {
+			return 0, err
+		}
+		events = append(events, next...)
+		return len(next), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// DeleteAdminEvents deletes all admin events.
+func (s *EventsService) DeleteAdminEvents(ctx context.Context, realm string) (*Response, error) {
+	u := pathf("admin/realms/%s/admin-events", realm)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// GetConfig returns the events configuration of the realm.
+func (s *EventsService) GetConfig(ctx context.Context, realm string) (*RealmEventsConfig, *Response, error) {
+	u := pathf("admin/realms/%s/events/config", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var config RealmEventsConfig
+	res, err := s.keycloak.Do(ctx, req, &config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &config, res, nil
+}
+
+// UpdateConfig updates the events configuration of the realm.
+func (s *EventsService) UpdateConfig(ctx context.Context, realm string, config *RealmEventsConfig) (*Response, error) {
+	u := pathf("admin/realms/%s/events/config", realm)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
@@ -0,0 +1,248 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// DefaultWatchInterval is the time between two polls of EventsService.Watch
+// unless configured otherwise.
+const DefaultWatchInterval = 5 * time.Second
+
+// WatchOptions specifies the optional parameters to the EventsService.Watch
+// method.
+type WatchOptions struct {
+	// Since is the time from which events are delivered. Defaults to the
+	// time Watch is called. To resume after a restart use the Time of the
+	// last processed event, which may then be delivered again.
+	Since time.Time
+
+	// Interval is the time between two polls. Defaults to
+	// DefaultWatchInterval.
+	Interval time.Duration
+
+	// Backoff returns how long to wait after the given number of failed
+	// polls in a row. Defaults to ExponentialBackoff(time.Second, time.Minute).
+	Backoff func(retry int) time.Duration
+
+	// Events and AdminEvents filter the watched login and admin events.
+	// DateFrom, DateTo and paging are managed by Watch. If both are nil,
+	// all login and admin events are watched, otherwise only the kinds
+	// that are set.
+	Events      *EventListOptions
+	AdminEvents *AdminEventListOptions
+}
+
+// WatchEvent is delivered by EventsService.Watch. Exactly one of Event,
+// AdminEvent and Err is set.
+type WatchEvent struct {
+	Event      *Event
+	AdminEvent *AdminEvent
+
+	// Err is a failed poll. Watch keeps polling with backoff.
+	Err error
+}
+
+// Time returns the time of the event.
+func (e *WatchEvent) Time() time.Time {
+	var ms int64
+	switch {
+	case e.Event != nil:
+		ms = e.Event.GetTime()
+	case e.AdminEvent != nil:
+		ms = e.AdminEvent.GetTime()
+	}
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+// Watch polls the login and admin events of the realm and delivers new ones
+// on the returned channel, oldest first. Events are delivered at most once
+// per Watch, even if several happen within the same millisecond. The channel
+// is closed when ctx is done.
+//
+// Keycloak only stores events if they are enabled for the realm, see
+// RealmEventsConfig.
+func (s *EventsService) Watch(ctx context.Context, realm string, opts *WatchOptions) <-chan *WatchEvent {
+	var o WatchOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Since.IsZero() {
+		o.Since = time.Now()
+	}
+	if o.Interval <= 0 {
+		o.Interval = DefaultWatchInterval
+	}
+	if o.Backoff == nil {
+		o.Backoff = ExponentialBackoff(time.Second, time.Minute)
+	}
+	if o.Events == nil && o.AdminEvents == nil {
+		o.Events, o.AdminEvents = &EventListOptions{}, &AdminEventListOptions{}
+	}
+
+	since := o.Since.UnixNano() / int64(time.Millisecond)
+	w := &watcher{
+		events:      &watchCursor{time: since, seen: map[string]bool{}},
+		adminEvents: &watchCursor{time: since, seen: map[string]bool{}},
+	}
+
+	ch := make(chan *WatchEvent)
+	go func() {
+		defer close(ch)
+
+		failures := 0
+		for {
+			events, err := s.poll(ctx, realm, &o, w)
+			wait := o.Interval
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				failures++
+				wait = o.Backoff(failures)
+				events = []*WatchEvent{{Err: err}}
+			} else {
+				failures = 0
+			}
+
+			for _, e := range events {
+				select {
+				case ch <- e:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// watcher holds the cursors of a Watch.
+type watcher struct {
+	events      *watchCursor
+	adminEvents *watchCursor
+}
+
+// watchCursor tracks the newest delivered events of a kind.
+type watchCursor struct {
+	// time of the newest delivered event in milliseconds
+	time int64
+	// seen are the keys of the delivered events at time
+	seen map[string]bool
+}
+
+// dateFrom returns the day to list events from. Keycloak filters by date
+// only, so the day before is used to be safe with time zones.
+func (c *watchCursor) dateFrom() string {
+	return time.Unix(0, c.time*int64(time.Millisecond)).Add(-24 * time.Hour).Format("2006-01-02")
+}
+
+// fresh reports whether the event at ms with key wasn't delivered yet.
+func (c *watchCursor) fresh(ms int64, key string) bool {
+	return ms > c.time || (ms == c.time && !c.seen[key])
+}
+
+// advance records the delivery of the event at ms with key.
+func (c *watchCursor) advance(ms int64, key string) {
+	if ms > c.time {
+		c.time = ms
+		c.seen = map[string]bool{}
+	}
+	c.seen[key] = true
+}
+
+// eventKey identifies an event. Older Keycloak versions don't send ids.
+func eventKey(id *string, v interface{}) string {
+	if id != nil {
+		return *id
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// poll lists the events newer than the cursors of w, oldest first, and
+// advances the cursors. The cursors are only advanced if all kinds were
+// listed successfully.
+func (s *EventsService) poll(ctx context.Context, realm string, opts *WatchOptions, w *watcher) ([]*WatchEvent, error) {
+	var events []*WatchEvent
+
+	if opts.Events != nil {
+		o := *opts.Events
+		o.DateFrom, o.DateTo = w.events.dateFrom(), ""
+		err := Paginate(ctx, Options{}, func(ctx context.Context, page Options) (int, error) {
+			o.Options = page
+			next, _, err := s.List(ctx, realm, &o)
+			if err != nil {
+				return 0, err
+			}
+			for _, e := range next {
+				// most recent first, stop at the cursor
+				if e.GetTime() < w.events.time {
+					return 0, nil
+				}
+				events = append(events, &WatchEvent{Event: e})
+			}
+			return len(next), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.AdminEvents != nil {
+		o := *opts.AdminEvents
+		o.DateFrom, o.DateTo = w.adminEvents.dateFrom(), ""
+		err := Paginate(ctx, Options{}, func(ctx context.Context, page Options) (int, error) {
+			o.Options = page
+			next, _, err := s.ListAdminEvents(ctx, realm, &o)
+			if err != nil {
+				return 0, err
+			}
+			for _, e := range next {
+				if e.GetTime() < w.adminEvents.time {
+					return 0, nil
+				}
+				events = append(events, &WatchEvent{AdminEvent: e})
+			}
+			return len(next), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// events are listed most recent first, reverse them before sorting so
+	// that events within the same millisecond stay in order too
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time().Before(events[j].Time())
+	})
+
+	fresh := events[:0]
+	for _, e := range events {
+		c, key, ms := w.events, "", int64(0)
+		if e.Event != nil {
+			key, ms = eventKey(e.Event.ID, e.Event), e.Event.GetTime()
+		} else {
+			c, key, ms = w.adminEvents, eventKey(e.AdminEvent.ID, e.AdminEvent), e.AdminEvent.GetTime()
+		}
+		if !c.fresh(ms, key) {
+			continue
+		}
+		c.advance(ms, key)
+		fresh = append(fresh, e)
+	}
+	return fresh, nil
+}
@@ -0,0 +1,131 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEventsService_Watch(t *testing.T) {
+	since := time.Now()
+	ms := since.UnixNano() / int64(time.Millisecond)
+
+	var (
+		mu          sync.Mutex
+		events      []*Event      // most recent first
+		adminEvents []*AdminEvent // most recent first
+		fail        bool
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if fail {
+			fail = false
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if r.URL.Query().Get("dateFrom") == "" {
+			t.Error("expected dateFrom")
+		}
+		first, _ := strconv.Atoi(r.URL.Query().Get("first"))
+		max, _ := strconv.Atoi(r.URL.Query().Get("max"))
+
+		var list interface{}
+		switch r.URL.Path {
+		case "/admin/realms/first/events":
+			end := first + max
+			if end > len(events) {
+				end = len(events)
+			}
+			list = events[first:end]
+		case "/admin/realms/first/admin-events":
+			end := first + max
+			if end > len(adminEvents) {
+				end = len(adminEvents)
+			}
+			list = adminEvents[first:end]
+		default:
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+		json.NewEncoder(w).Encode(list)
+	}))
+	defer server.Close()
+
+	events = []*Event{{ID: String("old"), Time: Int64(ms - 1000)}}
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := k.Events.Watch(ctx, "first", &WatchOptions{
+		Since:    since,
+		Interval: 5 * time.Millisecond,
+		Backoff:  func(int) time.Duration { return time.Millisecond },
+	})
+
+	next := func() *WatchEvent {
+		t.Helper()
+		select {
+		case e := <-ch:
+			return e
+		case <-time.After(2 * time.Second):
+			t.Fatal("timeout waiting for event")
+			return nil
+		}
+	}
+
+	mu.Lock()
+	events = append([]*Event{
+		{ID: String("e2"), Time: Int64(ms + 2)},
+		{ID: String("e1"), Time: Int64(ms)},
+	}, events...)
+	adminEvents = []*AdminEvent{{ID: String("a1"), Time: Int64(ms + 1)}}
+	mu.Unlock()
+
+	var got []string
+	for i := 0; i < 3; i++ {
+		e := next()
+		if e.Event != nil {
+			got = append(got, e.Event.GetID())
+		} else {
+			got = append(got, e.AdminEvent.GetID())
+		}
+	}
+	if want := []string{"e1", "a1", "e2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	// a failed poll is reported and the next event at the same millisecond
+	// as the cursor is delivered exactly once
+	mu.Lock()
+	fail = true
+	events = append([]*Event{{ID: String("e3"), Time: Int64(ms + 2)}}, events...)
+	mu.Unlock()
+
+	if e := next(); e.Err == nil {
+		t.Errorf("got: %+v, want error", e)
+	}
+	if e := next(); e.Event.GetID() != "e3" {
+		t.Errorf("got: %s, want: %s", e.Event.GetID(), "e3")
+	}
+
+	select {
+	case e := <-ch:
+		t.Errorf("unexpected event: %+v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cancel()
+	for range ch {
+	}
+}
@@ -0,0 +1,173 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// enable login and admin events for the realm.
+func enableEvents(t *testing.T, k *Keycloak, realm string) {
+	t.Helper()
+
+	r := &Realm{
+		EventsEnabled:             Bool(true),
+		AdminEventsEnabled:        Bool(true),
+		AdminEventsDetailsEnabled: Bool(true),
+	}
+
+	if _, err := k.Realms.Update(context.Background(), realm, r); err != nil {
+		t.Errorf("Realms.Update returned error: %v", err)
+	}
+}
+
+func TestEventsService_List(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	enableEvents(t, k, realm)
+	createSession(t, k, realm, "user")
+
+	opts := &EventListOptions{
+		Type: []string{"LOGIN"},
+	}
+
+	events, res, err := k.Events.List(context.Background(), realm, opts)
+	if err != nil {
+		t.Errorf("Events.List returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(events) != 1 {
+		t.Errorf("got: %d, want: %d", len(events), 1)
+	}
+}
+
+func TestEventsService_Delete(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	enableEvents(t, k, realm)
+	createSession(t, k, realm, "user")
+
+	ctx := context.Background()
+
+	res, err := k.Events.Delete(ctx, realm)
+	if err != nil {
+		t.Errorf("Events.Delete returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	events, err := k.Events.ListAll(ctx, realm, nil)
+	if err != nil {
+		t.Errorf("Events.ListAll returned error: %v", err)
+	}
+
+	if len(events) != 0 {
+		t.Errorf("got: %d, want: %d", len(events), 0)
+	}
+}
+
+func TestEventsService_ListAdminEvents(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	enableEvents(t, k, realm)
+	createUser(t, k, realm, "user")
+
+	opts := &AdminEventListOptions{
+		OperationTypes: []string{"CREATE"},
+		ResourceTypes:  []string{"USER"},
+	}
+
+	events, res, err := k.Events.ListAdminEvents(context.Background(), realm, opts)
+	if err != nil {
+		t.Errorf("Events.ListAdminEvents returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(events) != 1 {
+		t.Errorf("got: %d, want: %d", len(events), 1)
+	}
+}
+
+func TestEventsService_DeleteAdminEvents(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	enableEvents(t, k, realm)
+
+	res, err := k.Events.DeleteAdminEvents(context.Background(), realm)
+	if err != nil {
+		t.Errorf("Events.DeleteAdminEvents returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestEventsService_GetConfig(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	enableEvents(t, k, realm)
+
+	config, res, err := k.Events.GetConfig(context.Background(), realm)
+	if err != nil {
+		t.Errorf("Events.GetConfig returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if !*config.EventsEnabled {
+		t.Errorf("got: %t, want: %t", *config.EventsEnabled, true)
+	}
+}
+
+func TestEventsService_UpdateConfig(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	config := &RealmEventsConfig{
+		EventsEnabled:     Bool(true),
+		EventsExpiration:  Int64(3600),
+		EnabledEventTypes: []string{"LOGIN", "LOGOUT"},
+	}
+
+	res, err := k.Events.UpdateConfig(context.Background(), realm, config)
+	if err != nil {
+		t.Errorf("Events.UpdateConfig returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	config, _, err = k.Events.GetConfig(context.Background(), realm)
+	if err != nil {
+		t.Errorf("Events.GetConfig returned error: %v", err)
+	}
+
+	if len(config.EnabledEventTypes) != 2 {
+		t.Errorf("got: %d, want: %d", len(config.EnabledEventTypes), 2)
+	}
+}
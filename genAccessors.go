@@ -0,0 +1,203 @@
+//go:build ignore
+// +build ignore
+
+// genAccessors generates nil-safe GetX accessors for the pointer fields of
+// the representations in this package.
+//
+// It is meant to be used by go generate:
+//
+//	go generate
+//
+// Structs with unexported fields, like Keycloak or TokenVerifier, are
+// skipped since they are not representations of the Keycloak API.
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const output = "accessors.go"
+
+var zeroValues = map[string]string{
+	"bool":    "false",
+	"float64": "0",
+	"int":     "0",
+	"int32":   "0",
+	"int64":   "0",
+	"string":  `""`,
+}
+
+type getter struct {
+	Receiver   string
+	Type       string
+	Field      string
+	ReturnType string
+	ZeroValue  string
+	Deref      bool
+}
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") && name != output && name != "genAccessors.go"
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	pkg, ok := pkgs["keycloak"]
+	if !ok {
+		log.Fatal("package keycloak not found")
+	}
+
+	// existing methods must not be shadowed by generated ones
+	methods := map[string]bool{}
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 {
+				continue
+			}
+			methods[receiverType(fn.Recv.List[0].Type)+"."+fn.Name.Name] = true
+		}
+	}
+
+	var getters []*getter
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok || !ts.Name.IsExported() || hasUnexportedFields(st) {
+					continue
+				}
+				for _, field := range st.Fields.List {
+					for _, name := range field.Names {
+						g := newGetter(ts.Name.Name, name.Name, field.Type)
+						if g == nil || methods[ts.Name.Name+".Get"+name.Name] {
+							continue
+						}
+						getters = append(getters, g)
+					}
+				}
+			}
+		}
+	}
+
+	sort.Slice(getters, func(i, j int) bool {
+		if getters[i].Type != getters[j].Type {
+			return getters[i].Type < getters[j].Type
+		}
+		return getters[i].Field < getters[j].Field
+	})
+
+	var buf bytes.Buffer
+	if err := source.Execute(&buf, getters); err != nil {
+		log.Fatal(err)
+	}
+
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(output, clean, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func receiverType(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+func hasUnexportedFields(st *ast.StructType) bool {
+	for _, field := range st.Fields.List {
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// newGetter returns nil for fields that do not need an accessor.
+func newGetter(typeName, fieldName string, expr ast.Expr) *getter {
+	star, ok := expr.(*ast.StarExpr)
+	if !ok {
+		return nil
+	}
+
+	g := &getter{
+		Receiver: strings.ToLower(typeName[:1]),
+		Type:     typeName,
+		Field:    fieldName,
+	}
+
+	switch x := star.X.(type) {
+	case *ast.Ident:
+		if zero, ok := zeroValues[x.Name]; ok {
+			g.ReturnType = x.Name
+			g.ZeroValue = zero
+			g.Deref = true
+			return g
+		}
+		if strings.HasSuffix(x.Name, "Service") {
+			return nil
+		}
+		g.ReturnType = "*" + x.Name
+		g.ZeroValue = "nil"
+		return g
+	case *ast.MapType:
+		var buf bytes.Buffer
+		if err := format.Node(&buf, token.NewFileSet(), x); err != nil {
+			log.Fatal(err)
+		}
+		g.ReturnType = buf.String()
+		g.ZeroValue = "nil"
+		g.Deref = true
+		return g
+	default:
+		return nil
+	}
+}
+
+var source = template.Must(template.New("source").Parse(`// Code generated by genAccessors; DO NOT EDIT.
+
+package keycloak
+{{range .}}
+{{if .Deref}}// Get{{.Field}} returns the {{.Field}} field if it's non-nil, zero value otherwise.
+func ({{.Receiver}} *{{.Type}}) Get{{.Field}}() {{.ReturnType}} {
+	if {{.Receiver}} == nil || {{.Receiver}}.{{.Field}} == nil {
+		return {{.ZeroValue}}
+	}
+	return *{{.Receiver}}.{{.Field}}
+}
+{{else}}// Get{{.Field}} returns the {{.Field}} field.
+func ({{.Receiver}} *{{.Type}}) Get{{.Field}}() {{.ReturnType}} {
+	if {{.Receiver}} == nil {
+		return nil
+	}
+	return {{.Receiver}}.{{.Field}}
+}
+{{end}}{{end}}`))
@@ -1,10 +1,11 @@
 module github.com/zemirco/keycloak/v2
 
-go 1.17
+go 1.21
 
 require (
 	github.com/google/go-querystring v1.1.0
 	golang.org/x/oauth2 v0.0.0-20211005180243-6b3c2da341f1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
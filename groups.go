@@ -2,28 +2,33 @@ package keycloak
 
 import (
 	"context"
-	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
-// Group ...
+// Group representation.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/GroupRepresentation.java
 type Group struct {
-	ID          *string              `json:"id,omitempty"`
-	Name        *string              `json:"name,omitempty"`
-	Path        *string              `json:"path,omitempty"`
-	Attributes  *map[string][]string `json:"attributes,omitempty"`
-	RealmRoles  []string             `json:"realmRoles,omitempty"`
-	ClientRoles *map[string][]string `json:"clientRoles,omitempty"`
-	SubGroups   []*Group             `json:"subGroups,omitempty"`
-	Access      *map[string]bool     `json:"access,omitempty"`
+	ID            *string              `json:"id,omitempty"`
+	Name          *string              `json:"name,omitempty"`
+	Path          *string              `json:"path,omitempty"`
+	ParentID      *string              `json:"parentId,omitempty"`
+	SubGroupCount *int64               `json:"subGroupCount,omitempty"`
+	Attributes    *map[string][]string `json:"attributes,omitempty"`
+	RealmRoles    []string             `json:"realmRoles,omitempty"`
+	ClientRoles   *map[string][]string `json:"clientRoles,omitempty"`
+	SubGroups     []*Group             `json:"subGroups,omitempty"`
+	Access        *map[string]bool     `json:"access,omitempty"`
 }
 
 // GroupsService ...
 type GroupsService service
 
 // Create a new group.
-func (s *GroupsService) Create(ctx context.Context, realm string, group *Group) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/groups", realm)
+func (s *GroupsService) Create(ctx context.Context, realm string, group *Group) (*Response, error) {
+	u := pathf("admin/realms/%s/groups", realm)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, group)
 	if err != nil {
 		return nil, err
@@ -32,9 +37,23 @@ func (s *GroupsService) Create(ctx context.Context, realm string, group *Group)
 	return s.keycloak.Do(ctx, req, nil)
 }
 
+// GroupListOptions specifies the optional parameters to the GroupsService.List method.
+type GroupListOptions struct {
+	BriefRepresentation *bool  `url:"briefRepresentation,omitempty"`
+	Exact               *bool  `url:"exact,omitempty"`
+	Q                   string `url:"q,omitempty"`
+	Search              string `url:"search,omitempty"`
+	Options
+}
+
 // List groups.
-func (s *GroupsService) List(ctx context.Context, realm string) ([]*Group, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/groups", realm)
+func (s *GroupsService) List(ctx context.Context, realm string, opts *GroupListOptions) ([]*Group, *Response, error) {
+	u := pathf("admin/realms/%s/groups", realm)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -49,9 +68,34 @@ func (s *GroupsService) List(ctx context.Context, realm string) ([]*Group, *http
 	return groups, res, nil
 }
 
+// ListAll lists all top level groups matching opts by requesting one page
+// after another until the result set is exhausted.
+func (s *GroupsService) ListAll(ctx context.Context, realm string, opts *GroupListOptions) ([]*Group, error) {
+	var o GroupListOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	var groups []*Group
+	err := Paginate(ctx, o.Options, func(ctx context.Context, page Options) (int, error) {
+		o.Options = page
+		next, _, err := s.List(ctx, realm, &o)
+		if err != nil {
+			return 0, err
+		}
+		groups = append(groups, next...)
+		return len(next), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
 // Get group.
-func (s *GroupsService) Get(ctx context.Context, realm, groupID string) (*Group, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/groups/%s", realm, groupID)
+func (s *GroupsService) Get(ctx context.Context, realm, groupID string) (*Group, *Response, error) {
+	u := pathf("admin/realms/%s/groups/%s", realm, groupID)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -66,11 +110,73 @@ func (s *GroupsService) Get(ctx context.Context, realm, groupID string) (*Group,
 	return &group, res, nil
 }
 
-// update group
+// GroupCountOptions specifies the optional parameters to the GroupsService.Count method.
+type GroupCountOptions struct {
+	Search string `url:"search,omitempty"`
+	Top    *bool  `url:"top,omitempty"`
+}
+
+// Count returns the number of groups in realm. Only top level groups are
+// counted if opts.Top is set.
+func (s *GroupsService) Count(ctx context.Context, realm string, opts *GroupCountOptions) (int, *Response, error) {
+	u := pathf("admin/realms/%s/groups/count", realm)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var count struct {
+		Count int `json:"count"`
+	}
+	res, err := s.keycloak.Do(ctx, req, &count)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return count.Count, res, nil
+}
+
+// GetByPath gets a group by its path, e.g. "/org/team/subteam".
+func (s *GroupsService) GetByPath(ctx context.Context, realm, path string) (*Group, *Response, error) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	u := pathf("admin/realms/%s/group-by-path/", realm) + strings.Join(segments, "/")
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var group Group
+	res, err := s.keycloak.Do(ctx, req, &group)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &group, res, nil
+}
+
+// Update group.
+func (s *GroupsService) Update(ctx context.Context, realm string, group *Group) (*Response, error) {
+	u := pathf("admin/realms/%s/groups/%s", realm, *group.ID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, group)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
 
 // Delete group.
-func (s *GroupsService) Delete(ctx context.Context, realm, groupID string) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/groups/%s", realm, groupID)
+func (s *GroupsService) Delete(ctx context.Context, realm, groupID string) (*Response, error) {
+	u := pathf("admin/realms/%s/groups/%s", realm, groupID)
 	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
 	if err != nil {
 		return nil, err
@@ -79,8 +185,135 @@ func (s *GroupsService) Delete(ctx context.Context, realm, groupID string) (*htt
 	return s.keycloak.Do(ctx, req, nil)
 }
 
-func (s *GroupsService) AddRealmRoles(ctx context.Context, realm, groupID string, roles []*Role) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/groups/%s/role-mappings/realm", realm, groupID)
+// CreateChild creates a new group as a child of the parent group. If the group
+// already exists it is moved below the parent.
+func (s *GroupsService) CreateChild(ctx context.Context, realm, parentID string, group *Group) (*Response, error) {
+	u := pathf("admin/realms/%s/groups/%s/children", realm, parentID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, group)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListChildren lists the direct children of the parent group.
+func (s *GroupsService) ListChildren(ctx context.Context, realm, parentID string, opts *GroupListOptions) ([]*Group, *Response, error) {
+	u := pathf("admin/realms/%s/groups/%s/children", realm, parentID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groups []*Group
+	res, err := s.keycloak.Do(ctx, req, &groups)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return groups, res, nil
+}
+
+// GroupMembersListOptions specifies the optional parameters to the GroupsService.ListMembers method.
+type GroupMembersListOptions struct {
+	BriefRepresentation *bool `url:"briefRepresentation,omitempty"`
+	Options
+}
+
+// ListMembers lists the users that are direct members of the group.
+func (s *GroupsService) ListMembers(ctx context.Context, realm, groupID string, opts *GroupMembersListOptions) ([]*User, *Response, error) {
+	u := pathf("admin/realms/%s/groups/%s/members", realm, groupID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*User
+	res, err := s.keycloak.Do(ctx, req, &users)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return users, res, nil
+}
+
+// ListAllMembers lists all direct members of the group by requesting one
+// page after another until the result set is exhausted.
+func (s *GroupsService) ListAllMembers(ctx context.Context, realm, groupID string, opts *GroupMembersListOptions) ([]*User, error) {
+	var o GroupMembersListOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	var users []*User
+	err := Paginate(ctx, o.Options, func(ctx context.Context, page Options) (int, error) {
+		o.Options = page
+		next, _, err := s.ListMembers(ctx, realm, groupID, &o)
+		if err != nil {
+			return 0, err
+		}
+		users = append(users, next...)
+		return len(next), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// AddRealmRoles adds realm roles to group.
+func (s *GroupsService) AddRealmRoles(ctx context.Context, realm, groupID string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/groups/%s/role-mappings/realm", realm, groupID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// RemoveRealmRoles removes assigned realm roles from group.
+func (s *GroupsService) RemoveRealmRoles(ctx context.Context, realm, groupID string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/groups/%s/role-mappings/realm", realm, groupID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListRealmRoles returns a list of realm roles assigned to group.
+func (s *GroupsService) ListRealmRoles(ctx context.Context, realm, groupID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/groups/%s/role-mappings/realm", realm, groupID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// AddClientRoles adds client roles to group.
+func (s *GroupsService) AddClientRoles(ctx context.Context, realm, groupID, clientID string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/groups/%s/role-mappings/clients/%s", realm, groupID, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, roles)
 	if err != nil {
 		return nil, err
@@ -89,8 +322,9 @@ func (s *GroupsService) AddRealmRoles(ctx context.Context, realm, groupID string
 	return s.keycloak.Do(ctx, req, nil)
 }
 
-func (s *GroupsService) RemoveRealmRoles(ctx context.Context, realm, groupID string, roles []*Role) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/groups/%s/role-mappings/realm", realm, groupID)
+// RemoveClientRoles removes assigned client roles from group.
+func (s *GroupsService) RemoveClientRoles(ctx context.Context, realm, groupID, clientID string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/groups/%s/role-mappings/clients/%s", realm, groupID, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodDelete, u, roles)
 	if err != nil {
 		return nil, err
@@ -98,3 +332,85 @@ func (s *GroupsService) RemoveRealmRoles(ctx context.Context, realm, groupID str
 
 	return s.keycloak.Do(ctx, req, nil)
 }
+
+// ListClientRoles returns a list of client roles assigned to group.
+func (s *GroupsService) ListClientRoles(ctx context.Context, realm, groupID, clientID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/groups/%s/role-mappings/clients/%s", realm, groupID, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// ListRealmRolesComposite returns the effective realm roles of group, including roles inherited through composite roles and parent groups.
+func (s *GroupsService) ListRealmRolesComposite(ctx context.Context, realm, groupID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/groups/%s/role-mappings/realm/composite", realm, groupID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// ListClientRolesComposite returns the effective client roles of group, including roles inherited through composite roles and parent groups.
+func (s *GroupsService) ListClientRolesComposite(ctx context.Context, realm, groupID, clientID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/groups/%s/role-mappings/clients/%s/composite", realm, groupID, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// GetAllRoleMappings returns all realm and client roles directly mapped to group.
+func (s *GroupsService) GetAllRoleMappings(ctx context.Context, realm, groupID string) (*RoleMappings, *Response, error) {
+	u := pathf("admin/realms/%s/groups/%s/role-mappings", realm, groupID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mappings RoleMappings
+	res, err := s.keycloak.Do(ctx, req, &mappings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mappings, res, nil
+}
+
+// GetManagementPermissions returns whether fine-grained admin permissions are
+// enabled for the group.
+func (s *GroupsService) GetManagementPermissions(ctx context.Context, realm, id string) (*ManagementPermissionReference, *Response, error) {
+	u := pathf("admin/realms/%s/groups/%s/management/permissions", realm, id)
+	return getManagementPermissions(ctx, s.keycloak, u)
+}
+
+// SetManagementPermissions enables or disables fine-grained admin permissions
+// for the group.
+func (s *GroupsService) SetManagementPermissions(ctx context.Context, realm, id string, ref *ManagementPermissionReference) (*ManagementPermissionReference, *Response, error) {
+	u := pathf("admin/realms/%s/groups/%s/management/permissions", realm, id)
+	return setManagementPermissions(ctx, s.keycloak, u, ref)
+}
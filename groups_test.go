@@ -56,7 +56,7 @@ func TestGroupsService_List(t *testing.T) {
 	createGroup(t, k, realm, "group_a")
 	createGroup(t, k, realm, "group_b")
 
-	groups, res, err := k.Groups.List(context.Background(), realm)
+	groups, res, err := k.Groups.List(context.Background(), realm, nil)
 	if err != nil {
 		t.Errorf("Groups.List returned error: %v", err)
 	}
@@ -92,6 +92,147 @@ func TestGroupsService_Get(t *testing.T) {
 	}
 }
 
+func TestGroupsService_GetByPath(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	parentID := createGroup(t, k, realm, "parent")
+
+	ctx := context.Background()
+
+	if _, err := k.Groups.CreateChild(ctx, realm, parentID, &Group{Name: String("child")}); err != nil {
+		t.Errorf("Groups.CreateChild returned error: %v", err)
+	}
+
+	group, res, err := k.Groups.GetByPath(ctx, realm, "/parent/child")
+	if err != nil {
+		t.Errorf("Groups.GetByPath returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if *group.Name != "child" {
+		t.Errorf("got: %s, want: %s", *group.Name, "child")
+	}
+}
+
+func TestGroupsService_Count(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	parentID := createGroup(t, k, realm, "parent")
+	createGroup(t, k, realm, "other")
+
+	ctx := context.Background()
+
+	if _, err := k.Groups.CreateChild(ctx, realm, parentID, &Group{Name: String("child")}); err != nil {
+		t.Errorf("Groups.CreateChild returned error: %v", err)
+	}
+
+	count, res, err := k.Groups.Count(ctx, realm, &GroupCountOptions{Top: Bool(true)})
+	if err != nil {
+		t.Errorf("Groups.Count returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if count != 2 {
+		t.Errorf("got: %d, want: %d", count, 2)
+	}
+}
+
+func TestGroupsService_Update(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	groupID := createGroup(t, k, realm, "group")
+
+	ctx := context.Background()
+
+	group, _, err := k.Groups.Get(ctx, realm, groupID)
+	if err != nil {
+		t.Errorf("Groups.Get returned error: %v", err)
+	}
+
+	group.Name = String("renamed")
+
+	res, err := k.Groups.Update(ctx, realm, group)
+	if err != nil {
+		t.Errorf("Groups.Update returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	group, _, err = k.Groups.Get(ctx, realm, groupID)
+	if err != nil {
+		t.Errorf("Groups.Get returned error: %v", err)
+	}
+
+	if *group.Name != "renamed" {
+		t.Errorf("got: %s, want: %s", *group.Name, "renamed")
+	}
+}
+
+func TestGroupsService_CreateChild(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	parentID := createGroup(t, k, realm, "parent")
+
+	res, err := k.Groups.CreateChild(context.Background(), realm, parentID, &Group{Name: String("child")})
+	if err != nil {
+		t.Errorf("Groups.CreateChild returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+}
+
+func TestGroupsService_ListChildren(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	parentID := createGroup(t, k, realm, "parent")
+
+	ctx := context.Background()
+
+	for _, name := range []string{"child_a", "child_b"} {
+		if _, err := k.Groups.CreateChild(ctx, realm, parentID, &Group{Name: String(name)}); err != nil {
+			t.Errorf("Groups.CreateChild returned error: %v", err)
+		}
+	}
+
+	groups, res, err := k.Groups.ListChildren(ctx, realm, parentID, nil)
+	if err != nil {
+		t.Errorf("Groups.ListChildren returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(groups) != 2 {
+		t.Errorf("got: %d, want: %d", len(groups), 2)
+	}
+}
+
 func TestGroupsService_Delete(t *testing.T) {
 	k := client(t)
 
@@ -182,3 +323,161 @@ func TestGroupsService_RemoveRealmRoles(t *testing.T) {
 		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
 	}
 }
+
+func TestGroupsService_ListRealmRoles(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	createRealmRole(t, k, realm, "role")
+	groupID := createGroup(t, k, realm, "group")
+
+	ctx := context.Background()
+
+	role, _, err := k.RealmRoles.GetByName(ctx, realm, "role")
+	if err != nil {
+		t.Errorf("RealmRoles.GetByName returned error: %v", err)
+	}
+
+	if _, err := k.Groups.AddRealmRoles(ctx, realm, groupID, []*Role{role}); err != nil {
+		t.Errorf("Groups.AddRealmRoles returned error: %v", err)
+	}
+
+	roles, res, err := k.Groups.ListRealmRoles(ctx, realm, groupID)
+	if err != nil {
+		t.Errorf("Groups.ListRealmRoles returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(roles) != 1 {
+		t.Errorf("got: %d, want: %d", len(roles), 1)
+	}
+}
+
+func TestGroupsService_ClientRoles(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	clientID := createClient(t, k, realm, "client")
+	createClientRole(t, k, realm, clientID, "role")
+	groupID := createGroup(t, k, realm, "group")
+
+	ctx := context.Background()
+
+	role, _, err := k.ClientRoles.Get(ctx, realm, clientID, "role")
+	if err != nil {
+		t.Errorf("ClientRoles.Get returned error: %v", err)
+	}
+
+	res, err := k.Groups.AddClientRoles(ctx, realm, groupID, clientID, []*Role{role})
+	if err != nil {
+		t.Errorf("Groups.AddClientRoles returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	roles, _, err := k.Groups.ListClientRoles(ctx, realm, groupID, clientID)
+	if err != nil {
+		t.Errorf("Groups.ListClientRoles returned error: %v", err)
+	}
+
+	if len(roles) != 1 {
+		t.Errorf("got: %d, want: %d", len(roles), 1)
+	}
+
+	res, err = k.Groups.RemoveClientRoles(ctx, realm, groupID, clientID, []*Role{role})
+	if err != nil {
+		t.Errorf("Groups.RemoveClientRoles returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestGroupsService_ListMembers(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	groupID := createGroup(t, k, realm, "group")
+
+	ctx := context.Background()
+
+	for _, username := range []string{"john", "jane"} {
+		userID := createUser(t, k, realm, username)
+		if _, err := k.Users.JoinGroup(ctx, realm, userID, groupID); err != nil {
+			t.Errorf("Users.JoinGroup returned error: %v", err)
+		}
+	}
+
+	opts := &GroupMembersListOptions{
+		Options: Options{
+			Max: 1,
+		},
+	}
+
+	users, res, err := k.Groups.ListMembers(ctx, realm, groupID, opts)
+	if err != nil {
+		t.Errorf("Groups.ListMembers returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(users) != 1 {
+		t.Errorf("got: %d, want: %d", len(users), 1)
+	}
+
+	users, err = k.Groups.ListAllMembers(ctx, realm, groupID, opts)
+	if err != nil {
+		t.Errorf("Groups.ListAllMembers returned error: %v", err)
+	}
+
+	if len(users) != 2 {
+		t.Errorf("got: %d, want: %d", len(users), 2)
+	}
+}
+
+func TestGroupsService_GetAllRoleMappings(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	roleName := "role"
+
+	createRealm(t, k, realm)
+	createRealmRole(t, k, realm, roleName)
+	groupID := createGroup(t, k, realm, "group")
+
+	ctx := context.Background()
+
+	role, _, err := k.RealmRoles.GetByName(ctx, realm, roleName)
+	if err != nil {
+		t.Errorf("RealmRoles.GetByName returned error: %v", err)
+	}
+
+	if _, err := k.Groups.AddRealmRoles(ctx, realm, groupID, []*Role{role}); err != nil {
+		t.Errorf("Groups.AddRealmRoles returned error: %v", err)
+	}
+
+	mappings, res, err := k.Groups.GetAllRoleMappings(ctx, realm, groupID)
+	if err != nil {
+		t.Errorf("Groups.GetAllRoleMappings returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(mappings.RealmMappings) != 1 {
+		t.Errorf("got: %d, want: %d", len(mappings.RealmMappings), 1)
+	}
+}
@@ -0,0 +1,142 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// OperationUnknown is the name of operations not sent by a service method,
+// e.g. by Keycloak.Call. The path is not used as name since it contains ids,
+// which would give metrics an unbounded number of labels.
+const OperationUnknown = "unknown"
+
+// Operation describes an API call for instrumentation.
+type Operation struct {
+	// Name is the service method that sent the request, e.g. "Users.Get",
+	// or OperationUnknown if the request was not sent by a service.
+	Name string
+
+	// Method is the http method of the request.
+	Method string
+
+	// Path is the path of the request relative to BaseURL.
+	Path string
+
+	// Realm the request is scoped to, if any.
+	Realm string
+
+	// Resource is the resource type following the realm in Path, e.g. "users".
+	Resource string
+
+	// ResourceID is the id following Resource in Path, if any.
+	ResourceID string
+}
+
+// Tracer starts a span for every API call, see WithTracer.
+type Tracer interface {
+	Start(ctx context.Context, op *Operation) (context.Context, Span)
+}
+
+// Span is an API call in progress.
+type Span interface {
+	// End is called when the call is done. res is nil if no response
+	// was received.
+	End(res *http.Response, err error)
+}
+
+// WithTracer traces every API call with t. Tracer is a small interface to
+// keep this package free of dependencies, package otelkeycloak, a module of
+// its own, implements it with OpenTelemetry:
+//
+//	k, err := keycloak.NewKeycloak(httpClient, baseURL,
+//		keycloak.WithTracer(otelkeycloak.NewTracer(otel.GetTracerProvider())))
+func WithTracer(t Tracer) ClientOption {
+	return func(k *Keycloak) {
+		k.tracer = t
+	}
+}
+
+var (
+	pkgPath = reflect.TypeOf(Keycloak{}).PkgPath()
+
+	// methodName matches exported methods like "(*UsersService).Get".
+	methodName = regexp.MustCompile(`^\(\*(\w+)\)\.([A-Z]\w*)$`)
+)
+
+// newOperation describes req. The name is taken from the first exported
+// method of this package on the call stack.
+func (k *Keycloak) newOperation(req *http.Request) *Operation {
+	path := strings.TrimPrefix(req.URL.EscapedPath(), k.BaseURL.EscapedPath())
+
+	op := &Operation{
+		Name:   OperationUnknown,
+		Method: req.Method,
+		Path:   path,
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i], _ = url.PathUnescape(segment)
+	}
+	for i := 0; i < len(segments)-1; i++ {
+		if segments[i] != "realms" {
+			continue
+		}
+		op.Realm = segments[i+1]
+		if i+2 < len(segments) {
+			op.Resource = segments[i+2]
+		}
+		if i+3 < len(segments) {
+			op.ResourceID = segments[i+3]
+		}
+		break
+	}
+
+	pcs := make([]uintptr, 16)
+	for _, pc := range pcs[:runtime.Callers(3, pcs)] {
+		if name := methodAt(pc); name != "" {
+			op.Name = name
+			break
+		}
+	}
+
+	return op
+}
+
+// methodNames caches the service method of each program counter on the call
+// stack of newOperation, "" if it is not in an exported method of this
+// package. Resolving frames and matching their names is too slow to repeat
+// on every request.
+var methodNames sync.Map
+
+// methodAt returns the service method containing pc, e.g. "Users.Get".
+func methodAt(pc uintptr) string {
+	if name, ok := methodNames.Load(pc); ok {
+		return name.(string)
+	}
+
+	name := ""
+	frames := runtime.CallersFrames([]uintptr{pc})
+	for {
+		frame, more := frames.Next()
+		function := strings.TrimPrefix(frame.Function, pkgPath+".")
+		if function != frame.Function {
+			if m := methodName.FindStringSubmatch(function); m != nil && m[2] != "Do" {
+				name = strings.TrimSuffix(m[1], "Service") + "." + m[2]
+				break
+			}
+		}
+		if !more {
+			break
+		}
+	}
+
+	methodNames.Store(pc, name)
+	return name
+}
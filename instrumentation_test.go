@@ -0,0 +1,73 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type testTracer struct {
+	ops    []*Operation
+	status []int
+}
+
+func (t *testTracer) Start(ctx context.Context, op *Operation) (context.Context, Span) {
+	t.ops = append(t.ops, op)
+	return ctx, t
+}
+
+func (t *testTracer) End(res *http.Response, err error) {
+	t.status = append(t.status, res.StatusCode)
+}
+
+func TestWithTracer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	tracer := &testTracer{}
+	k, err := NewKeycloak(nil, server.URL+"/", WithTracer(tracer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	if _, _, err := k.Users.GetByID(ctx, "first", "123"); err != nil {
+		t.Errorf("Users.GetByID returned error: %v", err)
+	}
+	if _, _, err := k.Users.GetManagementPermissions(ctx, "first"); err != nil {
+		t.Errorf("Users.GetManagementPermissions returned error: %v", err)
+	}
+	if _, err := k.Call(ctx, http.MethodGet, "admin/serverinfo", nil, nil, nil); err != nil {
+		t.Errorf("Call returned error: %v", err)
+	}
+	req, err := k.NewRequest(http.MethodGet, "admin/realms/first/groups/456", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := k.Do(ctx, req, nil); err != nil {
+		t.Errorf("Do returned error: %v", err)
+	}
+
+	want := []Operation{
+		{Name: "Users.GetByID", Method: "GET", Path: "admin/realms/first/users/123", Realm: "first", Resource: "users", ResourceID: "123"},
+		{Name: "Users.GetManagementPermissions", Method: "GET", Path: "admin/realms/first/users-management-permissions", Realm: "first", Resource: "users-management-permissions"},
+		{Name: "Keycloak.Call", Method: "GET", Path: "admin/serverinfo"},
+		{Name: OperationUnknown, Method: "GET", Path: "admin/realms/first/groups/456", Realm: "first", Resource: "groups", ResourceID: "456"},
+	}
+
+	if len(tracer.ops) != len(want) {
+		t.Fatalf("got: %d, want: %d", len(tracer.ops), len(want))
+	}
+	for i, op := range tracer.ops {
+		if *op != want[i] {
+			t.Errorf("got: %+v, want: %+v", *op, want[i])
+		}
+		if tracer.status[i] != http.StatusOK {
+			t.Errorf("got: %d, want: %d", tracer.status[i], http.StatusOK)
+		}
+	}
+}
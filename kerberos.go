@@ -0,0 +1,210 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// UserStorageProviderType is the provider type of user storage providers,
+// e.g. LDAP or Kerberos federation.
+const UserStorageProviderType = "org.keycloak.storage.UserStorageProvider"
+
+// KerberosProviderID is the provider id of the Kerberos user storage provider.
+const KerberosProviderID = "kerberos"
+
+// Edit modes of user storage providers.
+const (
+	EditModeReadOnly = "READ_ONLY"
+	EditModeWritable = "WRITABLE"
+	EditModeUnsynced = "UNSYNCED"
+)
+
+// Cache policies of user storage providers.
+const (
+	CachePolicyDefault     = "DEFAULT"
+	CachePolicyEvictDaily  = "EVICT_DAILY"
+	CachePolicyEvictWeekly = "EVICT_WEEKLY"
+	CachePolicyMaxLifespan = "MAX_LIFESPAN"
+	CachePolicyNoCache     = "NO_CACHE"
+)
+
+// KerberosFederationProvider is the typed configuration of a Kerberos user
+// storage provider, which authenticates users with SPNEGO.
+type KerberosFederationProvider struct {
+	// ID is set by ComponentsService.GetKerberosProvider and required by
+	// ComponentsService.UpdateKerberosProvider.
+	ID string
+
+	Name string
+
+	// Enabled defaults to true.
+	Enabled *bool
+
+	Priority int
+
+	// KerberosRealm is the name of the Kerberos realm, e.g. "EXAMPLE.COM".
+	// Required.
+	KerberosRealm string
+
+	// ServerPrincipal is the principal of the HTTP service, e.g.
+	// "HTTP/sso.example.com@EXAMPLE.COM". Required.
+	ServerPrincipal string
+
+	// KeyTab is the path of the keytab file with the credentials of the
+	// server principal on the Keycloak server. Required.
+	KeyTab string
+
+	Debug bool
+
+	// AllowPasswordAuthentication lets users log in with their Kerberos
+	// password too.
+	AllowPasswordAuthentication bool
+
+	// EditMode is EditModeReadOnly or EditModeUnsynced. Only used with
+	// AllowPasswordAuthentication. Defaults to EditModeReadOnly.
+	EditMode string
+
+	UpdateProfileFirstLogin bool
+
+	// CachePolicy defaults to CachePolicyDefault.
+	CachePolicy string
+}
+
+// Component validates the configuration and returns the user storage
+// component of the provider.
+func (p *KerberosFederationProvider) Component() (*Component, error) {
+	if err := required(KerberosProviderID, map[string]string{
+		"Name":            p.Name,
+		"KerberosRealm":   p.KerberosRealm,
+		"ServerPrincipal": p.ServerPrincipal,
+		"KeyTab":          p.KeyTab,
+	}); err != nil {
+		return nil, err
+	}
+	editMode := defaultString(p.EditMode, EditModeReadOnly)
+	cachePolicy := defaultString(p.CachePolicy, CachePolicyDefault)
+	if err := oneOf(KerberosProviderID, map[string][]string{
+		"EditMode":    {editMode, EditModeReadOnly, EditModeUnsynced},
+		"CachePolicy": {cachePolicy, CachePolicyDefault, CachePolicyEvictDaily, CachePolicyEvictWeekly, CachePolicyMaxLifespan, CachePolicyNoCache},
+	}); err != nil {
+		return nil, err
+	}
+
+	enabled := true
+	if p.Enabled != nil {
+		enabled = *p.Enabled
+	}
+	c := &Component{
+		Name:         String(p.Name),
+		ProviderID:   String(KerberosProviderID),
+		ProviderType: String(UserStorageProviderType),
+		Config: &map[string][]string{
+			"enabled":                     {strconv.FormatBool(enabled)},
+			"priority":                    {strconv.Itoa(p.Priority)},
+			"kerberosRealm":               {p.KerberosRealm},
+			"serverPrincipal":             {p.ServerPrincipal},
+			"keyTab":                      {p.KeyTab},
+			"debug":                       {strconv.FormatBool(p.Debug)},
+			"allowPasswordAuthentication": {strconv.FormatBool(p.AllowPasswordAuthentication)},
+			"editMode":                    {editMode},
+			"updateProfileFirstLogin":     {strconv.FormatBool(p.UpdateProfileFirstLogin)},
+			"cachePolicy":                 {cachePolicy},
+		},
+	}
+	if p.ID != "" {
+		c.ID = String(p.ID)
+	}
+	return c, nil
+}
+
+// kerberosProvider returns the typed configuration of the component.
+func kerberosProvider(c *Component) (*KerberosFederationProvider, error) {
+	if c.GetProviderID() != KerberosProviderID {
+		return nil, fmt.Errorf("keycloak: component %s is a %q provider, not %q", c.GetID(), c.GetProviderID(), KerberosProviderID)
+	}
+	config := c.GetConfig()
+	get := func(key string) string {
+		if len(config[key]) == 0 {
+			return ""
+		}
+		return config[key][0]
+	}
+	flag := func(key string) bool {
+		b, _ := strconv.ParseBool(get(key))
+		return b
+	}
+
+	priority, _ := strconv.Atoi(get("priority"))
+	enabled := get("enabled") != "false"
+	return &KerberosFederationProvider{
+		ID:                          c.GetID(),
+		Name:                        c.GetName(),
+		Enabled:                     &enabled,
+		Priority:                    priority,
+		KerberosRealm:               get("kerberosRealm"),
+		ServerPrincipal:             get("serverPrincipal"),
+		KeyTab:                      get("keyTab"),
+		Debug:                       flag("debug"),
+		AllowPasswordAuthentication: flag("allowPasswordAuthentication"),
+		EditMode:                    get("editMode"),
+		UpdateProfileFirstLogin:     flag("updateProfileFirstLogin"),
+		CachePolicy:                 get("cachePolicy"),
+	}, nil
+}
+
+// CreateKerberosProvider validates the provider and creates it in the realm.
+// The id of the new provider is returned in Response.LocationID.
+func (s *ComponentsService) CreateKerberosProvider(ctx context.Context, realm string, provider *KerberosFederationProvider) (*Response, error) {
+	component, err := provider.Component()
+	if err != nil {
+		return nil, err
+	}
+	component.ID = nil
+	return s.Create(ctx, realm, component)
+}
+
+// GetKerberosProvider gets the Kerberos user storage provider with id.
+func (s *ComponentsService) GetKerberosProvider(ctx context.Context, realm, id string) (*KerberosFederationProvider, *Response, error) {
+	component, res, err := s.Get(ctx, realm, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	provider, err := kerberosProvider(component)
+	if err != nil {
+		return nil, nil, err
+	}
+	return provider, res, nil
+}
+
+// UpdateKerberosProvider validates the provider and updates it. Config keys
+// set on the component but not covered by KerberosFederationProvider are
+// left unchanged.
+func (s *ComponentsService) UpdateKerberosProvider(ctx context.Context, realm string, provider *KerberosFederationProvider) (*Response, error) {
+	if provider.ID == "" {
+		return nil, fmt.Errorf("keycloak: %s: ID required", KerberosProviderID)
+	}
+	update, err := provider.Component()
+	if err != nil {
+		return nil, err
+	}
+
+	current, _, err := s.Get(ctx, realm, provider.ID)
+	if err != nil {
+		return nil, err
+	}
+	if current.GetProviderID() != KerberosProviderID {
+		return nil, fmt.Errorf("keycloak: component %s is a %q provider, not %q", provider.ID, current.GetProviderID(), KerberosProviderID)
+	}
+
+	config := current.GetConfig()
+	if config == nil {
+		config = map[string][]string{}
+	}
+	for key, values := range update.GetConfig() {
+		config[key] = values
+	}
+	current.Name = update.Name
+	current.Config = &config
+	return s.Update(ctx, realm, current)
+}
@@ -0,0 +1,117 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestKerberosFederationProvider_Component(t *testing.T) {
+	p := &KerberosFederationProvider{
+		Name:                        "kerberos",
+		KerberosRealm:               "EXAMPLE.COM",
+		ServerPrincipal:             "HTTP/sso.example.com@EXAMPLE.COM",
+		KeyTab:                      "/etc/krb5.keytab",
+		AllowPasswordAuthentication: true,
+	}
+	c, err := p.Component()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.GetProviderID() != KerberosProviderID || c.GetProviderType() != UserStorageProviderType {
+		t.Errorf("got: %+v", c)
+	}
+
+	got, err := kerberosProvider(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := *p
+	want.Enabled, want.EditMode, want.CachePolicy = Bool(true), EditModeReadOnly, CachePolicyDefault
+	if !reflect.DeepEqual(got, &want) {
+		t.Errorf("got: %+v, want: %+v", got, &want)
+	}
+
+	for _, tt := range []struct {
+		provider *KerberosFederationProvider
+		want     string
+	}{
+		{&KerberosFederationProvider{Name: "kerberos"}, "KerberosRealm, KeyTab, ServerPrincipal required"},
+		{&KerberosFederationProvider{Name: "kerberos", KerberosRealm: "R", ServerPrincipal: "P", KeyTab: "K", EditMode: EditModeWritable}, `invalid EditMode "WRITABLE"`},
+	} {
+		if _, err := tt.provider.Component(); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("got: %v, want: %s", err, tt.want)
+		}
+	}
+}
+
+func TestComponentsService_KerberosProvider(t *testing.T) {
+	stored := &Component{
+		ID:           String("krb"),
+		Name:         String("kerberos"),
+		ProviderID:   String(KerberosProviderID),
+		ProviderType: String(UserStorageProviderType),
+		ParentID:     String("first"),
+		Config: &map[string][]string{
+			"kerberosRealm":   {"EXAMPLE.COM"},
+			"serverPrincipal": {"HTTP/sso.example.com@EXAMPLE.COM"},
+			"keyTab":          {"/etc/krb5.keytab"},
+			"enabled":         {"true"},
+			"evictionHour":    {"3"},
+		},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/admin/realms/first/components/krb":
+			json.NewEncoder(w).Encode(stored)
+		case r.Method == http.MethodPut && r.URL.Path == "/admin/realms/first/components/krb":
+			stored = &Component{}
+			if err := json.NewDecoder(r.Body).Decode(stored); err != nil {
+				t.Fatal(err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	p, _, err := k.Components.GetKerberosProvider(ctx, "first", "krb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.ID != "krb" || p.KerberosRealm != "EXAMPLE.COM" || !*p.Enabled {
+		t.Errorf("got: %+v", p)
+	}
+
+	p.Debug = true
+	if _, err := k.Components.UpdateKerberosProvider(ctx, "first", p); err != nil {
+		t.Fatal(err)
+	}
+	config := stored.GetConfig()
+	if got := config["debug"]; !reflect.DeepEqual(got, []string{"true"}) {
+		t.Errorf("got: %v, want: [true]", got)
+	}
+	// keys not covered by the typed configuration are kept
+	if got := config["evictionHour"]; !reflect.DeepEqual(got, []string{"3"}) {
+		t.Errorf("got: %v, want: [3]", got)
+	}
+	if stored.GetParentID() != "first" {
+		t.Errorf("got: %s, want: %s", stored.GetParentID(), "first")
+	}
+
+	stored.ProviderID = String("ldap")
+	if _, _, err := k.Components.GetKerberosProvider(ctx, "first", "krb"); err == nil {
+		t.Error("expected error for ldap provider")
+	}
+}
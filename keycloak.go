@@ -10,6 +10,8 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -20,30 +22,93 @@ type Keycloak struct {
 
 	BaseURL *url.URL
 
+	// Retry configures retries of failed requests. Requests are not retried
+	// if nil.
+	Retry *RetryPolicy
+
+	// Limiter caps the number of concurrent requests and their rate.
+	// Requests are not limited if nil.
+	Limiter *Limiter
+
+	// ServerInfoTTL is how long the server version used by version dependent
+	// methods is cached. Defaults to DefaultServerInfoTTL.
+	ServerInfoTTL time.Duration
+
+	// DiscoveryTTL is how long the discovery document of a realm used by
+	// the OIDC methods is cached. Defaults to DefaultDiscoveryTTL.
+	DiscoveryTTL time.Duration
+
 	common service
 
-	Clients      *ClientsService
-	ClientRoles  *ClientRolesService
-	ClientScopes *ClientScopesService
-	Groups       *GroupsService
-	Permissions  *PermissionsService
-	Policies     *PoliciesService
-	Realms       *RealmsService
-	RealmRoles   *RealmRolesService
-	Resources    *ResourcesService
-	Scopes       *ScopesService
-	Users        *UsersService
+	// discovery caches the OpenID configuration of each realm.
+	discovery sync.Map
+
+	serverInfo serverInfoCache
+
+	// header is set on every request, see WithHeader.
+	header http.Header
+
+	// middleware wraps the transport of client, see WithMiddleware.
+	middleware []Middleware
+
+	// tracer traces every API call, see WithTracer.
+	tracer Tracer
+
+	// metrics records every API call, see WithMetrics.
+	metrics Metrics
+
+	// logger logs every API call, see WithLogger.
+	logger Logger
+
+	Account            *AccountService
+	AttackDetection    *AttackDetectionService
+	Authentication     *AuthenticationService
+	Authorization      *AuthorizationService
+	Clients            *ClientsService
+	ClientRegistration *ClientRegistrationService
+	ClientRoles        *ClientRolesService
+	ClientScopes       *ClientScopesService
+	Components         *ComponentsService
+	Events             *EventsService
+	Groups             *GroupsService
+	Keys               *KeysService
+	OIDC               *OIDCService
+	Permissions        *PermissionsService
+	Policies           *PoliciesService
+	Realms             *RealmsService
+	RealmLocalization  *RealmLocalizationService
+	RealmRoles         *RealmRolesService
+	Resources          *ResourcesService
+	RoleByID           *RoleByIDService
+	Scopes             *ScopesService
+	ServerInfo         *ServerInfoService
+	Sessions           *SessionsService
+	Users              *UsersService
+	UserProfile        *UserProfileService
+	UserStorage        *UserStorageService
 }
 
 type service struct {
 	keycloak *Keycloak
 }
 
+// pathf formats a URL path like fmt.Sprintf and escapes every argument as a
+// single path segment, so user input like role names or ids cannot change
+// the path.
+func pathf(format string, args ...string) string {
+	escaped := make([]interface{}, len(args))
+	for i, arg := range args {
+		escaped[i] = url.PathEscape(arg)
+	}
+	return fmt.Sprintf(format, escaped...)
+}
+
 // addOptions adds the parameters in opts as URL query parameters to s. opts
-// must be a struct whose fields may contain "url" tags.
+// must be url.Values or a struct whose fields may contain "url" tags.
+// Parameters already present in s are kept unless opts overrides them.
 func addOptions(s string, opts interface{}) (string, error) {
 	v := reflect.ValueOf(opts)
-	if v.Kind() == reflect.Ptr && v.IsNil() {
+	if opts == nil || v.Kind() == reflect.Ptr && v.IsNil() {
 		return s, nil
 	}
 
@@ -52,17 +117,25 @@ func addOptions(s string, opts interface{}) (string, error) {
 		return s, err
 	}
 
-	qs, err := query.Values(opts)
-	if err != nil {
-		return s, err
+	qs, ok := opts.(url.Values)
+	if !ok {
+		qs, err = query.Values(opts)
+		if err != nil {
+			return s, err
+		}
+	}
+
+	merged := u.Query()
+	for key, values := range qs {
+		merged[key] = values
 	}
 
-	u.RawQuery = qs.Encode()
+	u.RawQuery = merged.Encode()
 	return u.String(), nil
 }
 
 // NewKeycloak ...
-func NewKeycloak(httpClient *http.Client, baseURL string) (*Keycloak, error) {
+func NewKeycloak(httpClient *http.Client, baseURL string, opts ...ClientOption) (*Keycloak, error) {
 	if httpClient == nil {
 		httpClient = &http.Client{}
 	}
@@ -77,22 +150,55 @@ func NewKeycloak(httpClient *http.Client, baseURL string) (*Keycloak, error) {
 	}
 
 	k.common.keycloak = k
+	k.Account = (*AccountService)(&k.common)
+	k.AttackDetection = (*AttackDetectionService)(&k.common)
+	k.Authentication = (*AuthenticationService)(&k.common)
+	k.Authorization = (*AuthorizationService)(&k.common)
 	k.Clients = (*ClientsService)(&k.common)
+	k.ClientRegistration = (*ClientRegistrationService)(&k.common)
 	k.ClientRoles = (*ClientRolesService)(&k.common)
 	k.ClientScopes = (*ClientScopesService)(&k.common)
+	k.Components = (*ComponentsService)(&k.common)
+	k.Events = (*EventsService)(&k.common)
 	k.Groups = (*GroupsService)(&k.common)
+	k.Keys = (*KeysService)(&k.common)
+	k.OIDC = (*OIDCService)(&k.common)
 	k.Permissions = (*PermissionsService)(&k.common)
 	k.Policies = (*PoliciesService)(&k.common)
 	k.Realms = (*RealmsService)(&k.common)
+	k.RealmLocalization = (*RealmLocalizationService)(&k.common)
 	k.RealmRoles = (*RealmRolesService)(&k.common)
 	k.Resources = (*ResourcesService)(&k.common)
+	k.RoleByID = (*RoleByIDService)(&k.common)
 	k.Scopes = (*ScopesService)(&k.common)
+	k.ServerInfo = (*ServerInfoService)(&k.common)
+	k.Sessions = (*SessionsService)(&k.common)
 	k.Users = (*UsersService)(&k.common)
+	k.UserProfile = (*UserProfileService)(&k.common)
+	k.UserStorage = (*UserStorageService)(&k.common)
+
+	for _, opt := range opts {
+		opt(k)
+	}
+
+	if len(k.middleware) > 0 {
+		transport := httpClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		for i := len(k.middleware) - 1; i >= 0; i-- {
+			transport = k.middleware[i](transport)
+		}
+		client := *httpClient
+		client.Transport = transport
+		k.client = &client
+	}
 
 	return k, nil
 }
 
-// NewRequest ...
+// NewRequest creates an API request. url is resolved relative to BaseURL and
+// body, if not nil, is sent JSON encoded.
 func (k *Keycloak) NewRequest(method string, url string, body interface{}) (*http.Request, error) {
 	if !strings.HasSuffix(k.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", k.BaseURL)
@@ -122,19 +228,118 @@ func (k *Keycloak) NewRequest(method string, url string, body interface{}) (*htt
 	return req, nil
 }
 
-// Do ...
-func (k *Keycloak) Do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+// Call sends a request to an endpoint not covered by the services, e.g.
+//
+//	var orgs []map[string]interface{}
+//	_, err := k.Call(ctx, http.MethodGet, "admin/realms/myrealm/organizations", url.Values{"max": {"10"}}, nil, &orgs)
+//
+// path is resolved relative to BaseURL and should be built with escaped
+// segments. query may be nil, url.Values or a struct with "url" tags. body
+// is sent JSON encoded and the response is decoded into v like Do does.
+func (k *Keycloak) Call(ctx context.Context, method, path string, query, body, v interface{}) (*Response, error) {
+	u, err := addOptions(path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := k.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.Do(ctx, req, v)
+}
+
+// NewFormRequest creates an API request with a form encoded body, as used by
+// the OpenID Connect endpoints.
+func (k *Keycloak) NewFormRequest(method string, url string, form url.Values) (*http.Request, error) {
+	if !strings.HasSuffix(k.BaseURL.Path, "/") {
+		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", k.BaseURL)
+	}
+	u, err := k.BaseURL.Parse(url)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return req, nil
+}
+
+// Do sends an API request and decodes the JSON response into v. If v
+// implements io.Writer the raw response body is written to it instead. An
+// error of type *ErrorResponse is returned together with the response if the
+// API responds with a status code outside the 200 range. If the body cannot
+// be decoded the response is returned with the error as well, so only
+// transport errors come without a response.
+func (k *Keycloak) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if k.tracer == nil && k.metrics == nil && k.logger == nil {
+		return k.do(ctx, req, v)
+	}
+
+	op := k.newOperation(req)
+
+	var span Span
+	if k.tracer != nil {
+		ctx, span = k.tracer.Start(ctx, op)
+	}
+	if k.logger != nil {
+		k.logRequest(op, req)
+	}
+
+	start := time.Now()
+	res, err := k.do(ctx, req, v)
+	duration := time.Since(start)
+
+	if k.logger != nil {
+		k.logResponse(op, res.raw(), err, duration)
+	}
+	if k.metrics != nil {
+		k.metrics.Observe(op, res.raw(), err, duration)
+	}
+	if span != nil {
+		span.End(res.raw(), err)
+	}
+
+	return res, err
+}
+
+func (k *Keycloak) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
 	req = req.WithContext(ctx)
+	k.applyOptions(req)
+
+	if k.Limiter != nil {
+		if err := k.Limiter.acquire(ctx); err != nil {
+			return nil, err
+		}
+		defer k.Limiter.release()
+	}
 
-	res, err := k.client.Do(req)
+	raw, err := k.send(req)
 	if err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
+	defer raw.Body.Close()
+
+	res := newResponse(raw)
+	if err := CheckResponse(raw); err != nil {
+		return res, err
+	}
 
-	if v != nil {
+	switch v := v.(type) {
+	case nil:
+	case io.Writer:
+		if _, err := io.Copy(v, res.Body); err != nil {
+			return res, err
+		}
+	default:
 		if err := json.NewDecoder(res.Body).Decode(v); err != nil {
-			return nil, err
+			return res, err
 		}
 	}
 
@@ -145,13 +350,13 @@ func (k *Keycloak) Do(ctx context.Context, req *http.Request, v interface{}) (*h
 // to store v and returns a pointer to it.
 func Bool(v bool) *bool { return &v }
 
-// // Int is a helper routine that allocates a new int value
-// // to store v and returns a pointer to it.
-// func Int(v int) *int { return &v }
+// Int is a helper routine that allocates a new int value
+// to store v and returns a pointer to it.
+func Int(v int) *int { return &v }
 
-// // Int64 is a helper routine that allocates a new int64 value
-// // to store v and returns a pointer to it.
-// func Int64(v int64) *int64 { return &v }
+// Int64 is a helper routine that allocates a new int64 value
+// to store v and returns a pointer to it.
+func Int64(v int64) *int64 { return &v }
 
 // String is a helper routine that allocates a new string value
 // to store v and returns a pointer to it.
@@ -2,6 +2,10 @@ package keycloak
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
 	"testing"
 
 	"golang.org/x/oauth2"
@@ -45,3 +49,77 @@ func TestKeycloak_NewRequest(t *testing.T) {
 func TestKeycloak_Do(t *testing.T) {
 
 }
+
+func TestPathf(t *testing.T) {
+	got := pathf("admin/realms/%s/roles/%s", "first", "a/b c")
+	want := "admin/realms/first/roles/a%2Fb%20c"
+	if got != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+}
+
+func TestAddOptions(t *testing.T) {
+	got, err := addOptions("admin/realms/first/users?briefRepresentation=true", url.Values{"username": {"john&max=1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "admin/realms/first/users?briefRepresentation=true&username=john%26max%3D1"
+	if got != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+}
+
+func TestUsersService_GetByUsername_Escape(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("username"); got != "john&max=1" {
+			t.Errorf("got: %s, want: %s", got, "john&max=1")
+		}
+		if got := r.URL.Query().Get("max"); got != "" {
+			t.Errorf("got: %s, want empty max", got)
+		}
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := k.Users.GetByUsername(context.Background(), "first", "john&max=1"); err != nil {
+		t.Errorf("Users.GetByUsername returned error: %v", err)
+	}
+}
+
+func TestKeycloak_Call(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("got: %s, want: %s", r.Method, http.MethodPost)
+		}
+		if r.URL.Path != "/admin/realms/first/organizations" {
+			t.Errorf("got: %s, want: %s", r.URL.Path, "/admin/realms/first/organizations")
+		}
+		if got := r.URL.Query().Get("max"); got != "10" {
+			t.Errorf("got: %s, want: %s", got, "10")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name":"acme"}`))
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var org map[string]interface{}
+	body := map[string]string{"name": "acme"}
+	if _, err := k.Call(context.Background(), http.MethodPost, "admin/realms/first/organizations", url.Values{"max": {"10"}}, body, &org); err != nil {
+		t.Fatalf("Call returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(org, map[string]interface{}{"name": "acme"}) {
+		t.Errorf("got: %v, want: %v", org, map[string]interface{}{"name": "acme"})
+	}
+}
@@ -0,0 +1,134 @@
+package keycloaktest
+
+import (
+	"net/http"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+func (r *realm) client(id string) *keycloak.Client {
+	for _, c := range r.clients {
+		if c.GetID() == id {
+			return c
+		}
+	}
+	return nil
+}
+
+func (r *realm) conflictingClient(c *keycloak.Client) bool {
+	for _, other := range r.clients {
+		if other.GetID() != c.GetID() && other.GetClientID() == c.GetClientID() {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) serveClients(w http.ResponseWriter, r *http.Request, rlm *realm, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			query := r.URL.Query()
+			clientID := query.Get("clientId")
+			search := query.Get("search") == "true"
+			clients := []*keycloak.Client{}
+			for _, c := range rlm.clients {
+				if clientID == "" || matches(c.GetClientID(), clientID, !search) {
+					clients = append(clients, c)
+				}
+			}
+			first, end := page(r, len(clients))
+			writeJSON(w, http.StatusOK, clients[first:end])
+		case http.MethodPost:
+			s.createClient(w, r, rlm)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	client := rlm.client(segments[0])
+	if client == nil {
+		notFound(w, "Could not find client")
+		return
+	}
+
+	if len(segments) > 1 {
+		if segments[1] != "roles" {
+			notFound(w, "RESTEASY003210: Could not find resource for full path: "+r.URL.String())
+			return
+		}
+		roles := rlm.clientRoles[client.GetID()]
+		s.serveRoles(w, r, rlm, &roles, client.GetID(), segments[2:])
+		rlm.clientRoles[client.GetID()] = roles
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, client)
+	case http.MethodPut:
+		update := &keycloak.Client{}
+		clone(client, update)
+		if !decode(w, r, update) {
+			return
+		}
+		update.ID = client.ID
+		if rlm.conflictingClient(update) {
+			conflict(w, "Client "+update.GetClientID()+" already exists")
+			return
+		}
+		*client = *update
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		for i, c := range rlm.clients {
+			if c == client {
+				rlm.clients = append(rlm.clients[:i], rlm.clients[i+1:]...)
+				break
+			}
+		}
+		for _, role := range rlm.clientRoles[client.GetID()] {
+			for userID, roleIDs := range rlm.mappings {
+				rlm.mappings[userID] = remove(roleIDs, role.GetID())
+			}
+		}
+		delete(rlm.clientRoles, client.GetID())
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) createClient(w http.ResponseWriter, r *http.Request, rlm *realm) {
+	var client keycloak.Client
+	if !decode(w, r, &client) {
+		return
+	}
+	if client.GetClientID() == "" {
+		badRequest(w, "Client id is missing")
+		return
+	}
+	if client.ID == nil {
+		client.ID = keycloak.String(newID())
+	}
+	if rlm.conflictingClient(&client) || rlm.client(client.GetID()) != nil {
+		conflict(w, "Client "+client.GetClientID()+" already exists")
+		return
+	}
+
+	if client.Enabled == nil {
+		client.Enabled = keycloak.Bool(true)
+	}
+	if client.Protocol == nil {
+		client.Protocol = keycloak.String(keycloak.ProtocolOpenIDConnect)
+	}
+	if client.PublicClient == nil {
+		client.PublicClient = keycloak.Bool(false)
+	}
+	if client.BearerOnly == nil {
+		client.BearerOnly = keycloak.Bool(false)
+	}
+
+	rlm.clients = append(rlm.clients, &client)
+	created(w, r, client.GetID())
+}
@@ -0,0 +1,171 @@
+// Package container runs Keycloak in a docker container for integration
+// tests. It is a module of its own so that only the tests using it depend
+// on testcontainers-go.
+//
+//	c, err := container.Start(ctx, &container.Options{RealmFile: "testdata/realm.json"})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer c.Terminate(context.Background())
+//
+// For unit tests without docker use the in-memory fake of package
+// keycloaktest.
+package container
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/zemirco/keycloak/v2"
+)
+
+// DefaultImage is the Keycloak image started by Start.
+const DefaultImage = "quay.io/keycloak/keycloak:26.0"
+
+// DefaultStartupTimeout is how long Start waits for Keycloak to become ready
+// when Options.StartupTimeout is not set.
+const DefaultStartupTimeout = 2 * time.Minute
+
+// Options configures the container started by Start.
+type Options struct {
+	// Image to run. Defaults to DefaultImage. Only the Quarkus based images
+	// of Keycloak 17 and later are supported, older images neither know
+	// the start-dev command nor serve the API without the /auth prefix.
+	Image string
+
+	// AdminUsername and AdminPassword of the admin user created in the
+	// master realm. Default to "admin".
+	AdminUsername string
+	AdminPassword string
+
+	// RealmFile is the path of a realm JSON file, e.g. an export, which is
+	// imported once Keycloak is ready.
+	RealmFile string
+
+	// StartupTimeout is how long to wait for Keycloak to become ready.
+	// Defaults to DefaultStartupTimeout.
+	StartupTimeout time.Duration
+}
+
+// Container is a Keycloak server running in a docker container.
+type Container struct {
+	container testcontainers.Container
+
+	// ID of the container.
+	ID string
+
+	// URL of the server, e.g. "http://127.0.0.1:49153/".
+	URL string
+
+	// Keycloak is a client logged in as the admin user.
+	Keycloak *keycloak.Keycloak
+}
+
+// Start starts Keycloak in development mode in a docker container, waits
+// until it is ready and returns a client logged in as the admin user. The
+// container is managed with testcontainers-go, so a docker daemon must be
+// reachable, e.g. via DOCKER_HOST. Terminate the container when finished.
+func Start(ctx context.Context, opts *Options) (*Container, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	image := opts.Image
+	if image == "" {
+		image = DefaultImage
+	}
+	username := opts.AdminUsername
+	if username == "" {
+		username = "admin"
+	}
+	password := opts.AdminPassword
+	if password == "" {
+		password = "admin"
+	}
+	timeout := opts.StartupTimeout
+	if timeout == 0 {
+		timeout = DefaultStartupTimeout
+	}
+
+	var realm []byte
+	if opts.RealmFile != "" {
+		b, err := os.ReadFile(opts.RealmFile)
+		if err != nil {
+			return nil, err
+		}
+		if !json.Valid(b) {
+			return nil, fmt.Errorf("container: %s is not valid JSON", opts.RealmFile)
+		}
+		realm = b
+	}
+
+	// Keycloak 26 creates the admin user from the bootstrap variables,
+	// earlier releases from the KEYCLOAK_ADMIN ones, which 26 still accepts
+	ctr, err := testcontainers.Run(ctx, image,
+		testcontainers.WithExposedPorts("8080/tcp"),
+		testcontainers.WithEnv(map[string]string{
+			"KC_BOOTSTRAP_ADMIN_USERNAME": username,
+			"KC_BOOTSTRAP_ADMIN_PASSWORD": password,
+			"KEYCLOAK_ADMIN":              username,
+			"KEYCLOAK_ADMIN_PASSWORD":     password,
+		}),
+		testcontainers.WithCmd("start-dev"),
+		testcontainers.WithWaitStrategy(
+			wait.ForHTTP("/realms/master/.well-known/openid-configuration").
+				WithPort("8080/tcp").
+				WithStartupTimeout(timeout),
+		),
+	)
+	if ctr == nil {
+		return nil, fmt.Errorf("container: start: %w", err)
+	}
+	c := &Container{container: ctr, ID: ctr.GetContainerID()}
+	if err != nil {
+		c.Terminate(context.Background())
+		return nil, fmt.Errorf("container: start: %w", err)
+	}
+
+	if err := c.start(ctx, username, password, realm); err != nil {
+		c.Terminate(context.Background())
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Container) start(ctx context.Context, username, password string, realm []byte) error {
+	endpoint, err := c.container.PortEndpoint(ctx, "8080/tcp", "http")
+	if err != nil {
+		return err
+	}
+	c.URL = endpoint + "/"
+
+	config := &keycloak.TokenConfig{
+		BaseURL:  c.URL,
+		Realm:    "master",
+		ClientID: "admin-cli",
+		Username: username,
+		Password: password,
+	}
+	k, err := keycloak.NewKeycloak(config.Client(context.Background()), c.URL)
+	if err != nil {
+		return err
+	}
+	c.Keycloak = k
+
+	if realm != nil {
+		if _, err := k.Call(ctx, http.MethodPost, "admin/realms", nil, json.RawMessage(realm), nil); err != nil {
+			return fmt.Errorf("container: import realm: %w", err)
+		}
+	}
+	return nil
+}
+
+// Terminate stops and removes the container.
+func (c *Container) Terminate(ctx context.Context) error {
+	return c.container.Terminate(ctx)
+}
@@ -0,0 +1,31 @@
+package container
+
+import (
+	"context"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+func TestStart(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping container test in short mode")
+	}
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+
+	ctx := context.Background()
+
+	c, err := Start(ctx, &Options{RealmFile: "testdata/realm.json"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Terminate(context.Background())
+
+	users, _, err := c.Keycloak.Users.GetByUsername(ctx, "imported", "john")
+	if err != nil {
+		t.Fatalf("Users.GetByUsername returned error: %v", err)
+	}
+	if len(users) != 1 {
+		t.Errorf("got: %d, want: %d", len(users), 1)
+	}
+}
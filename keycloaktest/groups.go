@@ -0,0 +1,246 @@
+package keycloaktest
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+func (r *realm) group(id string) *keycloak.Group {
+	for _, g := range r.groups {
+		if g.GetID() == id {
+			return g
+		}
+	}
+	return nil
+}
+
+func (r *realm) children(parentID string) []*keycloak.Group {
+	var children []*keycloak.Group
+	for _, g := range r.groups {
+		if g.GetParentID() == parentID {
+			children = append(children, g)
+		}
+	}
+	return children
+}
+
+func (r *realm) groupPath(g *keycloak.Group) string {
+	if parent := r.group(g.GetParentID()); parent != nil {
+		return r.groupPath(parent) + "/" + g.GetName()
+	}
+	return "/" + g.GetName()
+}
+
+func (r *realm) groupByPath(path string) *keycloak.Group {
+	path = "/" + strings.Trim(path, "/")
+	for _, g := range r.groups {
+		if r.groupPath(g) == path {
+			return g
+		}
+	}
+	return nil
+}
+
+// groupRep returns a copy of g with its computed fields set, including its
+// subgroups if subGroups is set.
+func (r *realm) groupRep(g *keycloak.Group, subGroups bool) *keycloak.Group {
+	rep := &keycloak.Group{}
+	clone(g, rep)
+	rep.Path = keycloak.String(r.groupPath(g))
+	if rep.Attributes == nil {
+		rep.Attributes = &map[string][]string{}
+	}
+
+	children := r.children(g.GetID())
+	rep.SubGroupCount = keycloak.Int64(int64(len(children)))
+	rep.SubGroups = []*keycloak.Group{}
+	if subGroups {
+		for _, child := range children {
+			rep.SubGroups = append(rep.SubGroups, r.groupRep(child, true))
+		}
+	}
+	return rep
+}
+
+// listGroups responds with the groups matching the query of req.
+func (r *realm) listGroups(w http.ResponseWriter, req *http.Request, groups []*keycloak.Group) {
+	query := req.URL.Query()
+	search := query.Get("search")
+	exact := query.Get("exact") == "true"
+
+	reps := []*keycloak.Group{}
+	for _, g := range groups {
+		if search == "" || matches(g.GetName(), search, exact) {
+			reps = append(reps, r.groupRep(g, false))
+		}
+	}
+	first, end := page(req, len(reps))
+	writeJSON(w, http.StatusOK, reps[first:end])
+}
+
+// createGroup adds a group below the parent with parentID, which is empty
+// for top level groups.
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request, rlm *realm, parentID string) {
+	var group keycloak.Group
+	if !decode(w, r, &group) {
+		return
+	}
+	if strings.TrimSpace(group.GetName()) == "" {
+		badRequest(w, "Group name is missing")
+		return
+	}
+
+	for _, sibling := range rlm.children(parentID) {
+		if sibling.GetName() == group.GetName() && sibling.GetID() != group.GetID() {
+			if parentID == "" {
+				conflict(w, "Top level group named '"+group.GetName()+"' already exists.")
+			} else {
+				conflict(w, "Sibling group named '"+group.GetName()+"' already exists.")
+			}
+			return
+		}
+	}
+
+	// an existing group is moved below the parent
+	if existing := rlm.group(group.GetID()); existing != nil {
+		if parentID == "" {
+			existing.ParentID = nil
+		} else {
+			existing.ParentID = keycloak.String(parentID)
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	group.ID = keycloak.String(newID())
+	if parentID != "" {
+		group.ParentID = keycloak.String(parentID)
+	}
+	group.Path = nil
+	group.SubGroups = nil
+	group.SubGroupCount = nil
+	rlm.groups = append(rlm.groups, &group)
+
+	if parentID == "" {
+		created(w, r, group.GetID())
+		return
+	}
+	// Keycloak responds with the child and the Location of the group itself
+	w.Header().Set("Location", "http://"+r.Host+"/admin/realms/"+url.PathEscape(rlm.rep.GetRealm())+"/groups/"+group.GetID())
+	writeJSON(w, http.StatusCreated, rlm.groupRep(&group, false))
+}
+
+func (s *Server) serveGroups(w http.ResponseWriter, r *http.Request, rlm *realm, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			rlm.listGroups(w, r, rlm.children(""))
+		case http.MethodPost:
+			s.createGroup(w, r, rlm, "")
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	if segments[0] == "count" && len(segments) == 1 {
+		count := 0
+		for _, g := range rlm.groups {
+			if r.URL.Query().Get("top") == "true" && g.GetParentID() != "" {
+				continue
+			}
+			if matches(g.GetName(), r.URL.Query().Get("search"), false) {
+				count++
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]int{"count": count})
+		return
+	}
+
+	group := rlm.group(segments[0])
+	if group == nil {
+		notFound(w, "Could not find group by id")
+		return
+	}
+
+	if len(segments) > 1 {
+		switch {
+		case segments[1] == "children" && len(segments) == 2 && r.Method == http.MethodGet:
+			rlm.listGroups(w, r, rlm.children(group.GetID()))
+		case segments[1] == "children" && len(segments) == 2 && r.Method == http.MethodPost:
+			s.createGroup(w, r, rlm, group.GetID())
+		case segments[1] == "members" && len(segments) == 2 && r.Method == http.MethodGet:
+			members := []*keycloak.User{}
+			for _, u := range rlm.users {
+				if contains(rlm.members[u.GetID()], group.GetID()) {
+					members = append(members, u)
+				}
+			}
+			first, end := page(r, len(members))
+			writeJSON(w, http.StatusOK, members[first:end])
+		case segments[1] == "role-mappings":
+			s.serveRoleMappings(w, r, rlm, group.GetID(), segments[2:])
+		default:
+			notFound(w, "RESTEASY003210: Could not find resource for full path: "+r.URL.String())
+		}
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, rlm.groupRep(group, true))
+	case http.MethodPut:
+		update := &keycloak.Group{}
+		clone(group, update)
+		if !decode(w, r, update) {
+			return
+		}
+		for _, sibling := range rlm.children(group.GetParentID()) {
+			if sibling != group && sibling.GetName() == update.GetName() {
+				conflict(w, "Sibling group named '"+update.GetName()+"' already exists.")
+				return
+			}
+		}
+		group.Name = update.Name
+		group.Attributes = update.Attributes
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		rlm.deleteGroup(group)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// deleteGroup deletes g, its subgroups, their memberships and role mappings.
+func (r *realm) deleteGroup(g *keycloak.Group) {
+	for _, child := range r.children(g.GetID()) {
+		r.deleteGroup(child)
+	}
+	for i, other := range r.groups {
+		if other == g {
+			r.groups = append(r.groups[:i], r.groups[i+1:]...)
+			break
+		}
+	}
+	for userID, groupIDs := range r.members {
+		r.members[userID] = remove(groupIDs, g.GetID())
+	}
+	delete(r.mappings, g.GetID())
+}
+
+func (s *Server) serveGroupByPath(w http.ResponseWriter, r *http.Request, rlm *realm, segments []string) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+	group := rlm.groupByPath(strings.Join(segments, "/"))
+	if group == nil {
+		notFound(w, "Group path does not exist")
+		return
+	}
+	writeJSON(w, http.StatusOK, rlm.groupRep(group, true))
+}
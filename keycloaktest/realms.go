@@ -0,0 +1,95 @@
+package keycloaktest
+
+import (
+	"net/http"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+func (s *Server) serveRealms(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			realms := make([]*keycloak.Realm, len(s.realms))
+			for i, rlm := range s.realms {
+				realms[i] = rlm.rep
+			}
+			writeJSON(w, http.StatusOK, realms)
+		case http.MethodPost:
+			var rep keycloak.Realm
+			if !decode(w, r, &rep) {
+				return
+			}
+			if rep.GetRealm() == "" {
+				badRequest(w, "Realm name cannot be empty")
+				return
+			}
+			if s.realm(rep.GetRealm()) != nil {
+				conflict(w, "Conflict detected. See logs for details")
+				return
+			}
+			if rep.Enabled == nil {
+				rep.Enabled = keycloak.Bool(false)
+			}
+			s.realms = append(s.realms, newRealm(&rep))
+			created(w, r, rep.GetRealm())
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	rlm := s.realm(segments[0])
+	if rlm == nil {
+		notFound(w, "Realm not found.")
+		return
+	}
+
+	if len(segments) > 1 {
+		switch segments[1] {
+		case "users":
+			s.serveUsers(w, r, rlm, segments[2:])
+		case "groups":
+			s.serveGroups(w, r, rlm, segments[2:])
+		case "group-by-path":
+			s.serveGroupByPath(w, r, rlm, segments[2:])
+		case "roles":
+			s.serveRoles(w, r, rlm, &rlm.roles, "", segments[2:])
+		case "roles-by-id":
+			s.serveRoleByID(w, r, rlm, segments[2:])
+		case "clients":
+			s.serveClients(w, r, rlm, segments[2:])
+		default:
+			notFound(w, "RESTEASY003210: Could not find resource for full path: "+r.URL.String())
+		}
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, rlm.rep)
+	case http.MethodPut:
+		update := &keycloak.Realm{}
+		clone(rlm.rep, update)
+		if !decode(w, r, update) {
+			return
+		}
+		if update.GetRealm() != rlm.rep.GetRealm() && s.realm(update.GetRealm()) != nil {
+			conflict(w, "Realm with same name exists")
+			return
+		}
+		update.ID = rlm.rep.ID
+		rlm.rep = update
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		for i, other := range s.realms {
+			if other == rlm {
+				s.realms = append(s.realms[:i], s.realms[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
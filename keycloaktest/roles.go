@@ -0,0 +1,266 @@
+package keycloaktest
+
+import (
+	"net/http"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+// role returns the realm or client role with id.
+func (r *realm) role(id string) *keycloak.Role {
+	for _, role := range r.roles {
+		if role.GetID() == id {
+			return role
+		}
+	}
+	for _, roles := range r.clientRoles {
+		for _, role := range roles {
+			if role.GetID() == id {
+				return role
+			}
+		}
+	}
+	return nil
+}
+
+func roleByName(roles []*keycloak.Role, name string) *keycloak.Role {
+	for _, role := range roles {
+		if role.GetName() == name {
+			return role
+		}
+	}
+	return nil
+}
+
+// serveRoles serves the realm roles or, if clientID is set, the roles of
+// the client.
+func (s *Server) serveRoles(w http.ResponseWriter, r *http.Request, rlm *realm, roles *[]*keycloak.Role, clientID string, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			search := r.URL.Query().Get("search")
+			list := []*keycloak.Role{}
+			for _, role := range *roles {
+				if matches(role.GetName(), search, false) {
+					list = append(list, role)
+				}
+			}
+			first, end := page(r, len(list))
+			writeJSON(w, http.StatusOK, list[first:end])
+		case http.MethodPost:
+			var role keycloak.Role
+			if !decode(w, r, &role) {
+				return
+			}
+			if role.GetName() == "" {
+				badRequest(w, "Role name is missing")
+				return
+			}
+			if roleByName(*roles, role.GetName()) != nil {
+				conflict(w, "Role with name "+role.GetName()+" already exists")
+				return
+			}
+			role.ID = keycloak.String(newID())
+			role.Composite = keycloak.Bool(false)
+			role.Composites = nil
+			role.ClientRole = keycloak.Bool(clientID != "")
+			role.ContainerID = rlm.rep.ID
+			if clientID != "" {
+				role.ContainerID = keycloak.String(clientID)
+			}
+			if role.Attributes == nil {
+				role.Attributes = &map[string][]string{}
+			}
+			*roles = append(*roles, &role)
+			created(w, r, role.GetName())
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	role := roleByName(*roles, segments[0])
+	if role == nil {
+		notFound(w, "Could not find role")
+		return
+	}
+
+	if len(segments) == 2 && segments[1] == "users" && r.Method == http.MethodGet {
+		users := []*keycloak.User{}
+		for _, u := range rlm.users {
+			if contains(rlm.mappings[u.GetID()], role.GetID()) {
+				users = append(users, u)
+			}
+		}
+		first, end := page(r, len(users))
+		writeJSON(w, http.StatusOK, users[first:end])
+		return
+	}
+	if len(segments) > 1 {
+		notFound(w, "RESTEASY003210: Could not find resource for full path: "+r.URL.String())
+		return
+	}
+
+	s.serveRole(w, r, rlm, roles, role)
+}
+
+func (s *Server) serveRoleByID(w http.ResponseWriter, r *http.Request, rlm *realm, segments []string) {
+	if len(segments) != 1 {
+		notFound(w, "RESTEASY003210: Could not find resource for full path: "+r.URL.String())
+		return
+	}
+
+	role := rlm.role(segments[0])
+	if role == nil {
+		notFound(w, "Could not find role with id")
+		return
+	}
+
+	if !role.GetClientRole() {
+		s.serveRole(w, r, rlm, &rlm.roles, role)
+		return
+	}
+	clientRoles := rlm.clientRoles[role.GetContainerID()]
+	s.serveRole(w, r, rlm, &clientRoles, role)
+	rlm.clientRoles[role.GetContainerID()] = clientRoles
+}
+
+// serveRole serves a single role of roles.
+func (s *Server) serveRole(w http.ResponseWriter, r *http.Request, rlm *realm, roles *[]*keycloak.Role, role *keycloak.Role) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, role)
+	case http.MethodPut:
+		update := &keycloak.Role{}
+		clone(role, update)
+		if !decode(w, r, update) {
+			return
+		}
+		if other := roleByName(*roles, update.GetName()); other != nil && other != role {
+			conflict(w, "Role with name "+update.GetName()+" already exists")
+			return
+		}
+		role.Name = update.Name
+		role.Description = update.Description
+		role.Attributes = update.Attributes
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		for i, other := range *roles {
+			if other == role {
+				*roles = append((*roles)[:i], (*roles)[i+1:]...)
+				break
+			}
+		}
+		for userID, roleIDs := range rlm.mappings {
+			rlm.mappings[userID] = remove(roleIDs, role.GetID())
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// serveRoleMappings serves the role mappings of the user or group with id.
+func (s *Server) serveRoleMappings(w http.ResponseWriter, r *http.Request, rlm *realm, id string, segments []string) {
+	if len(segments) == 0 {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		mappings := &keycloak.RoleMappings{}
+		for _, roleID := range rlm.mappings[id] {
+			role := rlm.role(roleID)
+			if !role.GetClientRole() {
+				mappings.RealmMappings = append(mappings.RealmMappings, role)
+				continue
+			}
+			client := rlm.client(role.GetContainerID())
+			if mappings.ClientMappings == nil {
+				mappings.ClientMappings = map[string]*keycloak.ClientRoleMappings{}
+			}
+			m, ok := mappings.ClientMappings[client.GetClientID()]
+			if !ok {
+				m = &keycloak.ClientRoleMappings{ID: client.ID, Client: client.ClientID}
+				mappings.ClientMappings[client.GetClientID()] = m
+			}
+			m.Mappings = append(m.Mappings, role)
+		}
+		writeJSON(w, http.StatusOK, mappings)
+		return
+	}
+
+	var roles []*keycloak.Role
+	switch {
+	case segments[0] == "realm":
+		roles, segments = rlm.roles, segments[1:]
+	case segments[0] == "clients" && len(segments) > 1:
+		if rlm.client(segments[1]) == nil {
+			notFound(w, "Client not found")
+			return
+		}
+		roles, segments = rlm.clientRoles[segments[1]], segments[2:]
+	default:
+		notFound(w, "RESTEASY003210: Could not find resource for full path: "+r.URL.String())
+		return
+	}
+
+	mapped := []*keycloak.Role{}
+	available := []*keycloak.Role{}
+	for _, role := range roles {
+		if contains(rlm.mappings[id], role.GetID()) {
+			mapped = append(mapped, role)
+		} else {
+			available = append(available, role)
+		}
+	}
+
+	if len(segments) == 1 && r.Method == http.MethodGet {
+		switch segments[0] {
+		case "composite":
+			writeJSON(w, http.StatusOK, mapped)
+		case "available":
+			writeJSON(w, http.StatusOK, available)
+		default:
+			notFound(w, "RESTEASY003210: Could not find resource for full path: "+r.URL.String())
+		}
+		return
+	}
+	if len(segments) != 0 {
+		notFound(w, "RESTEASY003210: Could not find resource for full path: "+r.URL.String())
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, mapped)
+	case http.MethodPost, http.MethodDelete:
+		var reps []*keycloak.Role
+		if !decode(w, r, &reps) {
+			return
+		}
+		var roleIDs []string
+		for _, rep := range reps {
+			role := roleByName(roles, rep.GetName())
+			for _, other := range roles {
+				if rep.GetID() != "" && other.GetID() == rep.GetID() {
+					role = other
+				}
+			}
+			if role == nil {
+				notFound(w, "Role not found")
+				return
+			}
+			roleIDs = append(roleIDs, role.GetID())
+		}
+		for _, roleID := range roleIDs {
+			if r.Method == http.MethodDelete {
+				rlm.mappings[id] = remove(rlm.mappings[id], roleID)
+			} else if !contains(rlm.mappings[id], roleID) {
+				rlm.mappings[id] = append(rlm.mappings[id], roleID)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
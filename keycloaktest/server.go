@@ -0,0 +1,265 @@
+// Package keycloaktest provides an in-memory fake of the Keycloak admin API
+// for unit tests.
+//
+//	srv := keycloaktest.NewServer()
+//	defer srv.Close()
+//
+//	k := srv.Keycloak()
+//	res, err := k.Users.Create(ctx, "master", keycloak.NewUser().WithUsername("john"))
+//
+// The fake covers realms, users, groups, realm and client roles and clients
+// with the status codes, Location headers and error bodies of a real server.
+// Every request is accepted without authentication. A token endpoint issuing
+// a static access token is served for every realm so that clients configured
+// with keycloak.TokenConfig work, too.
+//
+// For integration tests against a real server package
+// keycloaktest/container runs Keycloak in docker.
+package keycloaktest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+// AccessToken is issued by the token endpoint of every realm.
+const AccessToken = "keycloaktest"
+
+// Server is a fake Keycloak server. It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	realms []*realm
+}
+
+// NewServer starts a fake Keycloak server with an empty "master" realm. The
+// caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{}
+	s.Server = httptest.NewServer(s)
+	s.AddRealm("master")
+	return s
+}
+
+// Keycloak returns a client for the server.
+func (s *Server) Keycloak(opts ...keycloak.ClientOption) *keycloak.Keycloak {
+	k, err := keycloak.NewKeycloak(s.Client(), s.URL+"/", opts...)
+	if err != nil {
+		panic(err)
+	}
+	return k
+}
+
+// AddRealm adds an empty, enabled realm, e.g. to seed the server before a
+// test.
+func (s *Server) AddRealm(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.realms = append(s.realms, newRealm(&keycloak.Realm{
+		Realm:   keycloak.String(name),
+		Enabled: keycloak.Bool(true),
+	}))
+}
+
+// realm holds the state of a realm. Resources are kept in creation order.
+type realm struct {
+	rep         *keycloak.Realm
+	users       []*keycloak.User
+	groups      []*keycloak.Group
+	roles       []*keycloak.Role
+	clients     []*keycloak.Client
+	clientRoles map[string][]*keycloak.Role
+
+	// members maps user ids to group ids.
+	members map[string][]string
+
+	// mappings maps user and group ids to role ids.
+	mappings map[string][]string
+}
+
+func newRealm(rep *keycloak.Realm) *realm {
+	if rep.ID == nil {
+		rep.ID = keycloak.String(newID())
+	}
+	return &realm{
+		rep:         rep,
+		clientRoles: map[string][]*keycloak.Role{},
+		members:     map[string][]string{},
+		mappings:    map[string][]string{},
+	}
+}
+
+func (s *Server) realm(name string) *realm {
+	for _, r := range s.realms {
+		if r.rep.GetRealm() == name {
+			return r
+		}
+	}
+	return nil
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments := strings.Split(strings.Trim(r.URL.EscapedPath(), "/"), "/")
+	for i, segment := range segments {
+		segments[i], _ = url.PathUnescape(segment)
+	}
+
+	switch {
+	case len(segments) >= 2 && segments[0] == "realms":
+		s.serveOIDC(w, r, segments[1], segments[2:])
+	case len(segments) >= 2 && segments[0] == "admin" && segments[1] == "realms":
+		s.serveRealms(w, r, segments[2:])
+	default:
+		writeError(w, http.StatusNotFound, "error", "RESTEASY003210: Could not find resource for full path: "+r.URL.String())
+	}
+}
+
+func (s *Server) serveOIDC(w http.ResponseWriter, r *http.Request, name string, segments []string) {
+	if s.realm(name) == nil {
+		writeError(w, http.StatusNotFound, "error", "Realm does not exist")
+		return
+	}
+
+	issuer := s.URL + "/realms/" + url.PathEscape(name)
+	switch strings.Join(segments, "/") {
+	case ".well-known/openid-configuration":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"issuer":                 issuer,
+			"authorization_endpoint": issuer + "/protocol/openid-connect/auth",
+			"token_endpoint":         issuer + "/protocol/openid-connect/token",
+			"userinfo_endpoint":      issuer + "/protocol/openid-connect/userinfo",
+			"end_session_endpoint":   issuer + "/protocol/openid-connect/logout",
+			"jwks_uri":               issuer + "/protocol/openid-connect/certs",
+		})
+	case "protocol/openid-connect/token":
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "error", "RESTEASY003650: No resource method found for GET, return 405 with Allow header")
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"access_token": AccessToken,
+			"token_type":   "Bearer",
+			"expires_in":   300,
+			"scope":        "profile email",
+		})
+	default:
+		writeError(w, http.StatusNotFound, "error", "Unable to find matching target resource method")
+	}
+}
+
+// writeError sends an error body like Keycloak does. The admin API mostly
+// reports errors as {"errorMessage": "..."} and missing resources as
+// {"error": "..."}.
+func writeError(w http.ResponseWriter, code int, key, message string) {
+	writeJSON(w, code, map[string]string{key: message})
+}
+
+func notFound(w http.ResponseWriter, message string) {
+	writeError(w, http.StatusNotFound, "error", message)
+}
+
+func conflict(w http.ResponseWriter, message string) {
+	writeError(w, http.StatusConflict, "errorMessage", message)
+}
+
+func badRequest(w http.ResponseWriter, message string) {
+	writeError(w, http.StatusBadRequest, "errorMessage", message)
+}
+
+func methodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "error", "RESTEASY003650: No resource method found, return 405 with Allow header")
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+// created responds like Keycloak does when a resource is created.
+func created(w http.ResponseWriter, r *http.Request, id string) {
+	u := *r.URL
+	u.Scheme = "http"
+	u.Host = r.Host
+	u.RawQuery = ""
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + id
+	u.RawPath = ""
+	w.Header().Set("Location", u.String())
+	w.WriteHeader(http.StatusCreated)
+}
+
+// decode reads the JSON body of r into v. A bad request is sent if it fails.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		badRequest(w, "Unable to read request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// clone deep copies src into dst, which must be a pointer to the same type.
+func clone(src, dst interface{}) {
+	b, err := json.Marshal(src)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(b, dst); err != nil {
+		panic(err)
+	}
+}
+
+// page applies the first and max query parameters to a list of n items and
+// returns the bounds of the page.
+func page(r *http.Request, n int) (int, int) {
+	query := r.URL.Query()
+	first, _ := strconv.Atoi(query.Get("first"))
+	if first < 0 {
+		first = 0
+	}
+	if first > n {
+		first = n
+	}
+	end := n
+	if max, err := strconv.Atoi(query.Get("max")); err == nil && max >= 0 && first+max < n {
+		end = first + max
+	}
+	return first, end
+}
+
+// matches reports whether value matches the query parameter, which is a
+// case-insensitive substring search unless exact is set.
+func matches(value, param string, exact bool) bool {
+	if exact {
+		return value == param
+	}
+	return strings.Contains(strings.ToLower(value), strings.ToLower(param))
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func now() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
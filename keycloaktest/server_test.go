@@ -0,0 +1,218 @@
+package keycloaktest
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+func location(t *testing.T, header string) string {
+	t.Helper()
+	parts := strings.Split(header, "/")
+	return parts[len(parts)-1]
+}
+
+func TestServer_Users(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	k := srv.Keycloak()
+	ctx := context.Background()
+
+	res, err := k.Users.Create(ctx, "master", keycloak.NewUser().WithUsername("John").WithEmail("john@example.com").WithEnabled(true))
+	if err != nil {
+		t.Fatalf("Users.Create returned error: %v", err)
+	}
+	id := location(t, res.Header.Get("Location"))
+
+	_, err = k.Users.Create(ctx, "master", keycloak.NewUser().WithUsername("john"))
+	if !keycloak.IsConflict(err) {
+		t.Errorf("got: %v, want a conflict", err)
+	}
+
+	user, _, err := k.Users.GetByID(ctx, "master", id)
+	if err != nil {
+		t.Fatalf("Users.GetByID returned error: %v", err)
+	}
+	if user.GetUsername() != "john" {
+		t.Errorf("got: %s, want: %s", user.GetUsername(), "john")
+	}
+
+	user.FirstName = keycloak.String("John")
+	if _, err := k.Users.Update(ctx, "master", user); err != nil {
+		t.Errorf("Users.Update returned error: %v", err)
+	}
+
+	users, _, err := k.Users.List(ctx, "master", &keycloak.UserListOptions{Search: "joh"})
+	if err != nil {
+		t.Fatalf("Users.List returned error: %v", err)
+	}
+	if len(users) != 1 || users[0].GetFirstName() != "John" {
+		t.Errorf("got: %d, want: %d", len(users), 1)
+	}
+
+	if _, err := k.Users.Delete(ctx, "master", id); err != nil {
+		t.Errorf("Users.Delete returned error: %v", err)
+	}
+
+	_, _, err = k.Users.GetByID(ctx, "master", id)
+	if !keycloak.IsNotFound(err) {
+		t.Errorf("got: %v, want not found", err)
+	}
+}
+
+func TestServer_Groups(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	k := srv.Keycloak()
+	ctx := context.Background()
+
+	res, err := k.Groups.Create(ctx, "master", &keycloak.Group{Name: keycloak.String("org")})
+	if err != nil {
+		t.Fatalf("Groups.Create returned error: %v", err)
+	}
+	parentID := location(t, res.Header.Get("Location"))
+
+	if _, err := k.Groups.CreateChild(ctx, "master", parentID, &keycloak.Group{Name: keycloak.String("team")}); err != nil {
+		t.Fatalf("Groups.CreateChild returned error: %v", err)
+	}
+
+	group, _, err := k.Groups.GetByPath(ctx, "master", "/org/team")
+	if err != nil {
+		t.Fatalf("Groups.GetByPath returned error: %v", err)
+	}
+	if group.GetParentID() != parentID {
+		t.Errorf("got: %s, want: %s", group.GetParentID(), parentID)
+	}
+
+	res, err = k.Users.Create(ctx, "master", keycloak.NewUser().WithUsername("john").WithGroups("/org"))
+	if err != nil {
+		t.Fatalf("Users.Create returned error: %v", err)
+	}
+	userID := location(t, res.Header.Get("Location"))
+
+	if _, err := k.Users.JoinGroup(ctx, "master", userID, group.GetID()); err != nil {
+		t.Errorf("Users.JoinGroup returned error: %v", err)
+	}
+
+	groups, _, err := k.Users.ListGroups(ctx, "master", userID, nil)
+	if err != nil {
+		t.Fatalf("Users.ListGroups returned error: %v", err)
+	}
+	if len(groups) != 2 {
+		t.Errorf("got: %d, want: %d", len(groups), 2)
+	}
+
+	if _, err := k.Groups.Delete(ctx, "master", parentID); err != nil {
+		t.Errorf("Groups.Delete returned error: %v", err)
+	}
+
+	count, _, err := k.Users.CountGroups(ctx, "master", userID, "")
+	if err != nil {
+		t.Fatalf("Users.CountGroups returned error: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("got: %d, want: %d", count, 0)
+	}
+}
+
+func TestServer_Roles(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	k := srv.Keycloak()
+	ctx := context.Background()
+
+	if _, err := k.RealmRoles.Create(ctx, "master", &keycloak.Role{Name: keycloak.String("admin")}); err != nil {
+		t.Fatalf("RealmRoles.Create returned error: %v", err)
+	}
+	_, err := k.RealmRoles.Create(ctx, "master", &keycloak.Role{Name: keycloak.String("admin")})
+	if !keycloak.IsConflict(err) {
+		t.Errorf("got: %v, want a conflict", err)
+	}
+
+	res, err := k.Clients.Create(ctx, "master", &keycloak.Client{ClientID: keycloak.String("app")})
+	if err != nil {
+		t.Fatalf("Clients.Create returned error: %v", err)
+	}
+	clientID := location(t, res.Header.Get("Location"))
+
+	if _, err := k.ClientRoles.Create(ctx, "master", clientID, &keycloak.Role{Name: keycloak.String("viewer")}); err != nil {
+		t.Fatalf("ClientRoles.Create returned error: %v", err)
+	}
+
+	res, err = k.Users.Create(ctx, "master", keycloak.NewUser().WithUsername("john"))
+	if err != nil {
+		t.Fatalf("Users.Create returned error: %v", err)
+	}
+	userID := location(t, res.Header.Get("Location"))
+
+	admin, _, err := k.RealmRoles.GetByName(ctx, "master", "admin")
+	if err != nil {
+		t.Fatalf("RealmRoles.GetByName returned error: %v", err)
+	}
+	if _, err := k.Users.AddRealmRoles(ctx, "master", userID, []*keycloak.Role{admin}); err != nil {
+		t.Errorf("Users.AddRealmRoles returned error: %v", err)
+	}
+
+	viewer, _, err := k.ClientRoles.Get(ctx, "master", clientID, "viewer")
+	if err != nil {
+		t.Fatalf("ClientRoles.Get returned error: %v", err)
+	}
+	if _, err := k.Users.AddClientRoles(ctx, "master", userID, clientID, []*keycloak.Role{viewer}); err != nil {
+		t.Errorf("Users.AddClientRoles returned error: %v", err)
+	}
+
+	mappings, _, err := k.Users.GetAllRoleMappings(ctx, "master", userID)
+	if err != nil {
+		t.Fatalf("Users.GetAllRoleMappings returned error: %v", err)
+	}
+	if len(mappings.RealmMappings) != 1 {
+		t.Errorf("got: %d, want: %d", len(mappings.RealmMappings), 1)
+	}
+	if len(mappings.ClientMappings["app"].Mappings) != 1 {
+		t.Errorf("got: %d, want: %d", len(mappings.ClientMappings["app"].Mappings), 1)
+	}
+
+	users, _, err := k.RealmRoles.GetUsers(ctx, "master", "admin", nil)
+	if err != nil {
+		t.Fatalf("RealmRoles.GetUsers returned error: %v", err)
+	}
+	if len(users) != 1 {
+		t.Errorf("got: %d, want: %d", len(users), 1)
+	}
+}
+
+func TestServer_Realms(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	k := srv.Keycloak()
+	ctx := context.Background()
+
+	if _, err := k.Realms.Create(ctx, &keycloak.Realm{Realm: keycloak.String("first"), Enabled: keycloak.Bool(true)}); err != nil {
+		t.Fatalf("Realms.Create returned error: %v", err)
+	}
+
+	_, _, err := k.Users.List(ctx, "second", nil)
+	if !keycloak.IsNotFound(err) {
+		t.Errorf("got: %v, want not found", err)
+	}
+
+	config := &keycloak.TokenConfig{
+		BaseURL:    srv.URL + "/",
+		Realm:      "first",
+		ClientID:   "admin-cli",
+		HTTPClient: srv.Client(),
+	}
+	token, err := config.TokenSource(ctx).Token()
+	if err != nil {
+		t.Fatalf("TokenSource returned error: %v", err)
+	}
+	if token.AccessToken != AccessToken {
+		t.Errorf("got: %s, want: %s", token.AccessToken, AccessToken)
+	}
+}
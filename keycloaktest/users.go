@@ -0,0 +1,315 @@
+package keycloaktest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+func (r *realm) user(id string) *keycloak.User {
+	for _, u := range r.users {
+		if u.GetID() == id {
+			return u
+		}
+	}
+	return nil
+}
+
+// conflictingUser returns the error message if another user has the same
+// username or email as u.
+func (r *realm) conflictingUser(u *keycloak.User) string {
+	for _, other := range r.users {
+		if other.GetID() == u.GetID() {
+			continue
+		}
+		if other.GetUsername() == u.GetUsername() {
+			return "User exists with same username"
+		}
+		if u.GetEmail() != "" && strings.EqualFold(other.GetEmail(), u.GetEmail()) {
+			return "User exists with same email"
+		}
+	}
+	return ""
+}
+
+// filterUsers returns the users matching the query of req like the users
+// endpoint does.
+func (r *realm) filterUsers(req *http.Request) []*keycloak.User {
+	query := req.URL.Query()
+	exact := query.Get("exact") == "true"
+
+	attributes := parseAttributeQuery(query.Get("q"))
+
+	var users []*keycloak.User
+	for _, u := range r.users {
+		if search := query.Get("search"); search != "" {
+			search = strings.Trim(search, "*")
+			if !matches(u.GetUsername(), search, false) && !matches(u.GetEmail(), search, false) &&
+				!matches(u.GetFirstName(), search, false) && !matches(u.GetLastName(), search, false) {
+				continue
+			}
+		}
+		if v := query.Get("username"); v != "" && !matches(u.GetUsername(), strings.ToLower(v), exact) {
+			continue
+		}
+		if v := query.Get("email"); v != "" && !matches(u.GetEmail(), v, exact) {
+			continue
+		}
+		if v := query.Get("firstName"); v != "" && !matches(u.GetFirstName(), v, exact) {
+			continue
+		}
+		if v := query.Get("lastName"); v != "" && !matches(u.GetLastName(), v, exact) {
+			continue
+		}
+		if v := query.Get("enabled"); v != "" && strconv.FormatBool(u.GetEnabled()) != v {
+			continue
+		}
+		if v := query.Get("emailVerified"); v != "" && strconv.FormatBool(u.GetEmailVerified()) != v {
+			continue
+		}
+		// attributes are matched exactly unless exact=false
+		if !hasAttributes(u.GetAttributes(), attributes, query.Get("exact") != "false") {
+			continue
+		}
+		users = append(users, u)
+	}
+	return users
+}
+
+// parseAttributeQuery parses the q parameter of the users endpoint, a list
+// of name:value pairs separated by spaces. Names and values may be quoted,
+// inside quotes a backslash escapes the following character.
+func parseAttributeQuery(q string) map[string]string {
+	attributes := map[string]string{}
+	for q != "" {
+		q = strings.TrimLeft(q, " ")
+		name, rest := parseQueryTerm(q, ":")
+		if !strings.HasPrefix(rest, ":") {
+			break
+		}
+		value, rest := parseQueryTerm(rest[1:], " ")
+		attributes[name] = value
+		q = rest
+	}
+	return attributes
+}
+
+// parseQueryTerm returns the quoted term at the start of s, or the term up
+// to one of the stop characters, and the remainder of s.
+func parseQueryTerm(s, stop string) (string, string) {
+	if !strings.HasPrefix(s, `"`) {
+		if i := strings.IndexAny(s, stop); i >= 0 {
+			return s[:i], s[i:]
+		}
+		return s, ""
+	}
+
+	var term strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				term.WriteByte(s[i])
+			}
+		case '"':
+			return term.String(), s[i+1:]
+		default:
+			term.WriteByte(s[i])
+		}
+	}
+	return term.String(), ""
+}
+
+func hasAttributes(attributes map[string][]string, want map[string]string, exact bool) bool {
+	for key, value := range want {
+		found := false
+		for _, v := range attributes[key] {
+			if matches(v, value, exact) {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) serveUsers(w http.ResponseWriter, r *http.Request, rlm *realm, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			users := rlm.filterUsers(r)
+			first, end := page(r, len(users))
+			writeJSON(w, http.StatusOK, append([]*keycloak.User{}, users[first:end]...))
+		case http.MethodPost:
+			s.createUser(w, r, rlm)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	if segments[0] == "count" && len(segments) == 1 {
+		writeJSON(w, http.StatusOK, len(rlm.filterUsers(r)))
+		return
+	}
+
+	user := rlm.user(segments[0])
+	if user == nil {
+		notFound(w, "User not found")
+		return
+	}
+
+	if len(segments) > 1 {
+		switch segments[1] {
+		case "groups":
+			s.serveUserGroups(w, r, rlm, user, segments[2:])
+		case "role-mappings":
+			s.serveRoleMappings(w, r, rlm, user.GetID(), segments[2:])
+		default:
+			notFound(w, "RESTEASY003210: Could not find resource for full path: "+r.URL.String())
+		}
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, user)
+	case http.MethodPut:
+		update := &keycloak.User{}
+		clone(user, update)
+		// like Keycloak, the attributes are replaced by the ones sent
+		update.Attributes = nil
+		if !decode(w, r, update) {
+			return
+		}
+		update.ID = user.ID
+		update.Username = keycloak.String(strings.ToLower(update.GetUsername()))
+		update.Credentials = nil
+		update.Groups = nil
+		if msg := rlm.conflictingUser(update); msg != "" {
+			conflict(w, msg)
+			return
+		}
+		*user = *update
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		for i, u := range rlm.users {
+			if u == user {
+				rlm.users = append(rlm.users[:i], rlm.users[i+1:]...)
+				break
+			}
+		}
+		delete(rlm.members, user.GetID())
+		delete(rlm.mappings, user.GetID())
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request, rlm *realm) {
+	var user keycloak.User
+	if !decode(w, r, &user) {
+		return
+	}
+	if user.GetUsername() == "" {
+		badRequest(w, "User name is missing")
+		return
+	}
+
+	user.ID = keycloak.String(newID())
+	user.Username = keycloak.String(strings.ToLower(user.GetUsername()))
+	user.CreatedTimestamp = keycloak.Int64(now())
+	if user.Enabled == nil {
+		user.Enabled = keycloak.Bool(false)
+	}
+	if user.EmailVerified == nil {
+		user.EmailVerified = keycloak.Bool(false)
+	}
+	user.Totp = keycloak.Bool(false)
+	if msg := rlm.conflictingUser(&user); msg != "" {
+		conflict(w, msg)
+		return
+	}
+
+	var groupIDs []string
+	for _, path := range user.Groups {
+		group := rlm.groupByPath(path)
+		if group == nil {
+			badRequest(w, "Group "+path+" not found")
+			return
+		}
+		groupIDs = append(groupIDs, group.GetID())
+	}
+
+	// credentials are accepted but never returned
+	user.Credentials = nil
+	user.Groups = nil
+
+	rlm.users = append(rlm.users, &user)
+	rlm.members[user.GetID()] = groupIDs
+	created(w, r, user.GetID())
+}
+
+func (s *Server) serveUserGroups(w http.ResponseWriter, r *http.Request, rlm *realm, user *keycloak.User, segments []string) {
+	userID := user.GetID()
+
+	var groups []*keycloak.Group
+	for _, id := range rlm.members[userID] {
+		if g := rlm.group(id); g != nil && matches(g.GetName(), r.URL.Query().Get("search"), false) {
+			groups = append(groups, rlm.groupRep(g, false))
+		}
+	}
+
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		first, end := page(r, len(groups))
+		writeJSON(w, http.StatusOK, append([]*keycloak.Group{}, groups[first:end]...))
+	case len(segments) == 1 && segments[0] == "count" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]int{"count": len(groups)})
+	case len(segments) == 1:
+		group := rlm.group(segments[0])
+		if group == nil {
+			notFound(w, "Group not found")
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			if !contains(rlm.members[userID], group.GetID()) {
+				rlm.members[userID] = append(rlm.members[userID], group.GetID())
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			rlm.members[userID] = remove(rlm.members[userID], group.GetID())
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(w)
+		}
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func contains(ids []string, id string) bool {
+	for _, other := range ids {
+		if other == id {
+			return true
+		}
+	}
+	return false
+}
+
+func remove(ids []string, id string) []string {
+	var kept []string
+	for _, other := range ids {
+		if other != id {
+			kept = append(kept, other)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,94 @@
+package keycloak
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// KeysService handles communication with the key related methods of the Keycloak API.
+type KeysService service
+
+// KeysMetadata lists the keys of a realm.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/KeysMetadataRepresentation.java
+type KeysMetadata struct {
+	// Active maps an algorithm to the key id of the active key.
+	Active map[string]string `json:"active,omitempty"`
+	Keys   []*KeyMetadata    `json:"keys,omitempty"`
+}
+
+// KeyMetadata describes a single key of a realm.
+type KeyMetadata struct {
+	ProviderID       *string `json:"providerId,omitempty"`
+	ProviderPriority *int64  `json:"providerPriority,omitempty"`
+	Kid              *string `json:"kid,omitempty"`
+	Status           *string `json:"status,omitempty"`
+	Type             *string `json:"type,omitempty"`
+	Algorithm        *string `json:"algorithm,omitempty"`
+	PublicKey        *string `json:"publicKey,omitempty"`
+	Certificate      *string `json:"certificate,omitempty"`
+	Use              *string `json:"use,omitempty"`
+	ValidTo          *int64  `json:"validTo,omitempty"`
+}
+
+// ActiveKey returns the active key for the algorithm, e.g. "RS256", or nil if there is none.
+func (m *KeysMetadata) ActiveKey(algorithm string) *KeyMetadata {
+	kid, ok := m.Active[algorithm]
+	if !ok {
+		return nil
+	}
+
+	for _, key := range m.Keys {
+		if key.Kid != nil && *key.Kid == kid {
+			return key
+		}
+	}
+
+	return nil
+}
+
+// RSAPublicKey parses the public key of an RSA key.
+func (k *KeyMetadata) RSAPublicKey() (*rsa.PublicKey, error) {
+	if k.PublicKey == nil {
+		return nil, errors.New("key has no public key")
+	}
+
+	der, err := base64.StdEncoding.DecodeString(*k.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("key is of type %T, not an RSA key", pub)
+	}
+
+	return rsaPub, nil
+}
+
+// GetKeyMetadata lists the keys of the realm.
+func (s *KeysService) GetKeyMetadata(ctx context.Context, realm string) (*KeysMetadata, *Response, error) {
+	u := pathf("admin/realms/%s/keys", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keys KeysMetadata
+	res, err := s.keycloak.Do(ctx, req, &keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &keys, res, nil
+}
@@ -0,0 +1,77 @@
+package keycloak
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"net/http"
+	"testing"
+)
+
+func TestKeysService_GetKeyMetadata(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	keys, res, err := k.Keys.GetKeyMetadata(context.Background(), realm)
+	if err != nil {
+		t.Errorf("Keys.GetKeyMetadata returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	key := keys.ActiveKey("RS256")
+	if key == nil {
+		t.Fatalf("got no active RS256 key, want one")
+	}
+
+	if _, err := key.RSAPublicKey(); err != nil {
+		t.Errorf("KeyMetadata.RSAPublicKey returned error: %v", err)
+	}
+}
+
+func TestKeysMetadata_ActiveKey(t *testing.T) {
+	priv, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := &KeysMetadata{
+		Active: map[string]string{"RS256": "active"},
+		Keys: []*KeyMetadata{
+			{Kid: String("passive"), Algorithm: String("RS256")},
+			{Kid: String("active"), Algorithm: String("RS256"), PublicKey: String(base64.StdEncoding.EncodeToString(der))},
+		},
+	}
+
+	if key := keys.ActiveKey("HS256"); key != nil {
+		t.Errorf("got: %s, want: nil", *key.Kid)
+	}
+
+	key := keys.ActiveKey("RS256")
+	if key == nil || *key.Kid != "active" {
+		t.Fatalf("got: %v, want: %s", key, "active")
+	}
+
+	pub, err := key.RSAPublicKey()
+	if err != nil {
+		t.Fatalf("KeyMetadata.RSAPublicKey returned error: %v", err)
+	}
+
+	if pub.N.Cmp(priv.PublicKey.N) != 0 {
+		t.Errorf("got a different public key")
+	}
+
+	if _, err := keys.Keys[0].RSAPublicKey(); err == nil {
+		t.Errorf("got no error, want one")
+	}
+}
@@ -0,0 +1,408 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LDAPMapperProviderType is the provider type of the mappers of an LDAP user
+// storage provider.
+const LDAPMapperProviderType = "org.keycloak.storage.ldap.mappers.LDAPStorageMapper"
+
+// Provider ids of the LDAP mappers.
+const (
+	LDAPUserAttributeMapperProviderID = "user-attribute-ldap-mapper"
+	LDAPFullNameMapperProviderID      = "full-name-ldap-mapper"
+	LDAPGroupMapperProviderID         = "group-ldap-mapper"
+	LDAPRoleMapperProviderID          = "role-ldap-mapper"
+)
+
+// Modes of the LDAP group and role mappers.
+const (
+	// LDAPMapperModeReadOnly reads groups and roles from LDAP, changes are
+	// not written back.
+	LDAPMapperModeReadOnly = "READ_ONLY"
+	// LDAPMapperModeLDAPOnly stores all group and role mappings in LDAP.
+	LDAPMapperModeLDAPOnly = "LDAP_ONLY"
+	// LDAPMapperModeImport imports the mappings from LDAP once, afterwards
+	// they are managed in Keycloak.
+	LDAPMapperModeImport = "IMPORT"
+)
+
+// Types of the membership attribute of LDAP groups and roles.
+const (
+	LDAPMembershipDN  = "DN"
+	LDAPMembershipUID = "UID"
+)
+
+// Strategies to retrieve the groups of a user with LDAPGroupMapper.
+const (
+	LoadGroupsByMemberAttribute            = "LOAD_GROUPS_BY_MEMBER_ATTRIBUTE"
+	LoadGroupsByMemberAttributeRecursively = "LOAD_GROUPS_BY_MEMBER_ATTRIBUTE_RECURSIVELY"
+	GetGroupsFromUserMemberOfAttribute     = "GET_GROUPS_FROM_USER_MEMBEROF_ATTRIBUTE"
+)
+
+// Strategies to retrieve the roles of a user with LDAPRoleMapper.
+const (
+	LoadRolesByMemberAttribute            = "LOAD_ROLES_BY_MEMBER_ATTRIBUTE"
+	GetRolesFromUserMemberOfAttribute     = "GET_ROLES_FROM_USER_MEMBEROF_ATTRIBUTE"
+	LoadRolesByMemberAttributeRecursively = "LOAD_ROLES_BY_MEMBER_ATTRIBUTE_RECURSIVELY"
+)
+
+// LDAPMapper is a typed configuration of an LDAP mapper component. Use it
+// with ComponentsService.CreateLDAPMapper instead of filling in the config
+// map of a Component by hand.
+type LDAPMapper interface {
+	// Component validates the configuration and returns the mapper
+	// component of the LDAP provider with id parentID.
+	Component(parentID string) (*Component, error)
+}
+
+// LDAPUserAttributeMapper maps an LDAP attribute to a user attribute, e.g.
+// "mail" to "email".
+type LDAPUserAttributeMapper struct {
+	Name string
+
+	// UserModelAttribute is the user attribute, e.g. "email" or a custom
+	// attribute. Required.
+	UserModelAttribute string
+
+	// LDAPAttribute is the LDAP attribute, e.g. "mail". Required.
+	LDAPAttribute string
+
+	ReadOnly                bool
+	AlwaysReadValueFromLDAP bool
+	IsMandatoryInLDAP       bool
+
+	// IsBinaryAttribute must be set for binary LDAP attributes like
+	// "jpegPhoto". It requires AlwaysReadValueFromLDAP.
+	IsBinaryAttribute bool
+
+	// AttributeDefaultValue is written to LDAP if the attribute is mandatory
+	// but empty in Keycloak.
+	AttributeDefaultValue string
+}
+
+// Component implements LDAPMapper.
+func (m *LDAPUserAttributeMapper) Component(parentID string) (*Component, error) {
+	if err := required(LDAPUserAttributeMapperProviderID, map[string]string{
+		"Name":               m.Name,
+		"UserModelAttribute": m.UserModelAttribute,
+		"LDAPAttribute":      m.LDAPAttribute,
+	}); err != nil {
+		return nil, err
+	}
+	if m.IsBinaryAttribute && !m.AlwaysReadValueFromLDAP {
+		return nil, fmt.Errorf("keycloak: %s: IsBinaryAttribute requires AlwaysReadValueFromLDAP", LDAPUserAttributeMapperProviderID)
+	}
+
+	config := map[string][]string{
+		"user.model.attribute":        {m.UserModelAttribute},
+		"ldap.attribute":              {m.LDAPAttribute},
+		"read.only":                   {strconv.FormatBool(m.ReadOnly)},
+		"always.read.value.from.ldap": {strconv.FormatBool(m.AlwaysReadValueFromLDAP)},
+		"is.mandatory.in.ldap":        {strconv.FormatBool(m.IsMandatoryInLDAP)},
+		"is.binary.attribute":         {strconv.FormatBool(m.IsBinaryAttribute)},
+	}
+	if m.AttributeDefaultValue != "" {
+		config["attribute.default.value"] = []string{m.AttributeDefaultValue}
+	}
+	return ldapMapper(m.Name, LDAPUserAttributeMapperProviderID, parentID, config), nil
+}
+
+// LDAPFullNameMapper maps a single LDAP attribute holding the full name,
+// e.g. "cn", to the first and last name of users.
+type LDAPFullNameMapper struct {
+	Name string
+
+	// LDAPFullNameAttribute defaults to "cn".
+	LDAPFullNameAttribute string
+
+	ReadOnly  bool
+	WriteOnly bool
+}
+
+// Component implements LDAPMapper.
+func (m *LDAPFullNameMapper) Component(parentID string) (*Component, error) {
+	if err := required(LDAPFullNameMapperProviderID, map[string]string{"Name": m.Name}); err != nil {
+		return nil, err
+	}
+	if m.ReadOnly && m.WriteOnly {
+		return nil, fmt.Errorf("keycloak: %s: ReadOnly and WriteOnly are mutually exclusive", LDAPFullNameMapperProviderID)
+	}
+
+	config := map[string][]string{
+		"ldap.full.name.attribute": {defaultString(m.LDAPFullNameAttribute, "cn")},
+		"read.only":                {strconv.FormatBool(m.ReadOnly)},
+		"write.only":               {strconv.FormatBool(m.WriteOnly)},
+	}
+	return ldapMapper(m.Name, LDAPFullNameMapperProviderID, parentID, config), nil
+}
+
+// LDAPGroupMapper maps LDAP groups to Keycloak groups.
+type LDAPGroupMapper struct {
+	Name string
+
+	// GroupsDN is the LDAP DN the groups are stored in, e.g.
+	// "ou=groups,dc=example,dc=com". Required.
+	GroupsDN string
+
+	// GroupNameLDAPAttribute defaults to "cn".
+	GroupNameLDAPAttribute string
+
+	// GroupObjectClasses defaults to "groupOfNames".
+	GroupObjectClasses []string
+
+	// PreserveGroupInheritance keeps the LDAP group hierarchy. Defaults to
+	// true.
+	PreserveGroupInheritance *bool
+
+	IgnoreMissingGroups bool
+
+	// MembershipLDAPAttribute defaults to "member".
+	MembershipLDAPAttribute string
+
+	// MembershipAttributeType is LDAPMembershipDN, the default, or
+	// LDAPMembershipUID.
+	MembershipAttributeType string
+
+	// MembershipUserLDAPAttribute is the user attribute referenced by UID
+	// memberships. Defaults to "uid".
+	MembershipUserLDAPAttribute string
+
+	// GroupsLDAPFilter is an additional LDAP filter, e.g. "(cn=staff*)".
+	GroupsLDAPFilter string
+
+	// Mode defaults to LDAPMapperModeReadOnly.
+	Mode string
+
+	// UserGroupsRetrieveStrategy defaults to LoadGroupsByMemberAttribute.
+	UserGroupsRetrieveStrategy string
+
+	// MappedGroupAttributes are LDAP attributes imported as group
+	// attributes.
+	MappedGroupAttributes []string
+
+	DropNonExistingGroupsDuringSync bool
+
+	// GroupsPath is the Keycloak group the groups are created in. Defaults
+	// to "/".
+	GroupsPath string
+}
+
+// Component implements LDAPMapper.
+func (m *LDAPGroupMapper) Component(parentID string) (*Component, error) {
+	if err := required(LDAPGroupMapperProviderID, map[string]string{
+		"Name":     m.Name,
+		"GroupsDN": m.GroupsDN,
+	}); err != nil {
+		return nil, err
+	}
+	mode := defaultString(m.Mode, LDAPMapperModeReadOnly)
+	membership := defaultString(m.MembershipAttributeType, LDAPMembershipDN)
+	strategy := defaultString(m.UserGroupsRetrieveStrategy, LoadGroupsByMemberAttribute)
+	if err := oneOf(LDAPGroupMapperProviderID, map[string][]string{
+		"Mode":                       {mode, LDAPMapperModeReadOnly, LDAPMapperModeLDAPOnly, LDAPMapperModeImport},
+		"MembershipAttributeType":    {membership, LDAPMembershipDN, LDAPMembershipUID},
+		"UserGroupsRetrieveStrategy": {strategy, LoadGroupsByMemberAttribute, LoadGroupsByMemberAttributeRecursively, GetGroupsFromUserMemberOfAttribute},
+	}); err != nil {
+		return nil, err
+	}
+	if err := ldapFilter(LDAPGroupMapperProviderID, m.GroupsLDAPFilter); err != nil {
+		return nil, err
+	}
+	if m.GroupsPath != "" && !strings.HasPrefix(m.GroupsPath, "/") {
+		return nil, fmt.Errorf("keycloak: %s: GroupsPath %q must start with /", LDAPGroupMapperProviderID, m.GroupsPath)
+	}
+
+	preserve := true
+	if m.PreserveGroupInheritance != nil {
+		preserve = *m.PreserveGroupInheritance
+	}
+	config := map[string][]string{
+		"groups.dn":                            {m.GroupsDN},
+		"group.name.ldap.attribute":            {defaultString(m.GroupNameLDAPAttribute, "cn")},
+		"group.object.classes":                 {defaultList(m.GroupObjectClasses, "groupOfNames")},
+		"preserve.group.inheritance":           {strconv.FormatBool(preserve)},
+		"ignore.missing.groups":                {strconv.FormatBool(m.IgnoreMissingGroups)},
+		"membership.ldap.attribute":            {defaultString(m.MembershipLDAPAttribute, "member")},
+		"membership.attribute.type":            {membership},
+		"membership.user.ldap.attribute":       {defaultString(m.MembershipUserLDAPAttribute, "uid")},
+		"mode":                                 {mode},
+		"user.roles.retrieve.strategy":         {strategy},
+		"drop.non.existing.groups.during.sync": {strconv.FormatBool(m.DropNonExistingGroupsDuringSync)},
+		"groups.path":                          {defaultString(m.GroupsPath, "/")},
+	}
+	if m.GroupsLDAPFilter != "" {
+		config["groups.ldap.filter"] = []string{m.GroupsLDAPFilter}
+	}
+	if len(m.MappedGroupAttributes) > 0 {
+		config["mapped.group.attributes"] = []string{strings.Join(m.MappedGroupAttributes, ",")}
+	}
+	return ldapMapper(m.Name, LDAPGroupMapperProviderID, parentID, config), nil
+}
+
+// LDAPRoleMapper maps LDAP groups to realm roles, or to the roles of a
+// client if ClientID is set.
+type LDAPRoleMapper struct {
+	Name string
+
+	// RolesDN is the LDAP DN the roles are stored in, e.g.
+	// "ou=roles,dc=example,dc=com". Required.
+	RolesDN string
+
+	// RoleNameLDAPAttribute defaults to "cn".
+	RoleNameLDAPAttribute string
+
+	// RoleObjectClasses defaults to "groupOfNames".
+	RoleObjectClasses []string
+
+	// MembershipLDAPAttribute defaults to "member".
+	MembershipLDAPAttribute string
+
+	// MembershipAttributeType is LDAPMembershipDN, the default, or
+	// LDAPMembershipUID.
+	MembershipAttributeType string
+
+	// MembershipUserLDAPAttribute is the user attribute referenced by UID
+	// memberships. Defaults to "uid".
+	MembershipUserLDAPAttribute string
+
+	// RolesLDAPFilter is an additional LDAP filter, e.g. "(cn=app-*)".
+	RolesLDAPFilter string
+
+	// Mode defaults to LDAPMapperModeReadOnly.
+	Mode string
+
+	// UserRolesRetrieveStrategy defaults to LoadRolesByMemberAttribute.
+	UserRolesRetrieveStrategy string
+
+	// ClientID is the client id, not the id, of the client the roles are
+	// mapped to. Realm roles are mapped if empty.
+	ClientID string
+}
+
+// Component implements LDAPMapper.
+func (m *LDAPRoleMapper) Component(parentID string) (*Component, error) {
+	if err := required(LDAPRoleMapperProviderID, map[string]string{
+		"Name":    m.Name,
+		"RolesDN": m.RolesDN,
+	}); err != nil {
+		return nil, err
+	}
+	mode := defaultString(m.Mode, LDAPMapperModeReadOnly)
+	membership := defaultString(m.MembershipAttributeType, LDAPMembershipDN)
+	strategy := defaultString(m.UserRolesRetrieveStrategy, LoadRolesByMemberAttribute)
+	if err := oneOf(LDAPRoleMapperProviderID, map[string][]string{
+		"Mode":                      {mode, LDAPMapperModeReadOnly, LDAPMapperModeLDAPOnly, LDAPMapperModeImport},
+		"MembershipAttributeType":   {membership, LDAPMembershipDN, LDAPMembershipUID},
+		"UserRolesRetrieveStrategy": {strategy, LoadRolesByMemberAttribute, LoadRolesByMemberAttributeRecursively, GetRolesFromUserMemberOfAttribute},
+	}); err != nil {
+		return nil, err
+	}
+	if err := ldapFilter(LDAPRoleMapperProviderID, m.RolesLDAPFilter); err != nil {
+		return nil, err
+	}
+
+	config := map[string][]string{
+		"roles.dn":                       {m.RolesDN},
+		"role.name.ldap.attribute":       {defaultString(m.RoleNameLDAPAttribute, "cn")},
+		"role.object.classes":            {defaultList(m.RoleObjectClasses, "groupOfNames")},
+		"membership.ldap.attribute":      {defaultString(m.MembershipLDAPAttribute, "member")},
+		"membership.attribute.type":      {membership},
+		"membership.user.ldap.attribute": {defaultString(m.MembershipUserLDAPAttribute, "uid")},
+		"mode":                           {mode},
+		"user.roles.retrieve.strategy":   {strategy},
+		"use.realm.roles.mapping":        {strconv.FormatBool(m.ClientID == "")},
+	}
+	if m.RolesLDAPFilter != "" {
+		config["roles.ldap.filter"] = []string{m.RolesLDAPFilter}
+	}
+	if m.ClientID != "" {
+		config["client.id"] = []string{m.ClientID}
+	}
+	return ldapMapper(m.Name, LDAPRoleMapperProviderID, parentID, config), nil
+}
+
+// CreateLDAPMapper validates the mapper and creates it for the LDAP user
+// storage provider with id ldapID.
+func (s *ComponentsService) CreateLDAPMapper(ctx context.Context, realm, ldapID string, mapper LDAPMapper) (*Response, error) {
+	component, err := mapper.Component(ldapID)
+	if err != nil {
+		return nil, err
+	}
+	return s.Create(ctx, realm, component)
+}
+
+// ListLDAPMappers lists the mappers of the LDAP user storage provider with
+// id ldapID.
+func (s *ComponentsService) ListLDAPMappers(ctx context.Context, realm, ldapID string) ([]*Component, *Response, error) {
+	return s.List(ctx, realm, &ComponentListOptions{Parent: ldapID, Type: LDAPMapperProviderType})
+}
+
+func ldapMapper(name, providerID, parentID string, config map[string][]string) *Component {
+	return &Component{
+		Name:         String(name),
+		ProviderID:   String(providerID),
+		ProviderType: String(LDAPMapperProviderType),
+		ParentID:     String(parentID),
+		Config:       &config,
+	}
+}
+
+// required returns an error naming the empty fields.
+func required(providerID string, fields map[string]string) error {
+	var missing []string
+	for name, v := range fields {
+		if v == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+	return fmt.Errorf("keycloak: %s: %s required", providerID, strings.Join(missing, ", "))
+}
+
+// oneOf checks that the first value of each field is one of the others.
+func oneOf(providerID string, fields map[string][]string) error {
+	for name, values := range fields {
+		valid := false
+		for _, v := range values[1:] {
+			if v == values[0] {
+				valid = true
+			}
+		}
+		if !valid {
+			return fmt.Errorf("keycloak: %s: invalid %s %q, want one of %s", providerID, name, values[0], strings.Join(values[1:], ", "))
+		}
+	}
+	return nil
+}
+
+// ldapFilter checks that filter is empty or enclosed in parentheses, which
+// Keycloak requires.
+func ldapFilter(providerID, filter string) error {
+	if filter == "" || strings.HasPrefix(filter, "(") && strings.HasSuffix(filter, ")") {
+		return nil
+	}
+	return fmt.Errorf("keycloak: %s: LDAP filter %q must be enclosed in parentheses", providerID, filter)
+}
+
+func defaultString(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+func defaultList(list []string, def string) string {
+	if len(list) == 0 {
+		return def
+	}
+	return strings.Join(list, ", ")
+}
@@ -0,0 +1,133 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLDAPMappers_Component(t *testing.T) {
+	tests := []struct {
+		mapper     LDAPMapper
+		providerID string
+		config     map[string]string
+	}{
+		{
+			mapper:     &LDAPUserAttributeMapper{Name: "email", UserModelAttribute: "email", LDAPAttribute: "mail", ReadOnly: true},
+			providerID: LDAPUserAttributeMapperProviderID,
+			config:     map[string]string{"user.model.attribute": "email", "ldap.attribute": "mail", "read.only": "true", "is.binary.attribute": "false"},
+		},
+		{
+			mapper:     &LDAPFullNameMapper{Name: "full name"},
+			providerID: LDAPFullNameMapperProviderID,
+			config:     map[string]string{"ldap.full.name.attribute": "cn", "write.only": "false"},
+		},
+		{
+			mapper: &LDAPGroupMapper{
+				Name:                     "groups",
+				GroupsDN:                 "ou=groups,dc=example,dc=com",
+				GroupObjectClasses:       []string{"groupOfNames", "group"},
+				PreserveGroupInheritance: Bool(false),
+				GroupsLDAPFilter:         "(cn=staff*)",
+				MappedGroupAttributes:    []string{"description", "mail"},
+			},
+			providerID: LDAPGroupMapperProviderID,
+			config: map[string]string{
+				"groups.dn":                  "ou=groups,dc=example,dc=com",
+				"group.object.classes":       "groupOfNames, group",
+				"preserve.group.inheritance": "false",
+				"groups.ldap.filter":         "(cn=staff*)",
+				"mapped.group.attributes":    "description,mail",
+				"mode":                       LDAPMapperModeReadOnly,
+				"membership.attribute.type":  LDAPMembershipDN,
+				"groups.path":                "/",
+			},
+		},
+		{
+			mapper:     &LDAPRoleMapper{Name: "roles", RolesDN: "ou=roles,dc=example,dc=com", ClientID: "app"},
+			providerID: LDAPRoleMapperProviderID,
+			config: map[string]string{
+				"roles.dn":                     "ou=roles,dc=example,dc=com",
+				"use.realm.roles.mapping":      "false",
+				"client.id":                    "app",
+				"user.roles.retrieve.strategy": LoadRolesByMemberAttribute,
+			},
+		},
+	}
+	for _, tt := range tests {
+		c, err := tt.mapper.Component("ldap")
+		if err != nil {
+			t.Errorf("%s: %v", tt.providerID, err)
+			continue
+		}
+		if c.GetProviderID() != tt.providerID || c.GetProviderType() != LDAPMapperProviderType || c.GetParentID() != "ldap" {
+			t.Errorf("got: %+v", c)
+		}
+		for key, want := range tt.config {
+			if got := c.GetConfig()[key]; !reflect.DeepEqual(got, []string{want}) {
+				t.Errorf("%s: %s: got: %v, want: %v", tt.providerID, key, got, want)
+			}
+		}
+	}
+}
+
+func TestLDAPMappers_Validate(t *testing.T) {
+	tests := []struct {
+		mapper LDAPMapper
+		want   string
+	}{
+		{&LDAPUserAttributeMapper{Name: "email"}, "LDAPAttribute, UserModelAttribute required"},
+		{&LDAPUserAttributeMapper{Name: "photo", UserModelAttribute: "photo", LDAPAttribute: "jpegPhoto", IsBinaryAttribute: true}, "requires AlwaysReadValueFromLDAP"},
+		{&LDAPFullNameMapper{Name: "full name", ReadOnly: true, WriteOnly: true}, "mutually exclusive"},
+		{&LDAPGroupMapper{Name: "groups", GroupsDN: "ou=groups", Mode: "read_only"}, `invalid Mode "read_only"`},
+		{&LDAPGroupMapper{Name: "groups", GroupsDN: "ou=groups", GroupsLDAPFilter: "cn=staff"}, "enclosed in parentheses"},
+		{&LDAPGroupMapper{Name: "groups", GroupsDN: "ou=groups", GroupsPath: "staff"}, "must start with /"},
+		{&LDAPRoleMapper{Name: "roles", RolesDN: "ou=roles", UserRolesRetrieveStrategy: LoadGroupsByMemberAttribute}, "invalid UserRolesRetrieveStrategy"},
+	}
+	for _, tt := range tests {
+		_, err := tt.mapper.Component("ldap")
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("got: %v, want: %s", err, tt.want)
+		}
+	}
+}
+
+func TestComponentsService_CreateLDAPMapper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/admin/realms/first/components" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+		var c Component
+		if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+			t.Fatal(err)
+		}
+		if c.GetProviderID() != LDAPFullNameMapperProviderID || c.GetParentID() != "ldap" {
+			t.Errorf("got: %+v", c)
+		}
+		w.Header().Set("Location", "http://"+r.Host+"/admin/realms/first/components/mapper")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := k.Components.CreateLDAPMapper(context.Background(), "first", "ldap", &LDAPFullNameMapper{Name: "full name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.LocationID != "mapper" {
+		t.Errorf("got: %s, want: %s", res.LocationID, "mapper")
+	}
+
+	// invalid mappers are not sent
+	if _, err := k.Components.CreateLDAPMapper(context.Background(), "first", "ldap", &LDAPFullNameMapper{}); err == nil {
+		t.Error("expected error for mapper without name")
+	}
+}
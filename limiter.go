@@ -0,0 +1,71 @@
+package keycloak
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter caps the number of concurrent requests and optionally the rate at
+// which requests are sent. Use it to keep bulk operations from overwhelming
+// the server or proxies in front of it. A Limiter may be shared by multiple
+// Keycloak instances.
+type Limiter struct {
+	sem      chan struct{}
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// NewLimiter returns a limiter that allows at most maxInFlight concurrent
+// requests and at most perSecond requests per second. A value less than or
+// equal to zero disables the respective limit.
+func NewLimiter(maxInFlight int, perSecond float64) *Limiter {
+	l := &Limiter{}
+	if maxInFlight > 0 {
+		l.sem = make(chan struct{}, maxInFlight)
+	}
+	if perSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / perSecond)
+	}
+	return l
+}
+
+// acquire blocks until a request may be sent concurrently to the ones in
+// flight. Every successful call must be followed by a call to release.
+func (l *Limiter) acquire(ctx context.Context) error {
+	if l.sem == nil {
+		return nil
+	}
+	select {
+	case l.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *Limiter) release() {
+	if l.sem != nil {
+		<-l.sem
+	}
+}
+
+// wait blocks until the next request may be sent without exceeding the rate.
+func (l *Limiter) wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next = slot.Add(l.interval)
+	l.mu.Unlock()
+
+	return sleep(ctx, time.Until(slot))
+}
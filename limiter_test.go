@@ -0,0 +1,81 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLimiter_MaxInFlight(t *testing.T) {
+	var inFlight, max int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.Limiter = NewLimiter(2, 0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := k.Users.Delete(context.Background(), "first", "id"); err != nil {
+				t.Errorf("Users.Delete returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if max > 2 {
+		t.Errorf("got: %d, want at most: %d", max, 2)
+	}
+}
+
+func TestLimiter_Rate(t *testing.T) {
+	l := NewLimiter(0, 100)
+
+	ctx := context.Background()
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the first request is sent immediately, the others every 10ms
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("got: %v, want at least: %v", elapsed, 40*time.Millisecond)
+	}
+}
+
+func TestLimiter_Canceled(t *testing.T) {
+	l := NewLimiter(1, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := l.acquire(ctx); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	if err := l.acquire(ctx); err != context.Canceled {
+		t.Errorf("got: %v, want: %v", err, context.Canceled)
+	}
+}
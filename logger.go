@@ -0,0 +1,167 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Logger is a leveled, structured logger. keysAndValues are alternating
+// keys and values, like the arguments of slog.Logger.Info. See NewSlogLogger
+// for an adapter to log/slog.
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// WithLogger logs a summary of every request and response at debug level
+// and retries at warn level. Passwords, secrets and tokens in the query and
+// body are redacted and headers are never logged.
+func WithLogger(l Logger) ClientOption {
+	return func(k *Keycloak) {
+		k.logger = l
+	}
+}
+
+// maxLoggedBody is the number of bytes of a request body that is logged.
+const maxLoggedBody = 2048
+
+const redacted = "REDACTED"
+
+// sensitiveKeys are parts of query parameter, form field and JSON keys whose
+// values are redacted.
+var sensitiveKeys = []string{"password", "secret", "token", "credential", "assertion", "code"}
+
+func sensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *Keycloak) logRequest(op *Operation, req *http.Request) {
+	kv := []interface{}{
+		"operation", op.Name,
+		"method", req.Method,
+		"url", redactURL(req.URL),
+	}
+	if body := redactBody(req); body != "" {
+		kv = append(kv, "body", body)
+	}
+	k.logger.Debug("keycloak: request", kv...)
+}
+
+func (k *Keycloak) logResponse(op *Operation, res *http.Response, err error, duration time.Duration) {
+	kv := []interface{}{
+		"operation", op.Name,
+		"duration", duration,
+	}
+	if res != nil {
+		kv = append(kv, "status", res.StatusCode)
+	}
+	if err != nil {
+		kv = append(kv, "error", err.Error())
+	}
+	k.logger.Debug("keycloak: response", kv...)
+}
+
+func redactURL(u *url.URL) string {
+	query := u.Query()
+	if len(query) == 0 {
+		return u.String()
+	}
+	for key := range query {
+		if sensitive(key) {
+			query.Set(key, redacted)
+		}
+	}
+	c := *u
+	c.RawQuery = query.Encode()
+	return c.String()
+}
+
+// redactBody returns the JSON or form encoded body of req with sensitive
+// values redacted. Other bodies are not logged.
+func redactBody(req *http.Request) string {
+	if req.GetBody == nil {
+		return ""
+	}
+	contentType := req.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, "application/json") && !strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		return ""
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return ""
+	}
+
+	var s string
+	if strings.HasPrefix(contentType, "application/json") {
+		var v interface{}
+		if err := json.Unmarshal(b, &v); err != nil {
+			return ""
+		}
+		b, err = json.Marshal(redactJSON(v))
+		if err != nil {
+			return ""
+		}
+		s = string(b)
+	} else {
+		form, err := url.ParseQuery(string(b))
+		if err != nil {
+			return ""
+		}
+		for key := range form {
+			if sensitive(key) {
+				form.Set(key, redacted)
+			}
+		}
+		s = form.Encode()
+	}
+
+	if len(s) > maxLoggedBody {
+		s = s[:maxLoggedBody] + "..."
+	}
+	return s
+}
+
+// redactJSON redacts the values of sensitive keys and of credentials, which
+// look like {"type": "password", "value": "..."}. Objects are redacted
+// recursively, arrays of sensitive keys like the component config
+// {"bindCredential": ["..."]} are redacted as a whole.
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		_, typed := v["type"]
+		for key, value := range v {
+			if _, ok := value.(map[string]interface{}); ok {
+				v[key] = redactJSON(value)
+				continue
+			}
+			if sensitive(key) || (typed && key == "value") {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactJSON(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSON(value)
+		}
+	}
+	return v
+}
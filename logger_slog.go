@@ -0,0 +1,33 @@
+package keycloak
+
+import (
+	"context"
+	"log/slog"
+)
+
+// NewSlogLogger adapts l to Logger, e.g.
+//
+//	k, err := keycloak.NewKeycloak(client, "http://localhost:8080/", keycloak.WithLogger(keycloak.NewSlogLogger(slog.Default())))
+func NewSlogLogger(l *slog.Logger) Logger {
+	return slogLogger{l}
+}
+
+type slogLogger struct {
+	l *slog.Logger
+}
+
+func (s slogLogger) Debug(msg string, keysAndValues ...interface{}) {
+	s.l.Log(context.Background(), slog.LevelDebug, msg, keysAndValues...)
+}
+
+func (s slogLogger) Info(msg string, keysAndValues ...interface{}) {
+	s.l.Log(context.Background(), slog.LevelInfo, msg, keysAndValues...)
+}
+
+func (s slogLogger) Warn(msg string, keysAndValues ...interface{}) {
+	s.l.Log(context.Background(), slog.LevelWarn, msg, keysAndValues...)
+}
+
+func (s slogLogger) Error(msg string, keysAndValues ...interface{}) {
+	s.l.Log(context.Background(), slog.LevelError, msg, keysAndValues...)
+}
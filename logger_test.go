@@ -0,0 +1,119 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testLogger struct {
+	lines []string
+}
+
+func (l *testLogger) log(level, msg string, keysAndValues ...interface{}) {
+	l.lines = append(l.lines, strings.TrimSpace(fmt.Sprintln(append([]interface{}{level, msg}, keysAndValues...)...)))
+}
+
+func (l *testLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.log("DEBUG", msg, keysAndValues...)
+}
+
+func (l *testLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.log("INFO", msg, keysAndValues...)
+}
+
+func (l *testLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.log("WARN", msg, keysAndValues...)
+}
+
+func (l *testLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.log("ERROR", msg, keysAndValues...)
+}
+
+func TestWithLogger(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	logger := &testLogger{}
+	k, err := NewKeycloak(nil, server.URL+"/", WithLogger(logger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.Retry = &RetryPolicy{Backoff: noBackoff}
+
+	user := NewUser().
+		WithUsername("john").
+		WithPassword("s3cr3t", false)
+	if _, err := k.Users.Create(context.Background(), "first", user); err != nil {
+		t.Errorf("Users.Create returned error: %v", err)
+	}
+
+	if len(logger.lines) != 3 {
+		t.Fatalf("got: %d, want: %d", len(logger.lines), 3)
+	}
+
+	for i, want := range []string{
+		"DEBUG keycloak: request operation Users.Create",
+		"WARN keycloak: retrying request",
+		"DEBUG keycloak: response operation Users.Create",
+	} {
+		if !strings.HasPrefix(logger.lines[i], want) {
+			t.Errorf("got: %q, want prefix: %q", logger.lines[i], want)
+		}
+	}
+
+	if !strings.Contains(logger.lines[0], `"username":"john"`) {
+		t.Errorf("got: %q, want the request body", logger.lines[0])
+	}
+	if strings.Contains(logger.lines[0], "s3cr3t") {
+		t.Errorf("got: %q, want the password redacted", logger.lines[0])
+	}
+	if !strings.Contains(logger.lines[2], "status 201") {
+		t.Errorf("got: %q, want the status", logger.lines[2])
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	u, _ := http.NewRequest(http.MethodGet, "http://localhost/token?client_secret=s3cr3t&max=10", nil)
+	got := redactURL(u.URL)
+	want := "http://localhost/token?client_secret=REDACTED&max=10"
+	if got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestRedactJSON(t *testing.T) {
+	v := map[string]interface{}{
+		"username": "john",
+		"credentials": []interface{}{
+			map[string]interface{}{"type": "password", "value": "s3cr3t"},
+		},
+		"config": map[string]interface{}{
+			"bindCredential": []interface{}{"s3cr3t"},
+			"bindDn":         []interface{}{"cn=admin"},
+		},
+	}
+	got := fmt.Sprint(redactJSON(v))
+	want := "map[config:map[bindCredential:REDACTED bindDn:[cn=admin]] credentials:REDACTED username:john]"
+	if got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+
+	// credential sent by UsersService.ResetPassword
+	got = fmt.Sprint(redactJSON(map[string]interface{}{"type": "password", "value": "s3cr3t"}))
+	want = "map[type:password value:REDACTED]"
+	if got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
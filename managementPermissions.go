@@ -0,0 +1,48 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+)
+
+// ManagementPermissionReference describes the fine-grained admin permissions
+// of a user, group, client or role. Enabling them creates a permission for
+// every scope in the realm-management client, which can then be associated
+// with policies to delegate administration.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/ManagementPermissionReference.java
+type ManagementPermissionReference struct {
+	Enabled          *bool              `json:"enabled,omitempty"`
+	Resource         *string            `json:"resource,omitempty"`
+	ScopePermissions *map[string]string `json:"scopePermissions,omitempty"`
+}
+
+func getManagementPermissions(ctx context.Context, k *Keycloak, u string) (*ManagementPermissionReference, *Response, error) {
+	req, err := k.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ref ManagementPermissionReference
+	res, err := k.Do(ctx, req, &ref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &ref, res, nil
+}
+
+func setManagementPermissions(ctx context.Context, k *Keycloak, u string, ref *ManagementPermissionReference) (*ManagementPermissionReference, *Response, error) {
+	req, err := k.NewRequest(http.MethodPut, u, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated ManagementPermissionReference
+	res, err := k.Do(ctx, req, &updated)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &updated, res, nil
+}
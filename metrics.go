@@ -0,0 +1,62 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Metrics records every API call, see WithMetrics and PrometheusMetrics.
+type Metrics interface {
+	// Observe is called when a call is done. res is nil if no response was
+	// received.
+	Observe(op *Operation, res *http.Response, err error, duration time.Duration)
+}
+
+// WithMetrics records every API call with m.
+func WithMetrics(m Metrics) ClientOption {
+	return func(k *Keycloak) {
+		k.metrics = m
+	}
+}
+
+// Error classes returned by ErrorClass.
+const (
+	ErrorClassCanceled    = "canceled"
+	ErrorClassTimeout     = "timeout"
+	ErrorClassNetwork     = "network"
+	ErrorClassClient      = "client_error"
+	ErrorClassServer      = "server_error"
+	ErrorClassRateLimited = "rate_limited"
+	ErrorClassDecode      = "decode"
+)
+
+// ErrorClass groups the outcome of a call for metrics and alerting. It
+// returns an empty string for successful calls.
+func ErrorClass(res *http.Response, err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.Canceled):
+		return ErrorClassCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassTimeout
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return ErrorClassTimeout
+	case res == nil:
+		return ErrorClassNetwork
+	case res.StatusCode == http.StatusTooManyRequests:
+		return ErrorClassRateLimited
+	case res.StatusCode >= 500:
+		return ErrorClassServer
+	case res.StatusCode >= 400:
+		return ErrorClassClient
+	default:
+		return ErrorClassDecode
+	}
+}
@@ -0,0 +1,38 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestErrorClass(t *testing.T) {
+	tests := []struct {
+		res  *http.Response
+		err  error
+		want string
+	}{
+		{&http.Response{StatusCode: http.StatusOK}, nil, ""},
+		{nil, context.Canceled, ErrorClassCanceled},
+		{nil, context.DeadlineExceeded, ErrorClassTimeout},
+		{nil, timeoutError{}, ErrorClassTimeout},
+		{nil, errors.New("connection refused"), ErrorClassNetwork},
+		{&http.Response{StatusCode: http.StatusTooManyRequests}, errors.New("429"), ErrorClassRateLimited},
+		{&http.Response{StatusCode: http.StatusNotFound}, errors.New("404"), ErrorClassClient},
+		{&http.Response{StatusCode: http.StatusBadGateway}, errors.New("502"), ErrorClassServer},
+		{&http.Response{StatusCode: http.StatusOK}, errors.New("unexpected EOF"), ErrorClassDecode},
+	}
+
+	for _, tt := range tests {
+		if got := ErrorClass(tt.res, tt.err); got != tt.want {
+			t.Errorf("ErrorClass(%v): got: %q, want: %q", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,451 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// OIDCService handles communication with the OpenID Connect endpoints of a realm.
+//
+// In contrast to the admin API these endpoints are used on behalf of
+// clients and end users. The http client passed to NewKeycloak does not need
+// to carry an admin token for them.
+type OIDCService service
+
+// Grant types accepted by the token endpoint.
+const (
+	GrantTypePassword          = "password"
+	GrantTypeClientCredentials = "client_credentials"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeTokenExchange     = "urn:ietf:params:oauth:grant-type:token-exchange"
+	GrantTypeDeviceCode        = "urn:ietf:params:oauth:grant-type:device_code"
+)
+
+// Token types used by the token exchange grant.
+const (
+	TokenTypeAccessToken  = "urn:ietf:params:oauth:token-type:access_token"
+	TokenTypeRefreshToken = "urn:ietf:params:oauth:token-type:refresh_token"
+	TokenTypeIDToken      = "urn:ietf:params:oauth:token-type:id_token"
+)
+
+// TokenOptions specifies the parameters of a token request. Which
+// parameters are required depends on GrantType.
+type TokenOptions struct {
+	GrantType    string `url:"grant_type"`
+	ClientID     string `url:"client_id,omitempty"`
+	ClientSecret string `url:"client_secret,omitempty"`
+	Scope        string `url:"scope,omitempty"`
+
+	// password grant
+	Username string `url:"username,omitempty"`
+	Password string `url:"password,omitempty"`
+
+	// refresh_token grant
+	RefreshToken string `url:"refresh_token,omitempty"`
+
+	// authorization_code grant
+	Code         string `url:"code,omitempty"`
+	RedirectURI  string `url:"redirect_uri,omitempty"`
+	CodeVerifier string `url:"code_verifier,omitempty"`
+
+	// device code grant
+	DeviceCode string `url:"device_code,omitempty"`
+
+	// token exchange grant
+	SubjectToken       string `url:"subject_token,omitempty"`
+	SubjectTokenType   string `url:"subject_token_type,omitempty"`
+	SubjectIssuer      string `url:"subject_issuer,omitempty"`
+	RequestedTokenType string `url:"requested_token_type,omitempty"`
+	RequestedSubject   string `url:"requested_subject,omitempty"`
+	RequestedIssuer    string `url:"requested_issuer,omitempty"`
+	Audience           string `url:"audience,omitempty"`
+}
+
+// TokenResponse is the response of the token endpoint.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/AccessTokenResponse.java
+type TokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int    `json:"expires_in"`
+	RefreshExpiresIn int    `json:"refresh_expires_in"`
+	RefreshToken     string `json:"refresh_token,omitempty"`
+	TokenType        string `json:"token_type"`
+	IDToken          string `json:"id_token,omitempty"`
+	NotBeforePolicy  int    `json:"not-before-policy"`
+	SessionState     string `json:"session_state,omitempty"`
+	Scope            string `json:"scope,omitempty"`
+	IssuedTokenType  string `json:"issued_token_type,omitempty"`
+}
+
+// Audience is the aud claim of a token. Keycloak encodes a single audience
+// as string and multiple audiences as array.
+type Audience []string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *Audience) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*a = Audience{s}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(b, &list); err != nil {
+		return err
+	}
+	*a = list
+	return nil
+}
+
+// Contains reports whether aud is one of the audiences.
+func (a Audience) Contains(aud string) bool {
+	for _, v := range a {
+		if v == aud {
+			return true
+		}
+	}
+	return false
+}
+
+// Access lists the roles granted by a token.
+type Access struct {
+	Roles []string `json:"roles,omitempty"`
+}
+
+// IntrospectionResult is the response of the token introspection endpoint.
+// All fields but Active are empty for inactive tokens.
+//
+// https://datatracker.ietf.org/doc/html/rfc7662#section-2.2
+type IntrospectionResult struct {
+	Active            bool               `json:"active"`
+	Scope             string             `json:"scope,omitempty"`
+	ClientID          string             `json:"client_id,omitempty"`
+	Username          string             `json:"username,omitempty"`
+	TokenType         string             `json:"token_type,omitempty"`
+	Exp               int64              `json:"exp,omitempty"`
+	Iat               int64              `json:"iat,omitempty"`
+	Nbf               int64              `json:"nbf,omitempty"`
+	Sub               string             `json:"sub,omitempty"`
+	Aud               Audience           `json:"aud,omitempty"`
+	Iss               string             `json:"iss,omitempty"`
+	Jti               string             `json:"jti,omitempty"`
+	Typ               string             `json:"typ,omitempty"`
+	Azp               string             `json:"azp,omitempty"`
+	SessionState      string             `json:"session_state,omitempty"`
+	Email             string             `json:"email,omitempty"`
+	PreferredUsername string             `json:"preferred_username,omitempty"`
+	RealmAccess       *Access            `json:"realm_access,omitempty"`
+	ResourceAccess    map[string]*Access `json:"resource_access,omitempty"`
+}
+
+// UserInfo holds the standard claims returned by the userinfo endpoint.
+//
+// https://openid.net/specs/openid-connect-core-1_0.html#StandardClaims
+type UserInfo struct {
+	Sub               string `json:"sub"`
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	GivenName         string `json:"given_name,omitempty"`
+	FamilyName        string `json:"family_name,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     bool   `json:"email_verified,omitempty"`
+
+	// Claims holds all claims including custom ones added by protocol mappers.
+	Claims map[string]interface{} `json:"-"`
+}
+
+// OpenIDConfiguration is the discovery document of a realm.
+//
+// https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type OpenIDConfiguration struct {
+	Issuer                                string   `json:"issuer"`
+	AuthorizationEndpoint                 string   `json:"authorization_endpoint"`
+	TokenEndpoint                         string   `json:"token_endpoint"`
+	IntrospectionEndpoint                 string   `json:"introspection_endpoint"`
+	UserinfoEndpoint                      string   `json:"userinfo_endpoint"`
+	EndSessionEndpoint                    string   `json:"end_session_endpoint"`
+	RevocationEndpoint                    string   `json:"revocation_endpoint"`
+	DeviceAuthorizationEndpoint           string   `json:"device_authorization_endpoint,omitempty"`
+	JWKSURI                               string   `json:"jwks_uri"`
+	GrantTypesSupported                   []string `json:"grant_types_supported,omitempty"`
+	ResponseTypesSupported                []string `json:"response_types_supported,omitempty"`
+	SubjectTypesSupported                 []string `json:"subject_types_supported,omitempty"`
+	IDTokenSigningAlgValuesSupported      []string `json:"id_token_signing_alg_values_supported,omitempty"`
+	TokenEndpointAuthMethodsSupported     []string `json:"token_endpoint_auth_methods_supported,omitempty"`
+	ScopesSupported                       []string `json:"scopes_supported,omitempty"`
+	ClaimsSupported                       []string `json:"claims_supported,omitempty"`
+	CodeChallengeMethodsSupported         []string `json:"code_challenge_methods_supported,omitempty"`
+	IntrospectionEndpointAuthMethods      []string `json:"introspection_endpoint_auth_methods_supported,omitempty"`
+	RevocationEndpointAuthMethods         []string `json:"revocation_endpoint_auth_methods_supported,omitempty"`
+	BackchannelLogoutSupported            *bool    `json:"backchannel_logout_supported,omitempty"`
+	FrontchannelLogoutSupported           *bool    `json:"frontchannel_logout_supported,omitempty"`
+	RequestParameterSupported             *bool    `json:"request_parameter_supported,omitempty"`
+	TLSClientCertificateBoundAccessTokens *bool    `json:"tls_client_certificate_bound_access_tokens,omitempty"`
+}
+
+// discover fetches the discovery document of the issuer, e.g.
+// "http://localhost:8080/realms/myrealm", with a plain http client.
+func discover(ctx context.Context, client *http.Client, issuer string) (*OpenIDConfiguration, error) {
+	u := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
+
+	var conf OpenIDConfiguration
+	if err := json.NewDecoder(res.Body).Decode(&conf); err != nil {
+		return nil, err
+	}
+
+	return conf.resolve(issuer), nil
+}
+
+// resolve returns a copy of the configuration with the endpoints called by
+// this package moved from the issuer in the document to issuer. Keycloak
+// builds the endpoints from its frontend URL, which often differs from the
+// internal URL the client is configured with. The authorization endpoint is
+// left as it is since it is opened by browsers, as are endpoints outside the
+// issuer.
+func (c *OpenIDConfiguration) resolve(issuer string) *OpenIDConfiguration {
+	resolved := *c
+	from := strings.TrimSuffix(c.Issuer, "/") + "/"
+	to := strings.TrimSuffix(issuer, "/") + "/"
+	if from == "/" || from == to {
+		return &resolved
+	}
+
+	for _, endpoint := range []*string{
+		&resolved.TokenEndpoint,
+		&resolved.IntrospectionEndpoint,
+		&resolved.UserinfoEndpoint,
+		&resolved.EndSessionEndpoint,
+		&resolved.RevocationEndpoint,
+		&resolved.DeviceAuthorizationEndpoint,
+		&resolved.JWKSURI,
+	} {
+		if strings.HasPrefix(*endpoint, from) {
+			*endpoint = to + strings.TrimPrefix(*endpoint, from)
+		}
+	}
+	return &resolved
+}
+
+// WellKnown returns the discovery document of the realm.
+func (s *OIDCService) WellKnown(ctx context.Context, realm string) (*OpenIDConfiguration, *Response, error) {
+	u := pathf("realms/%s/.well-known/openid-configuration", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var conf OpenIDConfiguration
+	res, err := s.keycloak.Do(ctx, req, &conf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &conf, res, nil
+}
+
+// DefaultDiscoveryTTL is how long the discovery document of a realm is
+// cached when Keycloak.DiscoveryTTL is not set.
+const DefaultDiscoveryTTL = time.Hour
+
+// discovered is a cached discovery document.
+type discovered struct {
+	conf      *OpenIDConfiguration
+	fetchedAt time.Time
+}
+
+// configuration returns the discovery document of the realm with its
+// endpoints resolved against the base URL. It is fetched on first use and
+// again after Keycloak.DiscoveryTTL.
+func (s *OIDCService) configuration(ctx context.Context, realm string) (*OpenIDConfiguration, error) {
+	ttl := s.keycloak.DiscoveryTTL
+	if ttl == 0 {
+		ttl = DefaultDiscoveryTTL
+	}
+	if cached, ok := s.keycloak.discovery.Load(realm); ok && time.Since(cached.(*discovered).fetchedAt) < ttl {
+		return cached.(*discovered).conf, nil
+	}
+
+	conf, _, err := s.WellKnown(ctx, realm)
+	if err != nil {
+		return nil, err
+	}
+
+	issuer := s.keycloak.BaseURL.String() + pathf("realms/%s", realm)
+	conf = conf.resolve(issuer)
+
+	s.keycloak.discovery.Store(realm, &discovered{conf: conf, fetchedAt: time.Now()})
+	return conf, nil
+}
+
+// InvalidateConfiguration drops the cached discovery document of the realm,
+// e.g. after its frontend URL changed. It is fetched again on next use.
+func (s *OIDCService) InvalidateConfiguration(realm string) {
+	s.keycloak.discovery.Delete(realm)
+}
+
+// Token requests a token from the token endpoint of the realm.
+func (s *OIDCService) Token(ctx context.Context, realm string, opts *TokenOptions) (*TokenResponse, *Response, error) {
+	form, err := query.Values(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conf, err := s.configuration(ctx, realm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewFormRequest(http.MethodPost, conf.TokenEndpoint, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var token TokenResponse
+	res, err := s.keycloak.Do(ctx, req, &token)
+	if err != nil {
+		// the response is kept for callers like PollDeviceToken, which
+		// inspect the OAuth 2.0 error
+		return nil, res, err
+	}
+
+	return &token, res, nil
+}
+
+// Introspect asks the realm whether the token is active. The client must be
+// confidential, i.e. authenticate with clientID and secret.
+func (s *OIDCService) Introspect(ctx context.Context, realm, token, clientID, secret string) (*IntrospectionResult, *Response, error) {
+	form := url.Values{
+		"token":         {token},
+		"client_id":     {clientID},
+		"client_secret": {secret},
+	}
+
+	conf, err := s.configuration(ctx, realm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewFormRequest(http.MethodPost, conf.IntrospectionEndpoint, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result IntrospectionResult
+	res, err := s.keycloak.Do(ctx, req, &result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &result, res, nil
+}
+
+// Revoke revokes the token. Hint is either "access_token" or "refresh_token"
+// and may be left empty.
+func (s *OIDCService) Revoke(ctx context.Context, realm, token, hint, clientID, secret string) (*Response, error) {
+	form := url.Values{
+		"token":     {token},
+		"client_id": {clientID},
+	}
+	if hint != "" {
+		form.Set("token_type_hint", hint)
+	}
+	if secret != "" {
+		form.Set("client_secret", secret)
+	}
+
+	conf, err := s.configuration(ctx, realm)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.keycloak.NewFormRequest(http.MethodPost, conf.RevocationEndpoint, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// GetUserInfo returns the claims about the end user the access token was issued for.
+//
+// The access token is sent as bearer token. It is overwritten if the http
+// client passed to NewKeycloak sets the Authorization header itself, e.g.
+// an oauth2 client holding an admin token.
+func (s *OIDCService) GetUserInfo(ctx context.Context, realm, accessToken string) (*UserInfo, *Response, error) {
+	conf, err := s.configuration(ctx, realm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, conf.UserinfoEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	var raw json.RawMessage
+	res, err := s.keycloak.Do(ctx, req, &raw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var info UserInfo
+	if err := json.Unmarshal(raw, &info); err != nil {
+		return nil, res, err
+	}
+	if err := json.Unmarshal(raw, &info.Claims); err != nil {
+		return nil, res, err
+	}
+
+	return &info, res, nil
+}
+
+// Logout ends the session the refresh token belongs to. Secret may be left
+// empty for public clients.
+func (s *OIDCService) Logout(ctx context.Context, realm, refreshToken, clientID, secret string) (*Response, error) {
+	form := url.Values{
+		"refresh_token": {refreshToken},
+		"client_id":     {clientID},
+	}
+	if secret != "" {
+		form.Set("client_secret", secret)
+	}
+
+	conf, err := s.configuration(ctx, realm)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.keycloak.NewFormRequest(http.MethodPost, conf.EndSessionEndpoint, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
@@ -0,0 +1,161 @@
+package keycloak
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// Errors returned when checking the callback of an authorization request.
+var (
+	ErrInvalidState = errors.New("keycloak: invalid authorization state")
+	ErrMissingCode  = errors.New("keycloak: missing authorization code")
+	ErrInvalidNonce = errors.New("keycloak: invalid token nonce")
+)
+
+// AuthorizationURLOptions specifies the parameters of an authorization
+// request.
+type AuthorizationURLOptions struct {
+	ClientID    string
+	RedirectURI string
+
+	// Scope defaults to "openid".
+	Scope string
+
+	// Prompt is e.g. "login" to force the user to log in again.
+	Prompt string
+
+	// LoginHint prefills the username on the login page.
+	LoginHint string
+
+	// IDPHint skips the login page and redirects to the identity provider
+	// with this alias.
+	IDPHint string
+}
+
+// AuthorizationRequest is a started authorization code flow. It must be kept,
+// e.g. in the session of the user, until the callback to the redirect URI
+// is handled.
+type AuthorizationRequest struct {
+	// URL is the authorization URL the user is redirected to.
+	URL string
+
+	ClientID    string
+	RedirectURI string
+
+	// State, Nonce and CodeVerifier are random values generated for this
+	// request only.
+	State        string
+	Nonce        string
+	CodeVerifier string
+}
+
+// AuthorizationURL starts an authorization code flow with PKCE. The user is
+// redirected to the returned URL and, after logging in, back to the redirect
+// URI with the code to pass to ExchangeAuthorizationCode.
+func (s *OIDCService) AuthorizationURL(ctx context.Context, realm string, opts *AuthorizationURLOptions) (*AuthorizationRequest, error) {
+	conf, err := s.configuration(ctx, realm)
+	if err != nil {
+		return nil, err
+	}
+
+	auth := &AuthorizationRequest{
+		ClientID:    opts.ClientID,
+		RedirectURI: opts.RedirectURI,
+	}
+	for _, v := range []*string{&auth.State, &auth.Nonce, &auth.CodeVerifier} {
+		if *v, err = randomString(); err != nil {
+			return nil, err
+		}
+	}
+
+	scope := opts.Scope
+	if scope == "" {
+		scope = "openid"
+	}
+
+	challenge := sha256.Sum256([]byte(auth.CodeVerifier))
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {opts.ClientID},
+		"redirect_uri":          {opts.RedirectURI},
+		"scope":                 {scope},
+		"state":                 {auth.State},
+		"nonce":                 {auth.Nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+	for key, value := range map[string]string{
+		"prompt":      opts.Prompt,
+		"login_hint":  opts.LoginHint,
+		"kc_idp_hint": opts.IDPHint,
+	} {
+		if value != "" {
+			params.Set(key, value)
+		}
+	}
+
+	sep := "?"
+	if strings.Contains(conf.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	auth.URL = conf.AuthorizationEndpoint + sep + params.Encode()
+
+	return auth, nil
+}
+
+// Code returns the authorization code from the query of the callback to the
+// redirect URI. It returns ErrInvalidState if the state does not match the
+// request and the OAuth 2.0 error as *ErrorResponse if the user did not log
+// in, e.g. with Err "access_denied".
+func (a *AuthorizationRequest) Code(query url.Values) (string, error) {
+	if query.Get("state") != a.State {
+		return "", ErrInvalidState
+	}
+	if query.Get("error") != "" {
+		return "", &ErrorResponse{
+			Err:              query.Get("error"),
+			ErrorDescription: query.Get("error_description"),
+		}
+	}
+	if query.Get("code") == "" {
+		return "", ErrMissingCode
+	}
+	return query.Get("code"), nil
+}
+
+// VerifyNonce checks that the claims of the verified ID token contain the
+// nonce of the request.
+func (a *AuthorizationRequest) VerifyNonce(claims *Claims) error {
+	if nonce, _ := claims.Raw["nonce"].(string); nonce == "" || nonce != a.Nonce {
+		return ErrInvalidNonce
+	}
+	return nil
+}
+
+// ExchangeAuthorizationCode redeems the code returned to the redirect URI
+// of the request for tokens. Secret may be left empty for public clients.
+func (s *OIDCService) ExchangeAuthorizationCode(ctx context.Context, realm string, auth *AuthorizationRequest, code, secret string) (*TokenResponse, *Response, error) {
+	return s.Token(ctx, realm, &TokenOptions{
+		GrantType:    GrantTypeAuthorizationCode,
+		ClientID:     auth.ClientID,
+		ClientSecret: secret,
+		Code:         code,
+		RedirectURI:  auth.RedirectURI,
+		CodeVerifier: auth.CodeVerifier,
+	})
+}
+
+// randomString returns 32 random bytes encoded as base64url, which is also
+// a valid PKCE code verifier.
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
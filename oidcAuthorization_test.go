@@ -0,0 +1,122 @@
+package keycloak
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestOIDCService_AuthorizationCodeFlow(t *testing.T) {
+	var challenge string
+
+	server := httptest.NewServer(withDiscovery(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/realms/first/protocol/openid-connect/token" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			return
+		}
+		r.ParseForm()
+		for key, want := range map[string]string{
+			"grant_type":   GrantTypeAuthorizationCode,
+			"client_id":    "app",
+			"code":         "code",
+			"redirect_uri": "http://localhost/callback",
+		} {
+			if got := r.PostForm.Get(key); got != want {
+				t.Errorf("%s: got: %s, want: %s", key, got, want)
+			}
+		}
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if got := base64.RawURLEncoding.EncodeToString(sum[:]); got != challenge {
+			t.Errorf("got: %s, want: %s", got, challenge)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token":"token","expires_in":300,"token_type":"Bearer"}`)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	auth, err := k.OIDC.AuthorizationURL(ctx, "first", &AuthorizationURLOptions{
+		ClientID:    "app",
+		RedirectURI: "http://localhost/callback",
+		LoginHint:   "john",
+	})
+	if err != nil {
+		t.Fatalf("OIDC.AuthorizationURL returned error: %v", err)
+	}
+
+	u, err := url.Parse(auth.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Path != "/realms/first/protocol/openid-connect/auth" {
+		t.Errorf("got: %s, want: %s", u.Path, "/realms/first/protocol/openid-connect/auth")
+	}
+	params := u.Query()
+	for key, want := range map[string]string{
+		"response_type":         "code",
+		"scope":                 "openid",
+		"state":                 auth.State,
+		"nonce":                 auth.Nonce,
+		"login_hint":            "john",
+		"code_challenge_method": "S256",
+	} {
+		if got := params.Get(key); got != want {
+			t.Errorf("%s: got: %s, want: %s", key, got, want)
+		}
+	}
+	if _, ok := params["prompt"]; ok {
+		t.Errorf("got: prompt, want: empty parameters omitted")
+	}
+	challenge = params.Get("code_challenge")
+
+	if _, err := auth.Code(url.Values{"state": {"other"}, "code": {"code"}}); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("got: %v, want: %v", err, ErrInvalidState)
+	}
+
+	code, err := auth.Code(url.Values{"state": {auth.State}, "code": {"code"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, _, err := k.OIDC.ExchangeAuthorizationCode(ctx, "first", auth, code, "")
+	if err != nil {
+		t.Fatalf("OIDC.ExchangeAuthorizationCode returned error: %v", err)
+	}
+	if token.AccessToken != "token" {
+		t.Errorf("got: %s, want: %s", token.AccessToken, "token")
+	}
+
+	if err := auth.VerifyNonce(&Claims{Raw: map[string]interface{}{"nonce": auth.Nonce}}); err != nil {
+		t.Errorf("got: %v, want: nil", err)
+	}
+	if err := auth.VerifyNonce(&Claims{}); !errors.Is(err, ErrInvalidNonce) {
+		t.Errorf("got: %v, want: %v", err, ErrInvalidNonce)
+	}
+}
+
+func TestAuthorizationRequest_Code_Error(t *testing.T) {
+	auth := &AuthorizationRequest{State: "state"}
+
+	_, err := auth.Code(url.Values{"state": {"state"}, "error": {"access_denied"}})
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("got: %v, want: *ErrorResponse", err)
+	}
+	if errorResponse.Err != "access_denied" {
+		t.Errorf("got: %s, want: %s", errorResponse.Err, "access_denied")
+	}
+}
@@ -0,0 +1,128 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// defaultDeviceInterval is the polling interval used if the device
+// authorization response does not specify one.
+const defaultDeviceInterval = 5 * time.Second
+
+// DeviceAuthorizationOptions specifies the parameters of a device
+// authorization request. ClientSecret may be left empty for public clients.
+type DeviceAuthorizationOptions struct {
+	ClientID     string `url:"client_id"`
+	ClientSecret string `url:"client_secret,omitempty"`
+	Scope        string `url:"scope,omitempty"`
+}
+
+// DeviceAuthorization is the response of the device authorization endpoint.
+// The user logs in by opening VerificationURI and entering UserCode, or by
+// opening VerificationURIComplete, while the device polls for the token.
+//
+// https://www.rfc-editor.org/rfc/rfc8628#section-3.2
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+
+	// ExpiresIn is the lifetime of the codes in seconds.
+	ExpiresIn int `json:"expires_in"`
+
+	// Interval is the number of seconds to wait between polls.
+	Interval int `json:"interval,omitempty"`
+
+	// client the authorization was started for, used by PollDeviceToken
+	// if no options are given.
+	client *DeviceAuthorizationOptions
+}
+
+// StartDeviceAuthorization starts the OAuth 2.0 device authorization grant.
+// The client must have "OAuth 2.0 Device Authorization Grant" enabled.
+func (s *OIDCService) StartDeviceAuthorization(ctx context.Context, realm string, opts *DeviceAuthorizationOptions) (*DeviceAuthorization, *Response, error) {
+	form, err := query.Values(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conf, err := s.configuration(ctx, realm)
+	if err != nil {
+		return nil, nil, err
+	}
+	if conf.DeviceAuthorizationEndpoint == "" {
+		return nil, nil, fmt.Errorf("keycloak: realm %s has no device authorization endpoint", realm)
+	}
+
+	req, err := s.keycloak.NewFormRequest(http.MethodPost, conf.DeviceAuthorizationEndpoint, form)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var auth DeviceAuthorization
+	res, err := s.keycloak.Do(ctx, req, &auth)
+	if err != nil {
+		return nil, nil, err
+	}
+	auth.client = opts
+
+	return &auth, res, nil
+}
+
+// PollDeviceToken polls the token endpoint until the user has logged in and
+// returns the token. The client must be the one the authorization was
+// started for. If opts is nil, the client passed to StartDeviceAuthorization
+// is used. The first poll is sent after the interval of the authorization.
+//
+// Polling stops with an error if the user denies access, the device code
+// expires or ctx is done. The OAuth 2.0 error is returned as *ErrorResponse,
+// e.g. with Err "access_denied" or "expired_token".
+func (s *OIDCService) PollDeviceToken(ctx context.Context, realm string, auth *DeviceAuthorization, opts *DeviceAuthorizationOptions) (*TokenResponse, *Response, error) {
+	if opts == nil {
+		opts = auth.client
+	}
+	if opts == nil {
+		return nil, nil, errors.New("keycloak: missing client of the device authorization")
+	}
+	tokenOpts := &TokenOptions{
+		GrantType:    GrantTypeDeviceCode,
+		ClientID:     opts.ClientID,
+		ClientSecret: opts.ClientSecret,
+		DeviceCode:   auth.DeviceCode,
+	}
+
+	interval := time.Duration(auth.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultDeviceInterval
+	}
+
+	for {
+		// https://www.rfc-editor.org/rfc/rfc8628#section-3.5
+		if err := sleep(ctx, interval); err != nil {
+			return nil, nil, err
+		}
+
+		token, res, err := s.Token(ctx, realm, tokenOpts)
+
+		var errorResponse *ErrorResponse
+		if !errors.As(err, &errorResponse) {
+			return token, res, err
+		}
+
+		switch errorResponse.Err {
+		case "authorization_pending":
+		case "slow_down":
+			// the interval must be increased by 5 seconds for this and all
+			// following polls
+			interval += 5 * time.Second
+		default:
+			return nil, res, err
+		}
+	}
+}
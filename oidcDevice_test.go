@@ -0,0 +1,120 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestOIDCService_DeviceAuthorization(t *testing.T) {
+	var (
+		polls     int
+		firstPoll time.Time
+	)
+
+	server := httptest.NewServer(withDiscovery(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/realms/first/protocol/openid-connect/auth/device":
+			if got := r.PostForm.Get("client_id"); got != "cli" {
+				t.Errorf("got: %s, want: %s", got, "cli")
+			}
+			fmt.Fprint(w, `{"device_code":"device","user_code":"ABCD-EFGH","verification_uri":"http://localhost/device","expires_in":600,"interval":1}`)
+		case "/realms/first/protocol/openid-connect/token":
+			if got := r.PostForm.Get("grant_type"); got != GrantTypeDeviceCode {
+				t.Errorf("got: %s, want: %s", got, GrantTypeDeviceCode)
+			}
+			if got := r.PostForm.Get("device_code"); got != "device" {
+				t.Errorf("got: %s, want: %s", got, "device")
+			}
+			polls++
+			if polls == 1 {
+				firstPoll = time.Now()
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error":"authorization_pending"}`)
+				return
+			}
+			fmt.Fprint(w, `{"access_token":"token","expires_in":300,"token_type":"Bearer"}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	opts := &DeviceAuthorizationOptions{ClientID: "cli"}
+
+	auth, _, err := k.OIDC.StartDeviceAuthorization(ctx, "first", opts)
+	if err != nil {
+		t.Fatalf("OIDC.StartDeviceAuthorization returned error: %v", err)
+	}
+	if auth.UserCode != "ABCD-EFGH" {
+		t.Errorf("got: %s, want: %s", auth.UserCode, "ABCD-EFGH")
+	}
+
+	// the client of the authorization is used without options
+	start := time.Now()
+	token, _, err := k.OIDC.PollDeviceToken(ctx, "first", auth, nil)
+	if err != nil {
+		t.Fatalf("OIDC.PollDeviceToken returned error: %v", err)
+	}
+	if token.AccessToken != "token" {
+		t.Errorf("got: %s, want: %s", token.AccessToken, "token")
+	}
+	if polls != 2 {
+		t.Errorf("got: %d, want: %d", polls, 2)
+	}
+	if d := firstPoll.Sub(start); d < time.Second {
+		t.Errorf("got first poll after %s, want: %s", d, time.Second)
+	}
+}
+
+func TestOIDCService_PollDeviceToken_Denied(t *testing.T) {
+	server := httptest.NewServer(withDiscovery(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":"access_denied"}`)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auth := &DeviceAuthorization{DeviceCode: "device", Interval: 1}
+	_, res, err := k.OIDC.PollDeviceToken(context.Background(), "first", auth, &DeviceAuthorizationOptions{ClientID: "cli"})
+	if res == nil || res.StatusCode != http.StatusBadRequest {
+		t.Errorf("got: %v, want response with status %d", res, http.StatusBadRequest)
+	}
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("got: %v, want: *ErrorResponse", err)
+	}
+	if errorResponse.Err != "access_denied" {
+		t.Errorf("got: %s, want: %s", errorResponse.Err, "access_denied")
+	}
+}
+
+func TestOIDCService_PollDeviceToken_MissingClient(t *testing.T) {
+	k, err := NewKeycloak(nil, "http://localhost/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := k.OIDC.PollDeviceToken(context.Background(), "first", &DeviceAuthorization{DeviceCode: "device"}, nil); err == nil {
+		t.Error("expected error for missing client")
+	}
+}
@@ -0,0 +1,353 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// withDiscovery serves the discovery document of any realm and passes all
+// other requests to next. The endpoints follow the Keycloak conventions.
+func withDiscovery(next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		const path = "/.well-known/openid-configuration"
+		if !strings.HasSuffix(r.URL.Path, path) {
+			next(w, r)
+			return
+		}
+
+		issuer := "http://" + r.Host + strings.TrimSuffix(r.URL.Path, path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&OpenIDConfiguration{
+			Issuer:                issuer,
+			AuthorizationEndpoint: issuer + "/protocol/openid-connect/auth",
+			TokenEndpoint:         issuer + "/protocol/openid-connect/token",
+			IntrospectionEndpoint: issuer + "/protocol/openid-connect/token/introspect",
+			UserinfoEndpoint:      issuer + "/protocol/openid-connect/userinfo",
+			EndSessionEndpoint:    issuer + "/protocol/openid-connect/logout",
+			RevocationEndpoint:    issuer + "/protocol/openid-connect/revoke",
+			JWKSURI:               issuer + "/protocol/openid-connect/certs",
+
+			DeviceAuthorizationEndpoint: issuer + "/protocol/openid-connect/auth/device",
+		})
+	})
+}
+
+func TestOIDCService_Token(t *testing.T) {
+	server := httptest.NewServer(withDiscovery(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/realms/first/protocol/openid-connect/token" {
+			t.Errorf("got: %s, want: %s", r.URL.Path, "/realms/first/protocol/openid-connect/token")
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/x-www-form-urlencoded" {
+			t.Errorf("got: %s, want: %s", got, "application/x-www-form-urlencoded")
+		}
+		r.ParseForm()
+		if got := r.PostForm.Get("grant_type"); got != GrantTypePassword {
+			t.Errorf("got: %s, want: %s", got, GrantTypePassword)
+		}
+		if got := r.PostForm.Get("username"); got != "john" {
+			t.Errorf("got: %s, want: %s", got, "john")
+		}
+		if _, ok := r.PostForm["refresh_token"]; ok {
+			t.Errorf("got unexpected refresh_token parameter")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token":"token","expires_in":300,"refresh_token":"refresh","token_type":"Bearer"}`)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := &TokenOptions{
+		GrantType: GrantTypePassword,
+		ClientID:  "admin-cli",
+		Username:  "john",
+		Password:  "mypassword",
+	}
+
+	token, _, err := k.OIDC.Token(context.Background(), "first", opts)
+	if err != nil {
+		t.Fatalf("OIDC.Token returned error: %v", err)
+	}
+
+	if token.AccessToken != "token" {
+		t.Errorf("got: %s, want: %s", token.AccessToken, "token")
+	}
+
+	if token.ExpiresIn != 300 {
+		t.Errorf("got: %d, want: %d", token.ExpiresIn, 300)
+	}
+}
+
+func TestOIDCService_Token_Error(t *testing.T) {
+	server := httptest.NewServer(withDiscovery(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error":"invalid_grant","error_description":"Invalid user credentials"}`)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = k.OIDC.Token(context.Background(), "first", &TokenOptions{GrantType: GrantTypePassword})
+	if !IsUnauthorized(err) {
+		t.Errorf("got: %v, want an unauthorized error", err)
+	}
+}
+
+func TestOIDCService_Token_Password(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	createSession(t, k, realm, "john")
+
+	opts := &TokenOptions{
+		GrantType: GrantTypePassword,
+		ClientID:  "admin-cli",
+		Username:  "john",
+		Password:  "mypassword",
+	}
+
+	token, res, err := k.OIDC.Token(context.Background(), realm, opts)
+	if err != nil {
+		t.Errorf("OIDC.Token returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if token.RefreshToken == "" {
+		t.Errorf("got no refresh token, want one")
+	}
+}
+
+func TestAudience_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{`{"aud":"account"}`, []string{"account"}},
+		{`{"aud":["account","api"]}`, []string{"account", "api"}},
+	}
+
+	for _, tt := range tests {
+		var result IntrospectionResult
+		if err := json.Unmarshal([]byte(tt.in), &result); err != nil {
+			t.Fatalf("json.Unmarshal returned error: %v", err)
+		}
+		if !reflect.DeepEqual([]string(result.Aud), tt.want) {
+			t.Errorf("got: %v, want: %v", result.Aud, tt.want)
+		}
+		if !result.Aud.Contains("account") {
+			t.Errorf("got: %v, want it to contain %s", result.Aud, "account")
+		}
+	}
+}
+
+func TestOIDCService_Introspect(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	id := createClient(t, k, realm, "client")
+
+	ctx := context.Background()
+
+	secret, _, err := k.Clients.GetSecret(ctx, realm, id)
+	if err != nil {
+		t.Errorf("Clients.GetSecret returned error: %v", err)
+	}
+
+	opts := &TokenOptions{
+		GrantType:    GrantTypeClientCredentials,
+		ClientID:     "client",
+		ClientSecret: *secret.Value,
+	}
+
+	token, _, err := k.OIDC.Token(ctx, realm, opts)
+	if err != nil {
+		t.Errorf("OIDC.Token returned error: %v", err)
+	}
+
+	result, res, err := k.OIDC.Introspect(ctx, realm, token.AccessToken, "client", *secret.Value)
+	if err != nil {
+		t.Errorf("OIDC.Introspect returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if !result.Active {
+		t.Errorf("got: %t, want: %t", result.Active, true)
+	}
+
+	if _, err := k.OIDC.Revoke(ctx, realm, token.AccessToken, "access_token", "client", *secret.Value); err != nil {
+		t.Errorf("OIDC.Revoke returned error: %v", err)
+	}
+
+	result, _, err = k.OIDC.Introspect(ctx, realm, token.AccessToken, "client", *secret.Value)
+	if err != nil {
+		t.Errorf("OIDC.Introspect returned error: %v", err)
+	}
+
+	if result.Active {
+		t.Errorf("got: %t, want: %t", result.Active, false)
+	}
+}
+
+func TestOIDCService_GetUserInfo(t *testing.T) {
+	server := httptest.NewServer(withDiscovery(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("got: %s, want: %s", got, "Bearer token")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"sub":"123","preferred_username":"john","department":"sales"}`)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, _, err := k.OIDC.GetUserInfo(context.Background(), "first", "token")
+	if err != nil {
+		t.Fatalf("OIDC.GetUserInfo returned error: %v", err)
+	}
+
+	if info.PreferredUsername != "john" {
+		t.Errorf("got: %s, want: %s", info.PreferredUsername, "john")
+	}
+
+	if info.Claims["department"] != "sales" {
+		t.Errorf("got: %v, want: %s", info.Claims["department"], "sales")
+	}
+}
+
+func TestOIDCService_Logout(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	userID := createSession(t, k, realm, "john")
+
+	ctx := context.Background()
+
+	opts := &TokenOptions{
+		GrantType: GrantTypePassword,
+		ClientID:  "admin-cli",
+		Username:  "john",
+		Password:  "mypassword",
+	}
+
+	token, _, err := k.OIDC.Token(ctx, realm, opts)
+	if err != nil {
+		t.Errorf("OIDC.Token returned error: %v", err)
+	}
+
+	res, err := k.OIDC.Logout(ctx, realm, token.RefreshToken, "admin-cli", "")
+	if err != nil {
+		t.Errorf("OIDC.Logout returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	sessions, _, err := k.Users.ListSessions(ctx, realm, userID)
+	if err != nil {
+		t.Errorf("Users.ListSessions returned error: %v", err)
+	}
+
+	// createSession leaves one session behind
+	if len(sessions) != 1 {
+		t.Errorf("got: %d, want: %d", len(sessions), 1)
+	}
+}
+
+func TestOIDCService_WellKnown(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	conf, res, err := k.OIDC.WellKnown(context.Background(), realm)
+	if err != nil {
+		t.Errorf("OIDC.WellKnown returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if conf.Issuer != "http://localhost:8080/realms/first" {
+		t.Errorf("got: %s, want: %s", conf.Issuer, "http://localhost:8080/realms/first")
+	}
+}
+
+func TestOIDCService_FrontendURL(t *testing.T) {
+	discoveries := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/realms/first/.well-known/openid-configuration":
+			discoveries++
+			// the frontend hostname differs from the URL the client uses
+			issuer := "https://sso.example.com/realms/first"
+			json.NewEncoder(w).Encode(&OpenIDConfiguration{
+				Issuer:                issuer,
+				AuthorizationEndpoint: issuer + "/protocol/openid-connect/auth",
+				TokenEndpoint:         issuer + "/protocol/openid-connect/token",
+			})
+		case "/realms/first/protocol/openid-connect/token":
+			fmt.Fprint(w, `{"access_token":"token","expires_in":300,"token_type":"Bearer"}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	if _, _, err := k.OIDC.Token(ctx, "first", &TokenOptions{GrantType: GrantTypeClientCredentials}); err != nil {
+		t.Fatalf("OIDC.Token returned error: %v", err)
+	}
+
+	// browsers are sent to the frontend hostname
+	auth, err := k.OIDC.AuthorizationURL(ctx, "first", &AuthorizationURLOptions{ClientID: "app"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(auth.URL, "https://sso.example.com/realms/first/protocol/openid-connect/auth?") {
+		t.Errorf("got: %s, want: the frontend authorization endpoint", auth.URL)
+	}
+
+	k.OIDC.InvalidateConfiguration("first")
+	if _, _, err := k.OIDC.Token(ctx, "first", &TokenOptions{GrantType: GrantTypeClientCredentials}); err != nil {
+		t.Fatalf("OIDC.Token returned error: %v", err)
+	}
+
+	if discoveries != 2 {
+		t.Errorf("got: %d, want: %d", discoveries, 2)
+	}
+}
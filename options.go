@@ -0,0 +1,98 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+)
+
+// ClientOption configures a Keycloak instance created by NewKeycloak.
+type ClientOption func(*Keycloak)
+
+// WithHeader sets a header on every request, e.g. an API key required by a
+// gateway in front of Keycloak. Headers set by a method itself, like the
+// Authorization header of OIDCService.GetUserInfo, take precedence.
+func WithHeader(key, value string) ClientOption {
+	return func(k *Keycloak) {
+		if k.header == nil {
+			k.header = http.Header{}
+		}
+		k.header.Add(key, value)
+	}
+}
+
+// Middleware wraps the transport of the http client, e.g. to log, sign or
+// cache requests.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc is an adapter to use ordinary functions as
+// http.RoundTripper, which is handy when writing middleware.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// WithMiddleware adds middleware to the transport of the http client passed
+// to NewKeycloak. The client itself is not modified. The first middleware
+// sees a request first, e.g.
+//
+//	logging := func(next http.RoundTripper) http.RoundTripper {
+//		return keycloak.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+//			log.Println(req.Method, req.URL)
+//			return next.RoundTrip(req)
+//		})
+//	}
+//	k, err := keycloak.NewKeycloak(client, "http://localhost:8080/", keycloak.WithMiddleware(logging))
+//
+// Middleware sees every attempt of a retried request.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(k *Keycloak) {
+		k.middleware = append(k.middleware, middleware...)
+	}
+}
+
+// RequestOption modifies a single request before it is sent.
+type RequestOption func(*http.Request)
+
+// RequestHeader sets a header on the request, e.g. X-Request-Id or traceparent.
+func RequestHeader(key, value string) RequestOption {
+	return func(req *http.Request) {
+		req.Header.Set(key, value)
+	}
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a context that applies opts to every request
+// sent with it, e.g.
+//
+//	ctx = keycloak.WithRequestOptions(ctx, keycloak.RequestHeader("X-Request-Id", id))
+//	user, _, err := k.Users.Get(ctx, "myrealm", userID)
+//
+// Options already present in ctx are kept and applied first.
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	existing, _ := ctx.Value(requestOptionsKey{}).([]RequestOption)
+	combined := make([]RequestOption, 0, len(existing)+len(opts))
+	combined = append(combined, existing...)
+	combined = append(combined, opts...)
+	return context.WithValue(ctx, requestOptionsKey{}, combined)
+}
+
+// applyOptions sets the client headers and applies the request options found
+// in the context of req.
+func (k *Keycloak) applyOptions(req *http.Request) {
+	for key, values := range k.header {
+		if req.Header.Get(key) != "" {
+			continue
+		}
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	opts, _ := req.Context().Value(requestOptionsKey{}).([]RequestOption)
+	for _, opt := range opts {
+		opt(req)
+	}
+}
@@ -0,0 +1,113 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Api-Key"); got != "secret" {
+			t.Errorf("got: %s, want: %s", got, "secret")
+		}
+		if got := r.Header.Get("X-Request-Id"); got != "123" {
+			t.Errorf("got: %s, want: %s", got, "123")
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/", WithHeader("X-Api-Key", "secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := WithRequestOptions(context.Background(), RequestHeader("X-Request-Id", "123"))
+	if _, _, err := k.Realms.Get(ctx, "first"); err != nil {
+		t.Errorf("Realms.Get returned error: %v", err)
+	}
+}
+
+func TestWithHeader_Precedence(t *testing.T) {
+	server := httptest.NewServer(withDiscovery(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("got: %s, want: %s", got, "Bearer token")
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/", WithHeader("Authorization", "Basic Zm9vOmJhcg=="))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := k.OIDC.GetUserInfo(context.Background(), "first", "token"); err != nil {
+		t.Errorf("OIDC.GetUserInfo returned error: %v", err)
+	}
+}
+
+func TestWithRequestOptions(t *testing.T) {
+	ctx := WithRequestOptions(context.Background(), RequestHeader("X-Request-Id", "123"))
+	ctx = WithRequestOptions(ctx, RequestHeader("Traceparent", "00-abc-def-01"))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	k := &Keycloak{}
+	k.applyOptions(req)
+
+	if got := req.Header.Get("X-Request-Id"); got != "123" {
+		t.Errorf("got: %s, want: %s", got, "123")
+	}
+	if got := req.Header.Get("Traceparent"); got != "00-abc-def-01" {
+		t.Errorf("got: %s, want: %s", got, "00-abc-def-01")
+	}
+}
+
+func TestWithMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Order"); got != "first,second" {
+			t.Errorf("got: %s, want: %s", got, "first,second")
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	var calls []string
+	mark := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name)
+				order := req.Header.Get("X-Order")
+				if order != "" {
+					order += ","
+				}
+				req.Header.Set("X-Order", order+name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+
+	httpClient := &http.Client{}
+	k, err := NewKeycloak(httpClient, server.URL+"/", WithMiddleware(mark("first")), WithMiddleware(mark("second")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := k.Realms.Get(context.Background(), "first"); err != nil {
+		t.Errorf("Realms.Get returned error: %v", err)
+	}
+
+	if len(calls) != 2 {
+		t.Errorf("got: %d, want: %d", len(calls), 2)
+	}
+
+	if httpClient.Transport != nil {
+		t.Error("http client passed to NewKeycloak was modified")
+	}
+}
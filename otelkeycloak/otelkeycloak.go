@@ -0,0 +1,83 @@
+// Package otelkeycloak traces the API calls of a keycloak client with
+// OpenTelemetry.
+//
+//	k, err := keycloak.NewKeycloak(httpClient, baseURL,
+//		keycloak.WithTracer(otelkeycloak.NewTracer(otel.GetTracerProvider())))
+//
+// The package is a module of its own so that only its users depend on
+// OpenTelemetry.
+//
+// Every call becomes a client span named after the service method, e.g.
+// "Users.Get", with the http method, status code, realm and resource as
+// attributes.
+package otelkeycloak
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+// ScopeName is the instrumentation scope of the tracer.
+const ScopeName = "github.com/zemirco/keycloak/v2/otelkeycloak"
+
+// Attribute keys set in addition to the http semantic conventions.
+const (
+	AttributeRealm      = attribute.Key("keycloak.realm")
+	AttributeResource   = attribute.Key("keycloak.resource")
+	AttributeResourceID = attribute.Key("keycloak.resource_id")
+)
+
+// Tracer implements keycloak.Tracer.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer returns a tracer starting spans with a tracer of provider.
+func NewTracer(provider trace.TracerProvider) *Tracer {
+	return &Tracer{tracer: provider.Tracer(ScopeName)}
+}
+
+// Start starts a client span for the API call.
+func (t *Tracer) Start(ctx context.Context, op *keycloak.Operation) (context.Context, keycloak.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", op.Method),
+		attribute.String("url.path", op.Path),
+	}
+	for key, value := range map[attribute.Key]string{
+		AttributeRealm:      op.Realm,
+		AttributeResource:   op.Resource,
+		AttributeResourceID: op.ResourceID,
+	} {
+		if value != "" {
+			attrs = append(attrs, key.String(value))
+		}
+	}
+
+	ctx, span := t.tracer.Start(ctx, op.Name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...))
+	return ctx, &Span{span: span}
+}
+
+// Span implements keycloak.Span.
+type Span struct {
+	span trace.Span
+}
+
+// End records the status code and error of the call and ends the span.
+func (s *Span) End(res *http.Response, err error) {
+	if res != nil {
+		s.span.SetAttributes(attribute.Int("http.response.status_code", res.StatusCode))
+	}
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+}
@@ -0,0 +1,80 @@
+package otelkeycloak
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+func TestTracer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/admin/realms/first/users/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"id":"id"}`))
+	}))
+	defer server.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	k, err := keycloak.NewKeycloak(nil, server.URL+"/", keycloak.WithTracer(NewTracer(provider)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	if _, _, err := k.Users.GetByID(ctx, "first", "id"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := k.Users.GetByID(ctx, "first", "missing"); !keycloak.IsNotFound(err) {
+		t.Fatalf("got: %v, want: not found", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("got: %d, want: %d", len(spans), 2)
+	}
+
+	span := spans[0]
+	if span.Name != "Users.GetByID" {
+		t.Errorf("got: %s, want: %s", span.Name, "Users.GetByID")
+	}
+	if span.SpanKind != trace.SpanKindClient {
+		t.Errorf("got: %s, want: %s", span.SpanKind, trace.SpanKindClient)
+	}
+	want := map[attribute.Key]attribute.Value{
+		"http.request.method":       attribute.StringValue(http.MethodGet),
+		"http.response.status_code": attribute.IntValue(http.StatusOK),
+		AttributeRealm:              attribute.StringValue("first"),
+		AttributeResource:           attribute.StringValue("users"),
+		AttributeResourceID:         attribute.StringValue("id"),
+	}
+	got := map[attribute.Key]attribute.Value{}
+	for _, attr := range span.Attributes {
+		got[attr.Key] = attr.Value
+	}
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("%s: got: %v, want: %v", key, got[key].Emit(), value.Emit())
+		}
+	}
+
+	if spans[1].Status.Code != codes.Error {
+		t.Errorf("got: %s, want: %s", spans[1].Status.Code, codes.Error)
+	}
+	if len(spans[1].Events) != 1 || spans[1].Events[0].Name != "exception" {
+		t.Errorf("got: %v, want: a recorded error", spans[1].Events)
+	}
+}
@@ -0,0 +1,129 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+// Types of OTP policies.
+const (
+	OTPTypeTOTP = "totp"
+	OTPTypeHOTP = "hotp"
+)
+
+// Algorithms of OTP policies.
+const (
+	OTPAlgorithmSHA1   = "HmacSHA1"
+	OTPAlgorithmSHA256 = "HmacSHA256"
+	OTPAlgorithmSHA512 = "HmacSHA512"
+)
+
+// OTPPolicy is the one-time password policy of a realm, which Keycloak
+// stores in the OtpPolicy fields of the realm.
+type OTPPolicy struct {
+	// Type is OTPTypeTOTP for time based or OTPTypeHOTP for counter based
+	// passwords.
+	Type *string
+
+	// Algorithm is e.g. OTPAlgorithmSHA1, the only one supported by most
+	// authenticator apps.
+	Algorithm *string
+
+	// Digits is the length of the passwords, 6 or 8.
+	Digits *int
+
+	// LookAheadWindow is the number of periods or counter values before and
+	// after the current one that are accepted.
+	LookAheadWindow *int
+
+	// Period in seconds for which a time based password is valid.
+	Period *int
+
+	// InitialCounter of counter based passwords.
+	InitialCounter *int
+
+	// CodeReusable allows using a time based password more than once within
+	// its period.
+	CodeReusable *bool
+}
+
+// Validate checks the policy against the values Keycloak accepts. Unset
+// fields are not checked.
+func (p *OTPPolicy) Validate() error {
+	fields := map[string][]string{}
+	if p.Type != nil {
+		fields["type"] = []string{*p.Type, OTPTypeTOTP, OTPTypeHOTP}
+	}
+	if p.Algorithm != nil {
+		fields["algorithm"] = []string{*p.Algorithm, OTPAlgorithmSHA1, OTPAlgorithmSHA256, OTPAlgorithmSHA512}
+	}
+	if err := oneOf("otp policy", fields); err != nil {
+		return err
+	}
+
+	if p.Digits != nil && *p.Digits != 6 && *p.Digits != 8 {
+		return fmt.Errorf("keycloak: otp policy: invalid digits %d, want 6 or 8", *p.Digits)
+	}
+	if p.LookAheadWindow != nil && *p.LookAheadWindow < 0 {
+		return fmt.Errorf("keycloak: otp policy: negative look ahead window %d", *p.LookAheadWindow)
+	}
+	if p.Period != nil && *p.Period < 1 {
+		return fmt.Errorf("keycloak: otp policy: period %d must be positive", *p.Period)
+	}
+	if p.InitialCounter != nil && *p.InitialCounter < 0 {
+		return fmt.Errorf("keycloak: otp policy: negative initial counter %d", *p.InitialCounter)
+	}
+	return nil
+}
+
+// OTPPolicy returns the OTP policy of the realm.
+func (r *Realm) OTPPolicy() *OTPPolicy {
+	return &OTPPolicy{
+		Type:            r.OtpPolicyType,
+		Algorithm:       r.OtpPolicyAlgorithm,
+		Digits:          r.OtpPolicyDigits,
+		LookAheadWindow: r.OtpPolicyLookAheadWindow,
+		Period:          r.OtpPolicyPeriod,
+		InitialCounter:  r.OtpPolicyInitialCounter,
+		CodeReusable:    r.OtpPolicyCodeReusable,
+	}
+}
+
+// SetOTPPolicy stores the set fields of p in the OTP policy of the realm.
+func (r *Realm) SetOTPPolicy(p *OTPPolicy) {
+	if p.Type != nil {
+		r.OtpPolicyType = p.Type
+	}
+	if p.Algorithm != nil {
+		r.OtpPolicyAlgorithm = p.Algorithm
+	}
+	if p.Digits != nil {
+		r.OtpPolicyDigits = p.Digits
+	}
+	if p.LookAheadWindow != nil {
+		r.OtpPolicyLookAheadWindow = p.LookAheadWindow
+	}
+	if p.Period != nil {
+		r.OtpPolicyPeriod = p.Period
+	}
+	if p.InitialCounter != nil {
+		r.OtpPolicyInitialCounter = p.InitialCounter
+	}
+	if p.CodeReusable != nil {
+		r.OtpPolicyCodeReusable = p.CodeReusable
+	}
+}
+
+// UpdateOTPPolicy validates the policy and changes the set fields of it in
+// the realm, leaving the other realm settings as they are. Users keep their
+// configured OTP credentials, which may stop working when the type,
+// algorithm or digits change.
+func (s *RealmsService) UpdateOTPPolicy(ctx context.Context, name string, policy *OTPPolicy) (*Response, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	realm := &Realm{}
+	realm.SetOTPPolicy(policy)
+	return s.Update(ctx, name, realm)
+}
@@ -0,0 +1,79 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestOTPPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		policy *OTPPolicy
+		err    string
+	}{
+		{&OTPPolicy{}, ""},
+		{&OTPPolicy{Type: String(OTPTypeTOTP), Algorithm: String(OTPAlgorithmSHA256), Digits: Int(8), Period: Int(30), LookAheadWindow: Int(1)}, ""},
+		{&OTPPolicy{Type: String("sms")}, `invalid type "sms"`},
+		{&OTPPolicy{Algorithm: String("SHA1")}, `invalid algorithm "SHA1"`},
+		{&OTPPolicy{Digits: Int(7)}, "invalid digits 7"},
+		{&OTPPolicy{Period: Int(0)}, "period 0 must be positive"},
+		{&OTPPolicy{LookAheadWindow: Int(-1)}, "negative look ahead window"},
+	}
+
+	for _, tt := range tests {
+		err := tt.policy.Validate()
+		if tt.err == "" {
+			if err != nil {
+				t.Errorf("got: %v, want: nil", err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("got: %v, want: %s", err, tt.err)
+		}
+	}
+}
+
+func TestRealmsService_UpdateOTPPolicy(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	ctx := context.Background()
+
+	policy := &OTPPolicy{
+		Type:            String(OTPTypeTOTP),
+		Algorithm:       String(OTPAlgorithmSHA256),
+		Digits:          Int(8),
+		LookAheadWindow: Int(2),
+		Period:          Int(60),
+	}
+	res, err := k.Realms.UpdateOTPPolicy(ctx, realm, policy)
+	if err != nil {
+		t.Errorf("Realms.UpdateOTPPolicy returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	r, _, err := k.Realms.Get(ctx, realm)
+	if err != nil {
+		t.Errorf("Realms.Get returned error: %v", err)
+	}
+
+	got := r.OTPPolicy()
+	got.InitialCounter = nil
+	got.CodeReusable = nil
+	if !reflect.DeepEqual(got, policy) {
+		t.Errorf("got: %+v, want: %+v", got, policy)
+	}
+
+	// other settings are unchanged
+	if !r.GetEnabled() {
+		t.Errorf("got: %t, want: %t", r.GetEnabled(), true)
+	}
+}
@@ -0,0 +1,39 @@
+package keycloak
+
+import "context"
+
+// DefaultPageSize is the number of items requested per page by Paginate
+// when no explicit maximum is given.
+const DefaultPageSize = 100
+
+// PageFunc fetches a single page described by page and returns the number
+// of items it received.
+type PageFunc func(ctx context.Context, page Options) (int, error)
+
+// Paginate repeatedly calls fetch until a page contains fewer items than
+// requested. opts.First is the offset of the first page and opts.Max the page
+// size, which defaults to DefaultPageSize. Paginate stops with the context's
+// error as soon as ctx is done.
+func Paginate(ctx context.Context, opts Options, fetch PageFunc) error {
+	page := opts
+	if page.Max <= 0 {
+		page.Max = DefaultPageSize
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		n, err := fetch(ctx, page)
+		if err != nil {
+			return err
+		}
+
+		if n < page.Max {
+			return nil
+		}
+
+		page.First += n
+	}
+}
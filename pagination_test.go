@@ -0,0 +1,67 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestPaginate(t *testing.T) {
+	items := 250
+
+	var pages []Options
+	err := Paginate(context.Background(), Options{}, func(ctx context.Context, page Options) (int, error) {
+		pages = append(pages, page)
+		n := items - page.First
+		if n > page.Max {
+			n = page.Max
+		}
+		return n, nil
+	})
+	if err != nil {
+		t.Errorf("Paginate returned error: %v", err)
+	}
+
+	want := []Options{
+		{First: 0, Max: DefaultPageSize},
+		{First: 100, Max: DefaultPageSize},
+		{First: 200, Max: DefaultPageSize},
+	}
+	if len(pages) != len(want) {
+		t.Fatalf("got: %d, want: %d", len(pages), len(want))
+	}
+	for i := range want {
+		if pages[i] != want[i] {
+			t.Errorf("got: %+v, want: %+v", pages[i], want[i])
+		}
+	}
+}
+
+func TestPaginate_Error(t *testing.T) {
+	errFetch := errors.New("fetch failed")
+
+	err := Paginate(context.Background(), Options{Max: 10}, func(ctx context.Context, page Options) (int, error) {
+		return 0, errFetch
+	})
+	if !errors.Is(err, errFetch) {
+		t.Errorf("got: %v, want: %v", err, errFetch)
+	}
+}
+
+func TestPaginate_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	err := Paginate(ctx, Options{Max: 10}, func(ctx context.Context, page Options) (int, error) {
+		calls++
+		cancel()
+		return page.Max, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got: %v, want: %v", err, context.Canceled)
+	}
+
+	if calls != 1 {
+		t.Errorf("got: %d, want: %d", calls, 1)
+	}
+}
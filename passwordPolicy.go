@@ -0,0 +1,193 @@
+package keycloak
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Password policy IDs. Policies without a value, like
+// PasswordPolicyNotUsername, are added with an empty value.
+const (
+	PasswordPolicyLength                     = "length"
+	PasswordPolicyMaxLength                  = "maxLength"
+	PasswordPolicyDigits                     = "digits"
+	PasswordPolicyLowerCase                  = "lowerCase"
+	PasswordPolicyUpperCase                  = "upperCase"
+	PasswordPolicySpecialChars               = "specialChars"
+	PasswordPolicyNotUsername                = "notUsername"
+	PasswordPolicyNotEmail                   = "notEmail"
+	PasswordPolicyPasswordHistory            = "passwordHistory"
+	PasswordPolicyForceExpiredPasswordChange = "forceExpiredPasswordChange"
+	PasswordPolicyRegexPattern               = "regexPattern"
+	PasswordPolicyHashAlgorithm              = "hashAlgorithm"
+	PasswordPolicyHashIterations             = "hashIterations"
+	PasswordPolicyPasswordBlacklist          = "passwordBlacklist"
+)
+
+// PasswordPolicyRule is a single policy of a password policy.
+type PasswordPolicyRule struct {
+	ID    string
+	Value string
+}
+
+// PasswordPolicy is the password policy of a realm, stored by Keycloak as a
+// string like "length(12) and notUsername". Build it with NewPasswordPolicy
+// and the With methods and set Realm.PasswordPolicy to its String.
+type PasswordPolicy struct {
+	Rules []*PasswordPolicyRule
+}
+
+// NewPasswordPolicy returns an empty password policy to be filled with the
+// With methods.
+func NewPasswordPolicy() *PasswordPolicy {
+	return &PasswordPolicy{}
+}
+
+// ParsePasswordPolicy parses the password policy string of a realm the way
+// Keycloak does. An empty string is an empty policy.
+func ParsePasswordPolicy(s string) (*PasswordPolicy, error) {
+	p := NewPasswordPolicy()
+	if strings.TrimSpace(s) == "" {
+		return p, nil
+	}
+
+	for _, part := range strings.Split(s, " and ") {
+		var id, value string
+		if i := strings.Index(part, "("); i == -1 {
+			id = strings.TrimSpace(part)
+		} else {
+			j := strings.LastIndex(part, ")")
+			if j < i {
+				return nil, fmt.Errorf("keycloak: invalid password policy %q: missing closing parenthesis", part)
+			}
+			id = strings.TrimSpace(part[:i])
+			value = strings.TrimSpace(part[i+1 : j])
+		}
+		if id == "" {
+			return nil, fmt.Errorf("keycloak: invalid password policy %q: missing id", part)
+		}
+		p.With(id, value)
+	}
+	return p, nil
+}
+
+// With adds the policy id with value, replacing an existing policy with the
+// same id. Values must not contain " and ", which separates the policies.
+func (p *PasswordPolicy) With(id, value string) *PasswordPolicy {
+	for _, rule := range p.Rules {
+		if rule.ID == id {
+			rule.Value = value
+			return p
+		}
+	}
+	p.Rules = append(p.Rules, &PasswordPolicyRule{ID: id, Value: value})
+	return p
+}
+
+// Without removes the policy id.
+func (p *PasswordPolicy) Without(id string) *PasswordPolicy {
+	for i, rule := range p.Rules {
+		if rule.ID == id {
+			p.Rules = append(p.Rules[:i], p.Rules[i+1:]...)
+			break
+		}
+	}
+	return p
+}
+
+// Get returns the value of the policy id and whether the policy is set.
+func (p *PasswordPolicy) Get(id string) (string, bool) {
+	for _, rule := range p.Rules {
+		if rule.ID == id {
+			return rule.Value, true
+		}
+	}
+	return "", false
+}
+
+// WithLength sets the minimum length of passwords.
+func (p *PasswordPolicy) WithLength(n int) *PasswordPolicy {
+	return p.With(PasswordPolicyLength, strconv.Itoa(n))
+}
+
+// WithMaxLength sets the maximum length of passwords.
+func (p *PasswordPolicy) WithMaxLength(n int) *PasswordPolicy {
+	return p.With(PasswordPolicyMaxLength, strconv.Itoa(n))
+}
+
+// WithDigits sets the minimum number of digits.
+func (p *PasswordPolicy) WithDigits(n int) *PasswordPolicy {
+	return p.With(PasswordPolicyDigits, strconv.Itoa(n))
+}
+
+// WithLowerCase sets the minimum number of lower case characters.
+func (p *PasswordPolicy) WithLowerCase(n int) *PasswordPolicy {
+	return p.With(PasswordPolicyLowerCase, strconv.Itoa(n))
+}
+
+// WithUpperCase sets the minimum number of upper case characters.
+func (p *PasswordPolicy) WithUpperCase(n int) *PasswordPolicy {
+	return p.With(PasswordPolicyUpperCase, strconv.Itoa(n))
+}
+
+// WithSpecialChars sets the minimum number of special characters.
+func (p *PasswordPolicy) WithSpecialChars(n int) *PasswordPolicy {
+	return p.With(PasswordPolicySpecialChars, strconv.Itoa(n))
+}
+
+// WithNotUsername forbids passwords equal to the username.
+func (p *PasswordPolicy) WithNotUsername() *PasswordPolicy {
+	return p.With(PasswordPolicyNotUsername, "")
+}
+
+// WithNotEmail forbids passwords equal to the email.
+func (p *PasswordPolicy) WithNotEmail() *PasswordPolicy {
+	return p.With(PasswordPolicyNotEmail, "")
+}
+
+// WithPasswordHistory forbids reusing the last n passwords.
+func (p *PasswordPolicy) WithPasswordHistory(n int) *PasswordPolicy {
+	return p.With(PasswordPolicyPasswordHistory, strconv.Itoa(n))
+}
+
+// WithForceExpiredPasswordChange makes passwords expire after days.
+func (p *PasswordPolicy) WithForceExpiredPasswordChange(days int) *PasswordPolicy {
+	return p.With(PasswordPolicyForceExpiredPasswordChange, strconv.Itoa(days))
+}
+
+// WithRegexPattern requires passwords to match the Java regular expression
+// pattern.
+func (p *PasswordPolicy) WithRegexPattern(pattern string) *PasswordPolicy {
+	return p.With(PasswordPolicyRegexPattern, pattern)
+}
+
+// WithHashAlgorithm sets the algorithm new passwords are hashed with, e.g.
+// "pbkdf2-sha512" or "argon2".
+func (p *PasswordPolicy) WithHashAlgorithm(algorithm string) *PasswordPolicy {
+	return p.With(PasswordPolicyHashAlgorithm, algorithm)
+}
+
+// WithHashIterations sets the number of hash iterations.
+func (p *PasswordPolicy) WithHashIterations(n int) *PasswordPolicy {
+	return p.With(PasswordPolicyHashIterations, strconv.Itoa(n))
+}
+
+// WithPasswordBlacklist forbids the passwords listed in file, which must
+// exist in the blacklists directory of the Keycloak server.
+func (p *PasswordPolicy) WithPasswordBlacklist(file string) *PasswordPolicy {
+	return p.With(PasswordPolicyPasswordBlacklist, file)
+}
+
+// String returns the policy in the format of Realm.PasswordPolicy.
+func (p *PasswordPolicy) String() string {
+	parts := make([]string, 0, len(p.Rules))
+	for _, rule := range p.Rules {
+		if rule.Value == "" {
+			parts = append(parts, rule.ID)
+			continue
+		}
+		parts = append(parts, rule.ID+"("+rule.Value+")")
+	}
+	return strings.Join(parts, " and ")
+}
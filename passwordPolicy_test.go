@@ -0,0 +1,85 @@
+package keycloak
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestPasswordPolicy_String(t *testing.T) {
+	policy := NewPasswordPolicy().
+		WithLength(12).
+		WithUpperCase(1).
+		WithNotUsername().
+		WithPasswordHistory(5).
+		WithRegexPattern("^(?!.*(.)\\1).*$").
+		WithLength(14)
+
+	want := `length(14) and upperCase(1) and notUsername and passwordHistory(5) and regexPattern(^(?!.*(.)\1).*$)`
+	if got := policy.String(); got != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+
+	if got := policy.Without(PasswordPolicyRegexPattern).String(); got != "length(14) and upperCase(1) and notUsername and passwordHistory(5)" {
+		t.Errorf("got: %s", got)
+	}
+}
+
+func TestParsePasswordPolicy(t *testing.T) {
+	policy, err := ParsePasswordPolicy("length(12) and notUsername(undefined) and  notEmail and regexPattern((a|b)+)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*PasswordPolicyRule{
+		{ID: "length", Value: "12"},
+		{ID: "notUsername", Value: "undefined"},
+		{ID: "notEmail"},
+		{ID: "regexPattern", Value: "(a|b)+"},
+	}
+	if !reflect.DeepEqual(policy.Rules, want) {
+		t.Errorf("got: %+v, want: %+v", policy.Rules, want)
+	}
+
+	if value, ok := policy.Get(PasswordPolicyLength); !ok || value != "12" {
+		t.Errorf("got: %s %t, want: 12 true", value, ok)
+	}
+
+	if policy, err := ParsePasswordPolicy(""); err != nil || len(policy.Rules) != 0 {
+		t.Errorf("got: %v %v, want: empty policy", policy, err)
+	}
+
+	for _, s := range []string{"length(12", "(12) and notEmail"} {
+		if _, err := ParsePasswordPolicy(s); err == nil {
+			t.Errorf("%s: got: nil, want: error", s)
+		}
+	}
+}
+
+func TestRealmsService_UpdatePasswordPolicy(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	ctx := context.Background()
+
+	policy := NewPasswordPolicy().WithLength(12).WithNotUsername().WithPasswordHistory(5)
+	if _, err := k.Realms.Update(ctx, realm, &Realm{PasswordPolicy: String(policy.String())}); err != nil {
+		t.Errorf("Realms.Update returned error: %v", err)
+	}
+
+	r, _, err := k.Realms.Get(ctx, realm)
+	if err != nil {
+		t.Errorf("Realms.Get returned error: %v", err)
+	}
+
+	got, err := ParsePasswordPolicy(r.GetPasswordPolicy())
+	if err != nil {
+		t.Errorf("ParsePasswordPolicy returned error: %v", err)
+	}
+
+	if value, _ := got.Get(PasswordPolicyPasswordHistory); value != "5" {
+		t.Errorf("got: %s, want: %s", value, "5")
+	}
+}
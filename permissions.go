@@ -2,7 +2,6 @@ package keycloak
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 )
 
@@ -42,8 +41,8 @@ type ScopePermission struct {
 }
 
 // List lists all permissions.
-func (s *PermissionsService) List(ctx context.Context, realm, clientID string) ([]*Permission, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/permission", realm, clientID)
+func (s *PermissionsService) List(ctx context.Context, realm, clientID string) ([]*Permission, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/permission", realm, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -59,8 +58,8 @@ func (s *PermissionsService) List(ctx context.Context, realm, clientID string) (
 }
 
 // CreateResourcePermission creates a new resource based permission.
-func (s *PermissionsService) CreateResourcePermission(ctx context.Context, realm, clientID string, permission *ResourcePermission) (*ResourcePermission, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/permission/resource", realm, clientID)
+func (s *PermissionsService) CreateResourcePermission(ctx context.Context, realm, clientID string, permission *ResourcePermission) (*ResourcePermission, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/permission/resource", realm, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, permission)
 	if err != nil {
 		return nil, nil, err
@@ -76,8 +75,8 @@ func (s *PermissionsService) CreateResourcePermission(ctx context.Context, realm
 }
 
 // GetResourcePermission gets resource based permission by id.
-func (s *PermissionsService) GetResourcePermission(ctx context.Context, realm, clientID, permissionID string) (*ResourcePermission, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/permission/resource/%s", realm, clientID, permissionID)
+func (s *PermissionsService) GetResourcePermission(ctx context.Context, realm, clientID, permissionID string) (*ResourcePermission, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/permission/resource/%s", realm, clientID, permissionID)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -93,8 +92,8 @@ func (s *PermissionsService) GetResourcePermission(ctx context.Context, realm, c
 }
 
 // GetScopePermission gets scope based permission by id.
-func (s *PermissionsService) GetScopePermission(ctx context.Context, realm, clientID, permissionID string) (*ScopePermission, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/permission/scope/%s", realm, clientID, permissionID)
+func (s *PermissionsService) GetScopePermission(ctx context.Context, realm, clientID, permissionID string) (*ScopePermission, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/permission/scope/%s", realm, clientID, permissionID)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -110,8 +109,8 @@ func (s *PermissionsService) GetScopePermission(ctx context.Context, realm, clie
 }
 
 // CreateScopePermission creates a new scope based permission.
-func (s *PermissionsService) CreateScopePermission(ctx context.Context, realm, clientID string, permission *ScopePermission) (*ScopePermission, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/permission/scope", realm, clientID)
+func (s *PermissionsService) CreateScopePermission(ctx context.Context, realm, clientID string, permission *ScopePermission) (*ScopePermission, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/permission/scope", realm, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, permission)
 	if err != nil {
 		return nil, nil, err
@@ -125,3 +124,36 @@ func (s *PermissionsService) CreateScopePermission(ctx context.Context, realm, c
 
 	return &created, res, nil
 }
+
+// UpdateResourcePermission updates a resource based permission.
+func (s *PermissionsService) UpdateResourcePermission(ctx context.Context, realm, clientID string, permission *ResourcePermission) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/permission/resource/%s", realm, clientID, *permission.ID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, permission)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// UpdateScopePermission updates a scope based permission.
+func (s *PermissionsService) UpdateScopePermission(ctx context.Context, realm, clientID string, permission *ScopePermission) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/permission/scope/%s", realm, clientID, *permission.ID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, permission)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// Delete deletes a permission.
+func (s *PermissionsService) Delete(ctx context.Context, realm, clientID, permissionID string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/permission/%s", realm, clientID, permissionID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
@@ -1,8 +1,9 @@
 package keycloak
 
 import (
+	"bytes"
 	"context"
-	"fmt"
+	"encoding/json"
 	"net/http"
 )
 
@@ -23,6 +24,10 @@ type Policy struct {
 	Logic            *string  `json:"logic,omitempty"`
 	DecisionStrategy *string  `json:"decisionStrategy,omitempty"`
 	Owner            *string  `json:"owner,omitempty"`
+
+	// Config holds the type specific settings of the policy. It is only used
+	// by generic representations, e.g. in ResourceServer exports.
+	Config *map[string]string `json:"config,omitempty"`
 }
 
 // GroupDefinition represents a Keycloak groupDefinition.
@@ -67,9 +72,52 @@ type RoleDefinition struct {
 	Required *bool   `json:"required,omitempty"`
 }
 
+// ClientPolicy represents a Keycloak client policy.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/authorization/ClientPolicyRepresentation.java
+type ClientPolicy struct {
+	Policy
+	Clients []string `json:"clients,omitempty"`
+}
+
+// JSPolicy represents a Keycloak JavaScript policy.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/authorization/JSPolicyRepresentation.java
+type JSPolicy struct {
+	Policy
+	Code *string `json:"code,omitempty"`
+}
+
+// TimePolicy represents a Keycloak time policy.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/authorization/TimePolicyRepresentation.java
+type TimePolicy struct {
+	Policy
+	NotBefore    *string `json:"notBefore,omitempty"`
+	NotOnOrAfter *string `json:"notOnOrAfter,omitempty"`
+	DayMonth     *string `json:"dayMonth,omitempty"`
+	DayMonthEnd  *string `json:"dayMonthEnd,omitempty"`
+	Month        *string `json:"month,omitempty"`
+	MonthEnd     *string `json:"monthEnd,omitempty"`
+	Year         *string `json:"year,omitempty"`
+	YearEnd      *string `json:"yearEnd,omitempty"`
+	Hour         *string `json:"hour,omitempty"`
+	HourEnd      *string `json:"hourEnd,omitempty"`
+	Minute       *string `json:"minute,omitempty"`
+	MinuteEnd    *string `json:"minuteEnd,omitempty"`
+}
+
+// AggregatePolicy represents a Keycloak aggregated policy. The aggregated
+// policies are referenced by Policies.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/authorization/AggregatePolicyRepresentation.java
+type AggregatePolicy struct {
+	Policy
+}
+
 // List lists all policies.
-func (s *PoliciesService) List(ctx context.Context, realm, clientID string) ([]*Policy, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/policy?permission=false", realm, clientID)
+func (s *PoliciesService) List(ctx context.Context, realm, clientID string) ([]*Policy, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/policy?permission=false", realm, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -85,8 +133,8 @@ func (s *PoliciesService) List(ctx context.Context, realm, clientID string) ([]*
 }
 
 // CreateUserPolicy creates a new user policy.
-func (s *PoliciesService) CreateUserPolicy(ctx context.Context, realm, clientID string, policy *UserPolicy) (*UserPolicy, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/policy/user", realm, clientID)
+func (s *PoliciesService) CreateUserPolicy(ctx context.Context, realm, clientID string, policy *UserPolicy) (*UserPolicy, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/policy/user", realm, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, policy)
 	if err != nil {
 		return nil, nil, err
@@ -102,8 +150,8 @@ func (s *PoliciesService) CreateUserPolicy(ctx context.Context, realm, clientID
 }
 
 // CreateRolePolicy creates a new role policy.
-func (s *PoliciesService) CreateRolePolicy(ctx context.Context, realm, clientID string, policy *RolePolicy) (*RolePolicy, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/policy/role", realm, clientID)
+func (s *PoliciesService) CreateRolePolicy(ctx context.Context, realm, clientID string, policy *RolePolicy) (*RolePolicy, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/policy/role", realm, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, policy)
 	if err != nil {
 		return nil, nil, err
@@ -119,8 +167,8 @@ func (s *PoliciesService) CreateRolePolicy(ctx context.Context, realm, clientID
 }
 
 // CreateGroupPolicy creates a new group policy.
-func (s *PoliciesService) CreateGroupPolicy(ctx context.Context, realm, clientID string, policy *GroupPolicy) (*GroupPolicy, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/policy/group", realm, clientID)
+func (s *PoliciesService) CreateGroupPolicy(ctx context.Context, realm, clientID string, policy *GroupPolicy) (*GroupPolicy, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/policy/group", realm, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, policy)
 	if err != nil {
 		return nil, nil, err
@@ -134,3 +182,178 @@ func (s *PoliciesService) CreateGroupPolicy(ctx context.Context, realm, clientID
 
 	return &created, res, nil
 }
+
+// CreateClientPolicy creates a new client policy.
+func (s *PoliciesService) CreateClientPolicy(ctx context.Context, realm, clientID string, policy *ClientPolicy) (*ClientPolicy, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/policy/client", realm, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, policy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created ClientPolicy
+	res, err := s.keycloak.Do(ctx, req, &created)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &created, res, nil
+}
+
+// CreateJSPolicy creates a new JavaScript policy.
+func (s *PoliciesService) CreateJSPolicy(ctx context.Context, realm, clientID string, policy *JSPolicy) (*JSPolicy, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/policy/js", realm, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, policy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created JSPolicy
+	res, err := s.keycloak.Do(ctx, req, &created)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &created, res, nil
+}
+
+// CreateTimePolicy creates a new time policy.
+func (s *PoliciesService) CreateTimePolicy(ctx context.Context, realm, clientID string, policy *TimePolicy) (*TimePolicy, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/policy/time", realm, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, policy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created TimePolicy
+	res, err := s.keycloak.Do(ctx, req, &created)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &created, res, nil
+}
+
+// CreateAggregatePolicy creates a new aggregated policy.
+func (s *PoliciesService) CreateAggregatePolicy(ctx context.Context, realm, clientID string, policy *AggregatePolicy) (*AggregatePolicy, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/policy/aggregate", realm, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, policy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created AggregatePolicy
+	res, err := s.keycloak.Do(ctx, req, &created)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &created, res, nil
+}
+
+// Get gets a policy by id.
+func (s *PoliciesService) Get(ctx context.Context, realm, clientID, policyID string) (*Policy, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/policy/%s", realm, clientID, policyID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var policy Policy
+	res, err := s.keycloak.Do(ctx, req, &policy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &policy, res, nil
+}
+
+// GetByName gets a policy by name. It returns ErrNotFound if there is no
+// policy with the name.
+func (s *PoliciesService) GetByName(ctx context.Context, realm, clientID, name string) (*Policy, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/policy/search", realm, clientID)
+	u, err := addOptions(u, &struct {
+		Name string `url:"name"`
+	}{name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// a miss is answered with 204 No Content and an empty body
+	var body bytes.Buffer
+	res, err := s.keycloak.Do(ctx, req, &body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.StatusCode == http.StatusNoContent || body.Len() == 0 {
+		return nil, res, ErrNotFound
+	}
+
+	var policy Policy
+	if err := json.Unmarshal(body.Bytes(), &policy); err != nil {
+		return nil, res, err
+	}
+
+	return &policy, res, nil
+}
+
+// Delete deletes a policy or permission.
+func (s *PoliciesService) Delete(ctx context.Context, realm, clientID, policyID string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/policy/%s", realm, clientID, policyID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// UpdateUserPolicy updates a user policy.
+func (s *PoliciesService) UpdateUserPolicy(ctx context.Context, realm, clientID string, policy *UserPolicy) (*Response, error) {
+	return s.update(ctx, realm, clientID, "user", *policy.ID, policy)
+}
+
+// UpdateRolePolicy updates a role policy.
+func (s *PoliciesService) UpdateRolePolicy(ctx context.Context, realm, clientID string, policy *RolePolicy) (*Response, error) {
+	return s.update(ctx, realm, clientID, "role", *policy.ID, policy)
+}
+
+// UpdateGroupPolicy updates a group policy.
+func (s *PoliciesService) UpdateGroupPolicy(ctx context.Context, realm, clientID string, policy *GroupPolicy) (*Response, error) {
+	return s.update(ctx, realm, clientID, "group", *policy.ID, policy)
+}
+
+// UpdateClientPolicy updates a client policy.
+func (s *PoliciesService) UpdateClientPolicy(ctx context.Context, realm, clientID string, policy *ClientPolicy) (*Response, error) {
+	return s.update(ctx, realm, clientID, "client", *policy.ID, policy)
+}
+
+// UpdateJSPolicy updates a JavaScript policy.
+func (s *PoliciesService) UpdateJSPolicy(ctx context.Context, realm, clientID string, policy *JSPolicy) (*Response, error) {
+	return s.update(ctx, realm, clientID, "js", *policy.ID, policy)
+}
+
+// UpdateTimePolicy updates a time policy.
+func (s *PoliciesService) UpdateTimePolicy(ctx context.Context, realm, clientID string, policy *TimePolicy) (*Response, error) {
+	return s.update(ctx, realm, clientID, "time", *policy.ID, policy)
+}
+
+// UpdateAggregatePolicy updates an aggregated policy.
+func (s *PoliciesService) UpdateAggregatePolicy(ctx context.Context, realm, clientID string, policy *AggregatePolicy) (*Response, error) {
+	return s.update(ctx, realm, clientID, "aggregate", *policy.ID, policy)
+}
+
+func (s *PoliciesService) update(ctx context.Context, realm, clientID, policyType, policyID string, policy interface{}) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/policy/%s/%s", realm, clientID, policyType, policyID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
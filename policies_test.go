@@ -3,6 +3,7 @@ package keycloak
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -151,3 +152,143 @@ func TestPoliciesService_List(t *testing.T) {
 		t.Errorf("got: %d, want: %d", len(roles), 1)
 	}
 }
+
+func TestPoliciesService_CreateClientPolicy(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	clientID := createClient(t, k, realm, "client")
+	otherID := createClient(t, k, realm, "other")
+
+	policy := &ClientPolicy{
+		Policy: Policy{
+			Type:             String("client"),
+			Logic:            String(LogicPositive),
+			DecisionStrategy: String(DecisionStrategyUnanimous),
+			Name:             String("policy"),
+		},
+		Clients: []string{otherID},
+	}
+
+	policy, res, err := k.Policies.CreateClientPolicy(context.Background(), realm, clientID, policy)
+	if err != nil {
+		t.Errorf("Policies.CreateClientPolicy returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+
+	if len(policy.Clients) != 1 {
+		t.Errorf("got: %d, want: %d", len(policy.Clients), 1)
+	}
+}
+
+func TestPoliciesService_UpdateTimePolicy(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	clientID := createClient(t, k, realm, "client")
+
+	ctx := context.Background()
+
+	policy := &TimePolicy{
+		Policy: Policy{
+			Type:             String("time"),
+			Logic:            String(LogicPositive),
+			DecisionStrategy: String(DecisionStrategyUnanimous),
+			Name:             String("policy"),
+		},
+		Hour:    String("8"),
+		HourEnd: String("18"),
+	}
+
+	policy, _, err := k.Policies.CreateTimePolicy(ctx, realm, clientID, policy)
+	if err != nil {
+		t.Errorf("Policies.CreateTimePolicy returned error: %v", err)
+	}
+
+	policy.HourEnd = String("20")
+
+	res, err := k.Policies.UpdateTimePolicy(ctx, realm, clientID, policy)
+	if err != nil {
+		t.Errorf("Policies.UpdateTimePolicy returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+}
+
+func TestPoliciesService_Delete(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	clientID := createClient(t, k, realm, "client")
+	userID := createUser(t, k, realm, "john")
+
+	policy := createUserPolicy(t, k, realm, clientID, userID)
+
+	ctx := context.Background()
+
+	found, _, err := k.Policies.GetByName(ctx, realm, clientID, "policy")
+	if err != nil {
+		t.Errorf("Policies.GetByName returned error: %v", err)
+	}
+
+	if *found.ID != *policy.ID {
+		t.Errorf("got: %s, want: %s", *found.ID, *policy.ID)
+	}
+
+	res, err := k.Policies.Delete(ctx, realm, clientID, *policy.ID)
+	if err != nil {
+		t.Errorf("Policies.Delete returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestPoliciesService_GetByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/realms/first/clients/client/authz/resource-server/policy/search" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+		if r.URL.Query().Get("name") == "missing" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Write([]byte(`{"id":"id","name":"found"}`))
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	policy, _, err := k.Policies.GetByName(ctx, "first", "client", "found")
+	if err != nil {
+		t.Fatalf("Policies.GetByName returned error: %v", err)
+	}
+	if policy.GetID() != "id" {
+		t.Errorf("got: %s, want: %s", policy.GetID(), "id")
+	}
+
+	policy, res, err := k.Policies.GetByName(ctx, "first", "client", "missing")
+	if !IsNotFound(err) {
+		t.Errorf("got: %v, want: %v", err, ErrNotFound)
+	}
+	if policy != nil {
+		t.Errorf("got: %v, want: nil", policy)
+	}
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
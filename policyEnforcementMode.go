@@ -0,0 +1,15 @@
+package keycloak
+
+// The policy enforcement mode dictates how authorization requests are handled by the resource server.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/authorization/PolicyEnforcementMode.java
+const (
+	// ENFORCING denies requests by default, even when there is no policy associated with a given resource.
+	PolicyEnforcementModeEnforcing = "ENFORCING"
+
+	// PERMISSIVE allows requests even when there is no policy associated with a given resource.
+	PolicyEnforcementModePermissive = "PERMISSIVE"
+
+	// DISABLED completely disables the evaluation of policies and allows access to any resource.
+	PolicyEnforcementModeDisabled = "DISABLED"
+)
@@ -0,0 +1,163 @@
+package keycloak
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultDurationBuckets are the upper bounds in seconds of the request
+// duration histogram of PrometheusMetrics.
+var DefaultDurationBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// PrometheusMetrics implements Metrics and serves the recorded metrics in
+// the Prometheus text format, so it does not depend on the Prometheus
+// client library:
+//
+//	metrics := keycloak.NewPrometheusMetrics()
+//	k, err := keycloak.NewKeycloak(client, "http://localhost:8080/", keycloak.WithMetrics(metrics))
+//	http.Handle("/metrics/keycloak", metrics)
+//
+// It exposes
+//
+//	keycloak_requests_total{operation,method,code}
+//	keycloak_request_errors_total{operation,class}
+//	keycloak_request_duration_seconds{operation}
+//
+// code is empty if no response was received and class is one of the
+// ErrorClass constants. A PrometheusMetrics is safe for concurrent use.
+type PrometheusMetrics struct {
+	// Buckets of the duration histogram. Defaults to DefaultDurationBuckets
+	// and must not be changed after the first call was observed.
+	Buckets []float64
+
+	mu        sync.Mutex
+	requests  map[[3]string]uint64
+	errors    map[[2]string]uint64
+	durations map[string]*histogram
+}
+
+type histogram struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// NewPrometheusMetrics returns a PrometheusMetrics with default buckets.
+func NewPrometheusMetrics() *PrometheusMetrics {
+	return &PrometheusMetrics{}
+}
+
+// Observe records a call.
+func (m *PrometheusMetrics) Observe(op *Operation, res *http.Response, err error, duration time.Duration) {
+	code := ""
+	if res != nil {
+		code = strconv.Itoa(res.StatusCode)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.requests == nil {
+		m.requests = map[[3]string]uint64{}
+		m.errors = map[[2]string]uint64{}
+		m.durations = map[string]*histogram{}
+	}
+	if m.Buckets == nil {
+		m.Buckets = DefaultDurationBuckets
+	}
+
+	m.requests[[3]string{op.Name, op.Method, code}]++
+
+	if class := ErrorClass(res, err); class != "" {
+		m.errors[[2]string{op.Name, class}]++
+	}
+
+	h, ok := m.durations[op.Name]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(m.Buckets))}
+		m.durations[op.Name] = h
+	}
+	seconds := duration.Seconds()
+	for i, bound := range m.Buckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += seconds
+	h.count++
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format.
+func (m *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text format to w.
+func (m *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var b strings.Builder
+
+	b.WriteString("# HELP keycloak_requests_total Number of Keycloak API requests.\n")
+	b.WriteString("# TYPE keycloak_requests_total counter\n")
+	requests := make([][3]string, 0, len(m.requests))
+	for key := range m.requests {
+		requests = append(requests, key)
+	}
+	sort.Slice(requests, func(i, j int) bool {
+		return strings.Join(requests[i][:], "\x00") < strings.Join(requests[j][:], "\x00")
+	})
+	for _, key := range requests {
+		fmt.Fprintf(&b, "keycloak_requests_total{operation=%s,method=%s,code=%s} %d\n", label(key[0]), label(key[1]), label(key[2]), m.requests[key])
+	}
+
+	b.WriteString("# HELP keycloak_request_errors_total Number of failed Keycloak API requests by error class.\n")
+	b.WriteString("# TYPE keycloak_request_errors_total counter\n")
+	errs := make([][2]string, 0, len(m.errors))
+	for key := range m.errors {
+		errs = append(errs, key)
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i][0] < errs[j][0] || errs[i][0] == errs[j][0] && errs[i][1] < errs[j][1]
+	})
+	for _, key := range errs {
+		fmt.Fprintf(&b, "keycloak_request_errors_total{operation=%s,class=%s} %d\n", label(key[0]), label(key[1]), m.errors[key])
+	}
+
+	b.WriteString("# HELP keycloak_request_duration_seconds Duration of Keycloak API requests.\n")
+	b.WriteString("# TYPE keycloak_request_duration_seconds histogram\n")
+	ops := make([]string, 0, len(m.durations))
+	for op := range m.durations {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	for _, op := range ops {
+		h := m.durations[op]
+		for i, bound := range m.Buckets {
+			fmt.Fprintf(&b, "keycloak_request_duration_seconds_bucket{operation=%s,le=%s} %d\n", label(op), label(strconv.FormatFloat(bound, 'g', -1, 64)), h.counts[i])
+		}
+		fmt.Fprintf(&b, "keycloak_request_duration_seconds_bucket{operation=%s,le=\"+Inf\"} %d\n", label(op), h.count)
+		fmt.Fprintf(&b, "keycloak_request_duration_seconds_sum{operation=%s} %s\n", label(op), strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(&b, "keycloak_request_duration_seconds_count{operation=%s} %d\n", label(op), h.count)
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// labelEscaper escapes label values as the exposition format requires,
+// which only knows these three escape sequences.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// label quotes a label value.
+func label(v string) string {
+	return `"` + labelEscaper.Replace(v) + `"`
+}
@@ -0,0 +1,76 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPrometheusMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/missing") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/malformed") {
+			w.Write([]byte("{"))
+			return
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	metrics := NewPrometheusMetrics()
+	k, err := NewKeycloak(nil, server.URL+"/", WithMetrics(metrics))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	if _, _, err := k.Users.GetByID(ctx, "first", "123"); err != nil {
+		t.Errorf("Users.GetByID returned error: %v", err)
+	}
+	if _, _, err := k.Users.GetByID(ctx, "first", "missing"); err == nil {
+		t.Error("Users.GetByID returned no error")
+	}
+	if _, _, err := k.Groups.Get(ctx, "first", "malformed"); err == nil {
+		t.Error("Groups.Get returned no error")
+	}
+
+	rec := httptest.NewRecorder()
+	metrics.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+
+	for _, want := range []string{
+		`keycloak_requests_total{operation="Users.GetByID",method="GET",code="200"} 1`,
+		`keycloak_requests_total{operation="Users.GetByID",method="GET",code="404"} 1`,
+		`keycloak_request_errors_total{operation="Users.GetByID",class="client_error"} 1`,
+		`keycloak_request_duration_seconds_bucket{operation="Users.GetByID",le="+Inf"} 2`,
+		`keycloak_request_duration_seconds_count{operation="Users.GetByID"} 2`,
+		`keycloak_requests_total{operation="Groups.Get",method="GET",code="200"} 1`,
+		`keycloak_request_errors_total{operation="Groups.Get",class="decode"} 1`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("missing %s in\n%s", want, body)
+		}
+	}
+}
+
+func TestLabel(t *testing.T) {
+	tests := map[string]string{
+		"Users.Get": `"Users.Get"`,
+		`a"b`:       `"a\"b"`,
+		`a\b`:       `"a\\b"`,
+		"a\nb":      `"a\nb"`,
+		"a\tb\x00é": "\"a\tb\x00é\"",
+	}
+
+	for value, want := range tests {
+		if got := label(value); got != want {
+			t.Errorf("label(%q): got: %s, want: %s", value, got, want)
+		}
+	}
+}
@@ -0,0 +1,340 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+)
+
+// ProtectionAPI is a client for the UMA 2.0 protection API of a realm.
+//
+// Unlike the admin API the protection API is used by resource servers
+// themselves and must be authenticated with a protection API token (PAT),
+// i.e. a token obtained with the client credentials grant of the resource
+// server client:
+//
+//	conf := &keycloak.TokenConfig{
+//		BaseURL:      "http://localhost:8080",
+//		Realm:        "myrealm",
+//		ClientID:     "my-resource-server",
+//		ClientSecret: "secret",
+//	}
+//	api, err := keycloak.NewProtectionAPI(conf.Client(ctx), "http://localhost:8080/", "myrealm")
+//
+// https://www.keycloak.org/docs/latest/authorization_services/#_service_protection_api
+type ProtectionAPI struct {
+	keycloak *Keycloak
+	realm    string
+}
+
+// NewProtectionAPI returns a new protection API client for the realm. The
+// http client must authenticate requests with a PAT.
+func NewProtectionAPI(httpClient *http.Client, baseURL, realm string, opts ...ClientOption) (*ProtectionAPI, error) {
+	k, err := NewKeycloak(httpClient, baseURL, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProtectionAPI{keycloak: k, realm: realm}, nil
+}
+
+// ProtectedResource is a resource as registered through the protection API.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/authorization/ResourceRepresentation.java
+type ProtectedResource struct {
+	ID                 *string              `json:"_id,omitempty"`
+	Name               *string              `json:"name,omitempty"`
+	DisplayName        *string              `json:"displayName,omitempty"`
+	Type               *string              `json:"type,omitempty"`
+	IconURI            *string              `json:"icon_uri,omitempty"`
+	Uris               []string             `json:"uris,omitempty"`
+	ResourceScopes     []*Scope             `json:"resource_scopes,omitempty"`
+	Owner              *string              `json:"owner,omitempty"`
+	OwnerManagedAccess *bool                `json:"ownerManagedAccess,omitempty"`
+	Attributes         *map[string][]string `json:"attributes,omitempty"`
+}
+
+// ProtectedResourceListOptions specifies the optional parameters to the ProtectionAPI.ListResources method.
+type ProtectedResourceListOptions struct {
+	Name        string `url:"name,omitempty"`
+	URI         string `url:"uri,omitempty"`
+	Owner       string `url:"owner,omitempty"`
+	Type        string `url:"type,omitempty"`
+	Scope       string `url:"scope,omitempty"`
+	MatchingURI *bool  `url:"matchingUri,omitempty"`
+	Exact       *bool  `url:"exactName,omitempty"`
+	Options
+}
+
+// PermissionRequest requests access to the scopes of a resource on behalf of a client.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/authorization/PermissionRequest.java
+type PermissionRequest struct {
+	ResourceID     *string              `json:"resource_id,omitempty"`
+	ResourceScopes []string             `json:"resource_scopes,omitempty"`
+	Claims         *map[string][]string `json:"claims,omitempty"`
+}
+
+// PermissionTicket representation.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/authorization/PermissionTicketRepresentation.java
+type PermissionTicket struct {
+	ID            *string `json:"id,omitempty"`
+	Owner         *string `json:"owner,omitempty"`
+	Resource      *string `json:"resource,omitempty"`
+	Scope         *string `json:"scope,omitempty"`
+	Granted       *bool   `json:"granted,omitempty"`
+	ScopeName     *string `json:"scopeName,omitempty"`
+	ResourceName  *string `json:"resourceName,omitempty"`
+	Requester     *string `json:"requester,omitempty"`
+	OwnerName     *string `json:"ownerName,omitempty"`
+	RequesterName *string `json:"requesterName,omitempty"`
+}
+
+// PermissionTicketListOptions specifies the optional parameters to the ProtectionAPI.ListPermissionTickets method.
+type PermissionTicketListOptions struct {
+	ResourceID  string `url:"resourceId,omitempty"`
+	ScopeID     string `url:"scopeId,omitempty"`
+	Owner       string `url:"owner,omitempty"`
+	Requester   string `url:"requester,omitempty"`
+	Granted     *bool  `url:"granted,omitempty"`
+	ReturnNames *bool  `url:"returnNames,omitempty"`
+	Options
+}
+
+// UMAPolicy is a policy a resource owner associates with a resource.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/authorization/UmaPermissionRepresentation.java
+type UMAPolicy struct {
+	ID               *string  `json:"id,omitempty"`
+	Name             *string  `json:"name,omitempty"`
+	Description      *string  `json:"description,omitempty"`
+	Type             *string  `json:"type,omitempty"`
+	Scopes           []string `json:"scopes,omitempty"`
+	Roles            []string `json:"roles,omitempty"`
+	Groups           []string `json:"groups,omitempty"`
+	Clients          []string `json:"clients,omitempty"`
+	Users            []string `json:"users,omitempty"`
+	Condition        *string  `json:"condition,omitempty"`
+	Logic            *string  `json:"logic,omitempty"`
+	DecisionStrategy *string  `json:"decisionStrategy,omitempty"`
+	Owner            *string  `json:"owner,omitempty"`
+}
+
+// UMAPolicyListOptions specifies the optional parameters to the ProtectionAPI.ListUMAPolicies method.
+type UMAPolicyListOptions struct {
+	Resource string `url:"resource,omitempty"`
+	Name     string `url:"name,omitempty"`
+	Scope    string `url:"scope,omitempty"`
+	Options
+}
+
+// CreateResource registers a new resource.
+func (p *ProtectionAPI) CreateResource(ctx context.Context, resource *ProtectedResource) (*ProtectedResource, *Response, error) {
+	u := pathf("realms/%s/authz/protection/resource_set", p.realm)
+	req, err := p.keycloak.NewRequest(http.MethodPost, u, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created ProtectedResource
+	res, err := p.keycloak.Do(ctx, req, &created)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &created, res, nil
+}
+
+// ListResources lists the ids of the registered resources.
+func (p *ProtectionAPI) ListResources(ctx context.Context, opts *ProtectedResourceListOptions) ([]string, *Response, error) {
+	u := pathf("realms/%s/authz/protection/resource_set", p.realm)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := p.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ids []string
+	res, err := p.keycloak.Do(ctx, req, &ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ids, res, nil
+}
+
+// GetResource gets a registered resource.
+func (p *ProtectionAPI) GetResource(ctx context.Context, resourceID string) (*ProtectedResource, *Response, error) {
+	u := pathf("realms/%s/authz/protection/resource_set/%s", p.realm, resourceID)
+	req, err := p.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resource ProtectedResource
+	res, err := p.keycloak.Do(ctx, req, &resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &resource, res, nil
+}
+
+// UpdateResource updates a registered resource. It returns ErrMissingID if
+// the ID of resource is not set.
+func (p *ProtectionAPI) UpdateResource(ctx context.Context, resource *ProtectedResource) (*Response, error) {
+	if resource.ID == nil {
+		return nil, ErrMissingID
+	}
+	u := pathf("realms/%s/authz/protection/resource_set/%s", p.realm, *resource.ID)
+	req, err := p.keycloak.NewRequest(http.MethodPut, u, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.keycloak.Do(ctx, req, nil)
+}
+
+// DeleteResource deletes a registered resource.
+func (p *ProtectionAPI) DeleteResource(ctx context.Context, resourceID string) (*Response, error) {
+	u := pathf("realms/%s/authz/protection/resource_set/%s", p.realm, resourceID)
+	req, err := p.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.keycloak.Do(ctx, req, nil)
+}
+
+// CreatePermissionTicket requests a permission ticket for the resources and
+// scopes of the requests. The ticket is exchanged by the client for an RPT.
+func (p *ProtectionAPI) CreatePermissionTicket(ctx context.Context, requests []*PermissionRequest) (string, *Response, error) {
+	u := pathf("realms/%s/authz/protection/permission", p.realm)
+	req, err := p.keycloak.NewRequest(http.MethodPost, u, requests)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var ticket struct {
+		Ticket string `json:"ticket"`
+	}
+	res, err := p.keycloak.Do(ctx, req, &ticket)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return ticket.Ticket, res, nil
+}
+
+// ListPermissionTickets lists the permission tickets, e.g. the pending access requests of a resource.
+func (p *ProtectionAPI) ListPermissionTickets(ctx context.Context, opts *PermissionTicketListOptions) ([]*PermissionTicket, *Response, error) {
+	u := pathf("realms/%s/authz/protection/permission/ticket", p.realm)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := p.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tickets []*PermissionTicket
+	res, err := p.keycloak.Do(ctx, req, &tickets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tickets, res, nil
+}
+
+// UpdatePermissionTicket updates a permission ticket, e.g. to grant the requested access.
+func (p *ProtectionAPI) UpdatePermissionTicket(ctx context.Context, ticket *PermissionTicket) (*Response, error) {
+	u := pathf("realms/%s/authz/protection/permission/ticket", p.realm)
+	req, err := p.keycloak.NewRequest(http.MethodPut, u, ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.keycloak.Do(ctx, req, nil)
+}
+
+// DeletePermissionTicket deletes a permission ticket.
+func (p *ProtectionAPI) DeletePermissionTicket(ctx context.Context, ticketID string) (*Response, error) {
+	u := pathf("realms/%s/authz/protection/permission/ticket/%s", p.realm, ticketID)
+	req, err := p.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.keycloak.Do(ctx, req, nil)
+}
+
+// CreateUMAPolicy associates a new policy with the resource.
+func (p *ProtectionAPI) CreateUMAPolicy(ctx context.Context, resourceID string, policy *UMAPolicy) (*UMAPolicy, *Response, error) {
+	u := pathf("realms/%s/authz/protection/uma-policy/%s", p.realm, resourceID)
+	req, err := p.keycloak.NewRequest(http.MethodPost, u, policy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created UMAPolicy
+	res, err := p.keycloak.Do(ctx, req, &created)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &created, res, nil
+}
+
+// ListUMAPolicies lists the policies associated with resources.
+func (p *ProtectionAPI) ListUMAPolicies(ctx context.Context, opts *UMAPolicyListOptions) ([]*UMAPolicy, *Response, error) {
+	u := pathf("realms/%s/authz/protection/uma-policy", p.realm)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := p.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var policies []*UMAPolicy
+	res, err := p.keycloak.Do(ctx, req, &policies)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return policies, res, nil
+}
+
+// UpdateUMAPolicy updates a policy associated with a resource. It returns
+// ErrMissingID if the ID of policy is not set.
+func (p *ProtectionAPI) UpdateUMAPolicy(ctx context.Context, policy *UMAPolicy) (*Response, error) {
+	if policy.ID == nil {
+		return nil, ErrMissingID
+	}
+	u := pathf("realms/%s/authz/protection/uma-policy/%s", p.realm, *policy.ID)
+	req, err := p.keycloak.NewRequest(http.MethodPut, u, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.keycloak.Do(ctx, req, nil)
+}
+
+// DeleteUMAPolicy deletes a policy associated with a resource.
+func (p *ProtectionAPI) DeleteUMAPolicy(ctx context.Context, policyID string) (*Response, error) {
+	u := pathf("realms/%s/authz/protection/uma-policy/%s", p.realm, policyID)
+	req, err := p.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.keycloak.Do(ctx, req, nil)
+}
@@ -0,0 +1,139 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+// create a new protection api client authenticated as the resource server client.
+func protectionAPI(t *testing.T, k *Keycloak, realm, clientID string) *ProtectionAPI {
+	t.Helper()
+
+	ctx := context.Background()
+
+	client, _, err := k.Clients.Get(ctx, realm, clientID)
+	if err != nil {
+		t.Errorf("Clients.Get returned error: %v", err)
+	}
+
+	secret, _, err := k.Clients.GetSecret(ctx, realm, clientID)
+	if err != nil {
+		t.Errorf("Clients.GetSecret returned error: %v", err)
+	}
+
+	conf := &TokenConfig{
+		BaseURL:      "http://localhost:8080",
+		Realm:        realm,
+		ClientID:     *client.ClientID,
+		ClientSecret: *secret.Value,
+	}
+
+	api, err := NewProtectionAPI(conf.Client(ctx), "http://localhost:8080/", realm)
+	if err != nil {
+		t.Error(err)
+	}
+	return api
+}
+
+func TestProtectionAPI_CreateResource(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	clientID := createClient(t, k, realm, "client")
+
+	api := protectionAPI(t, k, realm, clientID)
+
+	ctx := context.Background()
+
+	resource := &ProtectedResource{
+		Name:           String("album"),
+		Type:           String("urn:client:resources:album"),
+		ResourceScopes: []*Scope{{Name: String("view")}},
+	}
+
+	resource, res, err := api.CreateResource(ctx, resource)
+	if err != nil {
+		t.Errorf("ProtectionAPI.CreateResource returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+
+	ids, _, err := api.ListResources(ctx, &ProtectedResourceListOptions{Name: "album"})
+	if err != nil {
+		t.Errorf("ProtectionAPI.ListResources returned error: %v", err)
+	}
+
+	if len(ids) != 1 || ids[0] != *resource.ID {
+		t.Errorf("got: %v, want: %s", ids, *resource.ID)
+	}
+
+	res, err = api.DeleteResource(ctx, *resource.ID)
+	if err != nil {
+		t.Errorf("ProtectionAPI.DeleteResource returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestProtectionAPI_CreatePermissionTicket(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	clientID := createClient(t, k, realm, "client")
+
+	api := protectionAPI(t, k, realm, clientID)
+
+	ctx := context.Background()
+
+	resource := &ProtectedResource{
+		Name:           String("album"),
+		ResourceScopes: []*Scope{{Name: String("view")}},
+	}
+
+	resource, _, err := api.CreateResource(ctx, resource)
+	if err != nil {
+		t.Errorf("ProtectionAPI.CreateResource returned error: %v", err)
+	}
+
+	requests := []*PermissionRequest{{
+		ResourceID:     resource.ID,
+		ResourceScopes: []string{"view"},
+	}}
+
+	ticket, res, err := api.CreatePermissionTicket(ctx, requests)
+	if err != nil {
+		t.Errorf("ProtectionAPI.CreatePermissionTicket returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+
+	if ticket == "" {
+		t.Errorf("got no ticket, want one")
+	}
+}
+
+func TestProtectionAPI_MissingID(t *testing.T) {
+	api, err := NewProtectionAPI(nil, "http://localhost:8080/", "first")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	if _, err := api.UpdateResource(ctx, &ProtectedResource{Name: String("resource")}); !errors.Is(err, ErrMissingID) {
+		t.Errorf("got: %v, want: %v", err, ErrMissingID)
+	}
+	if _, err := api.UpdateUMAPolicy(ctx, &UMAPolicy{}); !errors.Is(err, ErrMissingID) {
+		t.Errorf("got: %v, want: %v", err, ErrMissingID)
+	}
+}
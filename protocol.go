@@ -0,0 +1,10 @@
+package keycloak
+
+// Protocols used by clients, client scopes and protocol mappers.
+const (
+	// ProtocolOpenIDConnect is the default protocol of clients.
+	ProtocolOpenIDConnect = "openid-connect"
+
+	// ProtocolSAML is used by SAML 2.0 service providers.
+	ProtocolSAML = "saml"
+)
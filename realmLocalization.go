@@ -0,0 +1,117 @@
+package keycloak
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// RealmLocalizationService handles communication with the localization
+// related methods of the Keycloak API. Texts set on the realm override the
+// messages of the theme.
+type RealmLocalizationService service
+
+// ListLocales lists the locales the realm has texts for.
+func (s *RealmLocalizationService) ListLocales(ctx context.Context, realm string) ([]string, *Response, error) {
+	u := pathf("admin/realms/%s/localization", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var locales []string
+	res, err := s.keycloak.Do(ctx, req, &locales)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return locales, res, nil
+}
+
+// GetTexts returns the texts of the realm for the locale keyed by message key.
+func (s *RealmLocalizationService) GetTexts(ctx context.Context, realm, locale string) (map[string]string, *Response, error) {
+	u := pathf("admin/realms/%s/localization/%s", realm, locale)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var texts map[string]string
+	res, err := s.keycloak.Do(ctx, req, &texts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return texts, res, nil
+}
+
+// ImportTexts adds the texts for the locale to the realm. Existing texts
+// with the same keys are overwritten.
+func (s *RealmLocalizationService) ImportTexts(ctx context.Context, realm, locale string, texts map[string]string) (*Response, error) {
+	u := pathf("admin/realms/%s/localization/%s", realm, locale)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, texts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// DeleteTexts deletes all texts of the realm for the locale.
+func (s *RealmLocalizationService) DeleteTexts(ctx context.Context, realm, locale string) (*Response, error) {
+	u := pathf("admin/realms/%s/localization/%s", realm, locale)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// GetText returns a single text of the realm.
+func (s *RealmLocalizationService) GetText(ctx context.Context, realm, locale, key string) (string, *Response, error) {
+	u := pathf("admin/realms/%s/localization/%s/%s", realm, locale, key)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var text strings.Builder
+	res, err := s.keycloak.Do(ctx, req, &text)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return text.String(), res, nil
+}
+
+// SetText creates or updates a single text of the realm.
+func (s *RealmLocalizationService) SetText(ctx context.Context, realm, locale, key, text string) (*Response, error) {
+	u := pathf("admin/realms/%s/localization/%s/%s", realm, locale, key)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the endpoint only accepts the text as plain text
+	req.ContentLength = int64(len(text))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(text)), nil
+	}
+	req.Body, _ = req.GetBody()
+	req.Header.Set("Content-Type", "text/plain")
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// DeleteText deletes a single text of the realm.
+func (s *RealmLocalizationService) DeleteText(ctx context.Context, realm, locale, key string) (*Response, error) {
+	u := pathf("admin/realms/%s/localization/%s/%s", realm, locale, key)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
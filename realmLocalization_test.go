@@ -0,0 +1,100 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestRealmLocalizationService_ImportTexts(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+
+	ctx := context.Background()
+
+	res, err := k.RealmLocalization.ImportTexts(ctx, "first", "en", map[string]string{
+		"loginTitle": "Welcome",
+	})
+	if err != nil {
+		t.Errorf("RealmLocalization.ImportTexts returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	locales, _, err := k.RealmLocalization.ListLocales(ctx, "first")
+	if err != nil {
+		t.Errorf("RealmLocalization.ListLocales returned error: %v", err)
+	}
+
+	if len(locales) != 1 || locales[0] != "en" {
+		t.Errorf("got: %v, want: [en]", locales)
+	}
+
+	texts, _, err := k.RealmLocalization.GetTexts(ctx, "first", "en")
+	if err != nil {
+		t.Errorf("RealmLocalization.GetTexts returned error: %v", err)
+	}
+
+	if texts["loginTitle"] != "Welcome" {
+		t.Errorf("got: %s, want: %s", texts["loginTitle"], "Welcome")
+	}
+}
+
+func TestRealmLocalizationService_SetText(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+
+	ctx := context.Background()
+
+	res, err := k.RealmLocalization.SetText(ctx, "first", "de", "loginTitle", "Willkommen")
+	if err != nil {
+		t.Errorf("RealmLocalization.SetText returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	text, _, err := k.RealmLocalization.GetText(ctx, "first", "de", "loginTitle")
+	if err != nil {
+		t.Errorf("RealmLocalization.GetText returned error: %v", err)
+	}
+
+	if text != "Willkommen" {
+		t.Errorf("got: %s, want: %s", text, "Willkommen")
+	}
+
+	res, err = k.RealmLocalization.DeleteText(ctx, "first", "de", "loginTitle")
+	if err != nil {
+		t.Errorf("RealmLocalization.DeleteText returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestRealmLocalizationService_DeleteTexts(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+
+	ctx := context.Background()
+
+	if _, err := k.RealmLocalization.SetText(ctx, "first", "de", "loginTitle", "Willkommen"); err != nil {
+		t.Errorf("RealmLocalization.SetText returned error: %v", err)
+	}
+
+	res, err := k.RealmLocalization.DeleteTexts(ctx, "first", "de")
+	if err != nil {
+		t.Errorf("RealmLocalization.DeleteTexts returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
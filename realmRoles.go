@@ -12,17 +12,49 @@ type Role struct {
 	Name        *string              `json:"name,omitempty"`
 	Description *string              `json:"description,omitempty"`
 	Composite   *bool                `json:"composite,omitempty"`
+	Composites  *RoleComposites      `json:"composites,omitempty"`
 	ClientRole  *bool                `json:"clientRole,omitempty"`
 	ContainerID *string              `json:"containerId,omitempty"`
 	Attributes  *map[string][]string `json:"attributes,omitempty"`
 }
 
+// RoleComposites lists the names of the roles a composite role consists of.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/RoleRepresentation.java
+type RoleComposites struct {
+	Realm  []string             `json:"realm,omitempty"`
+	Client *map[string][]string `json:"client,omitempty"`
+}
+
+// RoleMappings holds all realm and client roles mapped to a user or group.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/MappingsRepresentation.java
+type RoleMappings struct {
+	RealmMappings  []*Role                        `json:"realmMappings,omitempty"`
+	ClientMappings map[string]*ClientRoleMappings `json:"clientMappings,omitempty"`
+}
+
+// ClientRoleMappings holds the roles of a single client mapped to a user or group.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/ClientMappingsRepresentation.java
+type ClientRoleMappings struct {
+	ID       *string `json:"id,omitempty"`
+	Client   *string `json:"client,omitempty"`
+	Mappings []*Role `json:"mappings,omitempty"`
+}
+
+// RoleGroupsListOptions specifies the optional parameters for listing the groups holding a role.
+type RoleGroupsListOptions struct {
+	BriefRepresentation *bool `url:"briefRepresentation,omitempty"`
+	Options
+}
+
 // RealmRolesService ...
 type RealmRolesService service
 
 // Create a new role.
-func (s *RealmRolesService) Create(ctx context.Context, realm string, role *Role) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/roles", realm)
+func (s *RealmRolesService) Create(ctx context.Context, realm string, role *Role) (*Response, error) {
+	u := pathf("admin/realms/%s/roles", realm)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, role)
 	if err != nil {
 		return nil, err
@@ -39,8 +71,8 @@ type RolesListOptions struct {
 }
 
 // List roles.
-func (s *RealmRolesService) List(ctx context.Context, realm string, opts *RolesListOptions) ([]*Role, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/roles", realm)
+func (s *RealmRolesService) List(ctx context.Context, realm string, opts *RolesListOptions) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/roles", realm)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
@@ -61,8 +93,8 @@ func (s *RealmRolesService) List(ctx context.Context, realm string, opts *RolesL
 }
 
 // GetByName gets role by name.
-func (s *RealmRolesService) GetByName(ctx context.Context, realm, name string) (*Role, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/roles/%s", realm, name)
+func (s *RealmRolesService) GetByName(ctx context.Context, realm, name string) (*Role, *Response, error) {
+	u := pathf("admin/realms/%s/roles/%s", realm, name)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -78,8 +110,8 @@ func (s *RealmRolesService) GetByName(ctx context.Context, realm, name string) (
 }
 
 // GetByID gets role by id.
-func (s *RealmRolesService) GetByID(ctx context.Context, realm, roleID string) (*Role, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/roles-by-id/%s", realm, roleID)
+func (s *RealmRolesService) GetByID(ctx context.Context, realm, roleID string) (*Role, *Response, error) {
+	u := pathf("admin/realms/%s/roles-by-id/%s", realm, roleID)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -94,6 +126,219 @@ func (s *RealmRolesService) GetByID(ctx context.Context, realm, roleID string) (
 	return &role, res, nil
 }
 
-// delete role
+// Update updates the role with the given name.
+func (s *RealmRolesService) Update(ctx context.Context, realm, name string, role *Role) (*Response, error) {
+	u := pathf("admin/realms/%s/roles/%s", realm, name)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// Delete deletes the role with the given name.
+func (s *RealmRolesService) Delete(ctx context.Context, realm, name string) (*Response, error) {
+	u := pathf("admin/realms/%s/roles/%s", realm, name)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// AddComposites adds roles to the composite of the role.
+func (s *RealmRolesService) AddComposites(ctx context.Context, realm, name string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/roles/%s/composites", realm, name)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListComposites lists the roles the composite role consists of.
+func (s *RealmRolesService) ListComposites(ctx context.Context, realm, name string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/roles/%s/composites", realm, name)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// RemoveComposites removes roles from the composite of the role.
+func (s *RealmRolesService) RemoveComposites(ctx context.Context, realm, name string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/roles/%s/composites", realm, name)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// GetUsers returns the users that have the role.
+func (s *RealmRolesService) GetUsers(ctx context.Context, realm, name string, opts *Options) ([]*User, *Response, error) {
+	u := pathf("admin/realms/%s/roles/%s/users", realm, name)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*User
+	res, err := s.keycloak.Do(ctx, req, &users)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return users, res, nil
+}
+
+// GetGroups returns the groups that have the role.
+func (s *RealmRolesService) GetGroups(ctx context.Context, realm, name string, opts *RoleGroupsListOptions) ([]*Group, *Response, error) {
+	u := pathf("admin/realms/%s/roles/%s/groups", realm, name)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groups []*Group
+	res, err := s.keycloak.Do(ctx, req, &groups)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return groups, res, nil
+}
+
+// ListUsersWithRole lists all users that have the role by requesting one
+// page after another until the result set is exhausted. opts.First is the
+// offset to start at and opts.Max the page size, opts may be nil. Users
+// holding the role through a group or composite role are not included.
+func (s *RealmRolesService) ListUsersWithRole(ctx context.Context, realm, name string, opts *Options) ([]*User, error) {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+
+	var users []*User
+	err := Paginate(ctx, o, func(ctx context.Context, page Options) (int, error) {
+		next, _, err := s.GetUsers(ctx, realm, name, &page)
+		if err != nil {
+			return 0, err
+		}
+		users = append(users, next...)
+		return len(next), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// ListGroupsWithRole lists all groups that have the role by requesting one
+// page after another until the result set is exhausted. opts may be nil.
+func (s *RealmRolesService) ListGroupsWithRole(ctx context.Context, realm, name string, opts *RoleGroupsListOptions) ([]*Group, error) {
+	var o RoleGroupsListOptions
+	if opts != nil {
+		o = *opts
+	}
 
-// delete client role
+	var groups []*Group
+	err := Paginate(ctx, o.Options, func(ctx context.Context, page Options) (int, error) {
+		o.Options = page
+		next, _, err := s.GetGroups(ctx, realm, name, &o)
+		if err != nil {
+			return 0, err
+		}
+		groups = append(groups, next...)
+		return len(next), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// GetManagementPermissions returns whether fine-grained admin permissions are
+// enabled for the realm role.
+func (s *RealmRolesService) GetManagementPermissions(ctx context.Context, realm, name string) (*ManagementPermissionReference, *Response, error) {
+	u := pathf("admin/realms/%s/roles/%s/management/permissions", realm, name)
+	return getManagementPermissions(ctx, s.keycloak, u)
+}
+
+// SetManagementPermissions enables or disables fine-grained admin permissions
+// for the realm role.
+func (s *RealmRolesService) SetManagementPermissions(ctx context.Context, realm, name string, ref *ManagementPermissionReference) (*ManagementPermissionReference, *Response, error) {
+	u := pathf("admin/realms/%s/roles/%s/management/permissions", realm, name)
+	return setManagementPermissions(ctx, s.keycloak, u, ref)
+}
+
+// defaultRole returns the name of the composite role every user of the realm
+// is granted, usually default-roles-{realm}.
+func (s *RealmRolesService) defaultRole(ctx context.Context, realm string) (string, error) {
+	r, _, err := s.keycloak.Realms.Get(ctx, realm)
+	if err != nil {
+		return "", err
+	}
+
+	if r.DefaultRole == nil || r.DefaultRole.Name == nil {
+		return "", fmt.Errorf("keycloak: realm %q has no default role", realm)
+	}
+
+	return *r.DefaultRole.Name, nil
+}
+
+// ListDefaultRoles lists the roles granted to every user of the realm.
+func (s *RealmRolesService) ListDefaultRoles(ctx context.Context, realm string) ([]*Role, *Response, error) {
+	name, err := s.defaultRole(ctx, realm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.ListComposites(ctx, realm, name)
+}
+
+// AddDefaultRoles adds realm or client roles to the roles granted to every
+// user of the realm.
+func (s *RealmRolesService) AddDefaultRoles(ctx context.Context, realm string, roles []*Role) (*Response, error) {
+	name, err := s.defaultRole(ctx, realm)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.AddComposites(ctx, realm, name, roles)
+}
+
+// RemoveDefaultRoles removes realm or client roles from the roles granted to
+// every user of the realm.
+func (s *RealmRolesService) RemoveDefaultRoles(ctx context.Context, realm string, roles []*Role) (*Response, error) {
+	name, err := s.defaultRole(ctx, realm)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.RemoveComposites(ctx, realm, name, roles)
+}
@@ -2,7 +2,10 @@ package keycloak
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
 )
 
@@ -117,3 +120,242 @@ func TestRealmRolesService_GetByID(t *testing.T) {
 		t.Errorf("got: %s, want: %s", *role.Name, "first")
 	}
 }
+
+func TestRealmRolesService_Update(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	createRealmRole(t, k, realm, "role")
+
+	ctx := context.Background()
+
+	res, err := k.RealmRoles.Update(ctx, realm, "role", &Role{
+		Name:        String("role"),
+		Description: String("new description"),
+	})
+	if err != nil {
+		t.Errorf("RealmRoles.Update returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	role, _, err := k.RealmRoles.GetByName(ctx, realm, "role")
+	if err != nil {
+		t.Errorf("RealmRoles.GetByName returned error: %v", err)
+	}
+
+	if *role.Description != "new description" {
+		t.Errorf("got: %s, want: %s", *role.Description, "new description")
+	}
+}
+
+func TestRealmRolesService_Delete(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	createRealmRole(t, k, realm, "role")
+
+	res, err := k.RealmRoles.Delete(context.Background(), realm, "role")
+	if err != nil {
+		t.Errorf("RealmRoles.Delete returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestRealmRolesService_Composites(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	createRealmRole(t, k, realm, "composite")
+	createRealmRole(t, k, realm, "child")
+
+	ctx := context.Background()
+
+	child, _, err := k.RealmRoles.GetByName(ctx, realm, "child")
+	if err != nil {
+		t.Errorf("RealmRoles.GetByName returned error: %v", err)
+	}
+
+	res, err := k.RealmRoles.AddComposites(ctx, realm, "composite", []*Role{child})
+	if err != nil {
+		t.Errorf("RealmRoles.AddComposites returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	roles, _, err := k.RealmRoles.ListComposites(ctx, realm, "composite")
+	if err != nil {
+		t.Errorf("RealmRoles.ListComposites returned error: %v", err)
+	}
+
+	if len(roles) != 1 || *roles[0].Name != "child" {
+		t.Errorf("got: %v, want: [child]", roles)
+	}
+
+	res, err = k.RealmRoles.RemoveComposites(ctx, realm, "composite", []*Role{child})
+	if err != nil {
+		t.Errorf("RealmRoles.RemoveComposites returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestRealmRolesService_GetUsers(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	createRealmRole(t, k, realm, "role")
+	userID := createUser(t, k, realm, "user")
+
+	ctx := context.Background()
+
+	role, _, err := k.RealmRoles.GetByName(ctx, realm, "role")
+	if err != nil {
+		t.Errorf("RealmRoles.GetByName returned error: %v", err)
+	}
+
+	if _, err := k.Users.AddRealmRoles(ctx, realm, userID, []*Role{role}); err != nil {
+		t.Errorf("Users.AddRealmRoles returned error: %v", err)
+	}
+
+	users, res, err := k.RealmRoles.GetUsers(ctx, realm, "role", nil)
+	if err != nil {
+		t.Errorf("RealmRoles.GetUsers returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(users) != 1 {
+		t.Errorf("got: %d, want: %d", len(users), 1)
+	}
+}
+
+func TestRealmRolesService_GetGroups(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	createRealmRole(t, k, realm, "role")
+	groupID := createGroup(t, k, realm, "group")
+
+	ctx := context.Background()
+
+	role, _, err := k.RealmRoles.GetByName(ctx, realm, "role")
+	if err != nil {
+		t.Errorf("RealmRoles.GetByName returned error: %v", err)
+	}
+
+	if _, err := k.Groups.AddRealmRoles(ctx, realm, groupID, []*Role{role}); err != nil {
+		t.Errorf("Groups.AddRealmRoles returned error: %v", err)
+	}
+
+	groups, res, err := k.RealmRoles.GetGroups(ctx, realm, "role", nil)
+	if err != nil {
+		t.Errorf("RealmRoles.GetGroups returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(groups) != 1 {
+		t.Errorf("got: %d, want: %d", len(groups), 1)
+	}
+}
+
+func TestRealmRolesService_ListUsersWithRole(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/realms/first/roles/admin/users" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+		first, _ := strconv.Atoi(r.URL.Query().Get("first"))
+		max, _ := strconv.Atoi(r.URL.Query().Get("max"))
+		var users []*User
+		for i := first; i < first+max && i < 150; i++ {
+			users = append(users, &User{Username: String(strconv.Itoa(i))})
+		}
+		json.NewEncoder(w).Encode(users)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	users, err := k.RealmRoles.ListUsersWithRole(context.Background(), "first", "admin", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(users) != 150 {
+		t.Errorf("got: %d, want: %d", len(users), 150)
+	}
+
+	if got := users[149].GetUsername(); got != "149" {
+		t.Errorf("got: %s, want: %s", got, "149")
+	}
+}
+
+func TestRealmRolesService_AddDefaultRoles(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	createRealmRole(t, k, realm, "role")
+
+	ctx := context.Background()
+
+	role, _, err := k.RealmRoles.GetByName(ctx, realm, "role")
+	if err != nil {
+		t.Errorf("RealmRoles.GetByName returned error: %v", err)
+	}
+
+	res, err := k.RealmRoles.AddDefaultRoles(ctx, realm, []*Role{role})
+	if err != nil {
+		t.Errorf("RealmRoles.AddDefaultRoles returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	roles, _, err := k.RealmRoles.ListDefaultRoles(ctx, realm)
+	if err != nil {
+		t.Errorf("RealmRoles.ListDefaultRoles returned error: %v", err)
+	}
+
+	found := false
+	for _, r := range roles {
+		if *r.Name == "role" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("got: %v, want role among default roles", roles)
+	}
+
+	res, err = k.RealmRoles.RemoveDefaultRoles(ctx, realm, []*Role{role})
+	if err != nil {
+		t.Errorf("RealmRoles.RemoveDefaultRoles returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
@@ -0,0 +1,111 @@
+// Package realmfile reads and writes Keycloak representations, e.g. realm
+// exports, users or clients, as JSON or YAML so they can be stored and
+// reviewed in git.
+//
+//	var realm keycloak.Realm
+//	if err := realmfile.ReadFile("realm.json", &realm); err != nil {
+//		return err
+//	}
+//	if err := realmfile.WriteFile("realm.yaml", &realm); err != nil {
+//		return err
+//	}
+//
+// Output is stable: fields are written in the order of the representation
+// structs and map keys are sorted, so exports of the same state produce the
+// same file and diffs only show actual changes.
+//
+// YAML is converted to and from JSON with gopkg.in/yaml.v3, so the json
+// tags of the representations apply. Only the first document of a YAML
+// file is read.
+package realmfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Formats of a file.
+const (
+	JSON = "json"
+	YAML = "yaml"
+)
+
+// Format returns the format of the file at path by its extension, YAML for
+// ".yaml" and ".yml" and JSON otherwise.
+func Format(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return YAML
+	}
+	return JSON
+}
+
+// Marshal returns the encoding of v in format.
+func Marshal(v interface{}, format string) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	switch format {
+	case JSON:
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(v); err != nil {
+			return nil, fmt.Errorf("realmfile: %w", err)
+		}
+		return buf.Bytes(), nil
+	case YAML:
+		if err := enc.Encode(v); err != nil {
+			return nil, fmt.Errorf("realmfile: %w", err)
+		}
+		b, err := jsonToYAML(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("realmfile: %w", err)
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("realmfile: unknown format %q", format)
+}
+
+// Unmarshal decodes data in format into v.
+func Unmarshal(data []byte, v interface{}, format string) error {
+	switch format {
+	case JSON:
+	case YAML:
+		var err error
+		if data, err = yamlToJSON(data); err != nil {
+			return fmt.Errorf("realmfile: %w", err)
+		}
+	default:
+		return fmt.Errorf("realmfile: unknown format %q", format)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("realmfile: %w", err)
+	}
+	return nil
+}
+
+// ReadFile decodes the file at path into v in the format of its extension.
+func ReadFile(path string, v interface{}) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := Unmarshal(b, v, Format(path)); err != nil {
+		return fmt.Errorf("%w (%s)", err, path)
+	}
+	return nil
+}
+
+// WriteFile writes v to the file at path in the format of its extension.
+func WriteFile(path string, v interface{}) error {
+	b, err := Marshal(v, Format(path))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o644)
+}
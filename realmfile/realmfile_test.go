@@ -0,0 +1,188 @@
+package realmfile
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+func testRealm() *keycloak.Realm {
+	attributes := map[string][]string{"cost-center": {"0042"}, "tier": {"gold"}}
+	clientAttributes := map[string]string{"notes": "Line one\nline two\n"}
+	return &keycloak.Realm{
+		Realm:   keycloak.String("myrealm"),
+		Enabled: keycloak.Bool(true),
+		Roles: &keycloak.Roles{
+			Realm: []*keycloak.Role{
+				{Name: keycloak.String("admin"), Description: keycloak.String("Administrators: full access")},
+			},
+		},
+		Clients: []*keycloak.Client{
+			{
+				ClientID:     keycloak.String("app"),
+				RedirectUris: []string{"https://app.example.com/*"},
+				Attributes:   &clientAttributes,
+			},
+		},
+		Groups: []*keycloak.Group{
+			{Name: keycloak.String("team"), Attributes: &attributes},
+		},
+		Users: []*keycloak.User{
+			{Username: keycloak.String("john"), Email: keycloak.String("john@example.com"), FirstName: keycloak.String("yes")},
+			{Username: keycloak.String("true")},
+		},
+	}
+}
+
+func TestMarshal_YAML(t *testing.T) {
+	realm := testRealm()
+
+	b, err := Marshal(realm, YAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got keycloak.Realm
+	if err := Unmarshal(b, &got, YAML); err != nil {
+		t.Fatalf("%v\n%s", err, b)
+	}
+	if !reflect.DeepEqual(&got, realm) {
+		t.Errorf("round trip changed the realm:\n%s", b)
+	}
+
+	again, err := Marshal(&got, YAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(again) != string(b) {
+		t.Errorf("output is not stable:\n%s\n---\n%s", b, again)
+	}
+
+	for _, want := range []string{
+		"realm: myrealm\n",
+		"    - name: admin\n      description: 'Administrators: full access'\n",
+		"      notes: |\n        Line one\n        line two\n",
+		"      cost-center:\n        - \"0042\"\n",
+		"  - username: \"true\"\n",
+	} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("missing %q in:\n%s", want, b)
+		}
+	}
+}
+
+func TestUnmarshal_YAML(t *testing.T) {
+	data := `---
+# managed by git
+realm: myrealm
+enabled: true   # comment
+accessTokenLifespan: 300
+displayName: 'It''s # not a comment'
+users:
+- username: john
+  email: "john@example.com"
+  requiredActions: [UPDATE_PASSWORD, "VERIFY_EMAIL"]
+  attributes: {tier: [gold], empty: []}
+- username: jane
+  enabled: false
+clients:
+  - clientId: app
+    redirectUris:
+      - https://app.example.com/*
+    name: >
+      folded
+      text
+
+      paragraph
+    attributes:
+      script: |-
+        line one
+          indented
+`
+	var realm keycloak.Realm
+	if err := Unmarshal([]byte(data), &realm, YAML); err != nil {
+		t.Fatal(err)
+	}
+
+	if realm.GetRealm() != "myrealm" || !realm.GetEnabled() || realm.GetAccessTokenLifespan() != 300 {
+		t.Errorf("got: %s %t %d", realm.GetRealm(), realm.GetEnabled(), realm.GetAccessTokenLifespan())
+	}
+	if realm.GetDisplayName() != "It's # not a comment" {
+		t.Errorf("got: %q", realm.GetDisplayName())
+	}
+	if len(realm.Users) != 2 {
+		t.Fatalf("got: %d, want: %d", len(realm.Users), 2)
+	}
+	john := realm.Users[0]
+	if john.GetEmail() != "john@example.com" {
+		t.Errorf("got: %s", john.GetEmail())
+	}
+	if want := []string{"UPDATE_PASSWORD", "VERIFY_EMAIL"}; !reflect.DeepEqual(john.RequiredActions, want) {
+		t.Errorf("got: %v, want: %v", john.RequiredActions, want)
+	}
+	if want := map[string][]string{"tier": {"gold"}, "empty": {}}; !reflect.DeepEqual(john.GetAttributes(), want) {
+		t.Errorf("got: %v, want: %v", john.GetAttributes(), want)
+	}
+	if realm.Users[1].GetEnabled() {
+		t.Error("expected jane to be disabled")
+	}
+
+	client := realm.Clients[0]
+	if want := []string{"https://app.example.com/*"}; !reflect.DeepEqual(client.RedirectUris, want) {
+		t.Errorf("got: %v, want: %v", client.RedirectUris, want)
+	}
+	if want := "folded text\nparagraph\n"; client.GetName() != want {
+		t.Errorf("got: %q, want: %q", client.GetName(), want)
+	}
+	if want := "line one\n  indented"; client.GetAttributes()["script"] != want {
+		t.Errorf("got: %q, want: %q", client.GetAttributes()["script"], want)
+	}
+}
+
+func TestUnmarshal_YAMLErrors(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		{"realm: a\nrealm: b\n", "line 2: mapping key \"realm\" already defined"},
+		{"realm: a\n\tenabled: true\n", "line 2: found a tab character"},
+		{"realm: a\n  enabled: true\n", "line 2: mapping values are not allowed"},
+		{"realm: \"a\n", "found unexpected end of stream"},
+		{"users: [a]\n", "cannot unmarshal string"},
+	}
+	for _, tt := range tests {
+		var realm keycloak.Realm
+		err := Unmarshal([]byte(tt.data), &realm, YAML)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%q: got: %v, want: %s", tt.data, err, tt.want)
+		}
+	}
+}
+
+func TestFile(t *testing.T) {
+	if got := Format("realm.YML"); got != YAML {
+		t.Errorf("got: %s, want: %s", got, YAML)
+	}
+	if got := Format("realm-export.json"); got != JSON {
+		t.Errorf("got: %s, want: %s", got, JSON)
+	}
+
+	dir := t.TempDir()
+	realm := testRealm()
+	for _, name := range []string{"realm.json", "realm.yaml"} {
+		path := filepath.Join(dir, name)
+		if err := WriteFile(path, realm); err != nil {
+			t.Fatal(err)
+		}
+		var got keycloak.Realm
+		if err := ReadFile(path, &got); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(&got, realm) {
+			t.Errorf("%s: round trip changed the realm", name)
+		}
+	}
+}
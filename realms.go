@@ -2,7 +2,6 @@ package keycloak
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 )
 
@@ -47,8 +46,11 @@ type Realm struct {
 	AccessCodeLifespanLogin                                   *int                      `json:"accessCodeLifespanLogin,omitempty"`
 	ActionTokenGeneratedByAdminLifespan                       *int                      `json:"actionTokenGeneratedByAdminLifespan,omitempty"`
 	ActionTokenGeneratedByUserLifespan                        *int                      `json:"actionTokenGeneratedByUserLifespan,omitempty"`
+	OAuth2DeviceCodeLifespan                                  *int                      `json:"oauth2DeviceCodeLifespan,omitempty"`
+	OAuth2DevicePollingInterval                               *int                      `json:"oauth2DevicePollingInterval,omitempty"`
 	Enabled                                                   *bool                     `json:"enabled,omitempty"`
 	SslRequired                                               *string                   `json:"sslRequired,omitempty"`
+	DefaultSignatureAlgorithm                                 *string                   `json:"defaultSignatureAlgorithm,omitempty"`
 	RegistrationAllowed                                       *bool                     `json:"registrationAllowed,omitempty"`
 	RegistrationEmailAsUsername                               *bool                     `json:"registrationEmailAsUsername,omitempty"`
 	RememberMe                                                *bool                     `json:"rememberMe,omitempty"`
@@ -65,14 +67,18 @@ type Realm struct {
 	QuickLoginCheckMilliSeconds                               *int                      `json:"quickLoginCheckMilliSeconds,omitempty"`
 	MaxDeltaTimeSeconds                                       *int                      `json:"maxDeltaTimeSeconds,omitempty"`
 	FailureFactor                                             *int                      `json:"failureFactor,omitempty"`
+	MaxTemporaryLockouts                                      *int                      `json:"maxTemporaryLockouts,omitempty"`
+	BruteForceStrategy                                        *string                   `json:"bruteForceStrategy,omitempty"`
 	DefaultRoles                                              []string                  `json:"defaultRoles,omitempty"`
 	RequiredCredentials                                       []string                  `json:"requiredCredentials,omitempty"`
+	PasswordPolicy                                            *string                   `json:"passwordPolicy,omitempty"`
 	OtpPolicyType                                             *string                   `json:"otpPolicyType,omitempty"`
 	OtpPolicyAlgorithm                                        *string                   `json:"otpPolicyAlgorithm,omitempty"`
 	OtpPolicyInitialCounter                                   *int                      `json:"otpPolicyInitialCounter,omitempty"`
 	OtpPolicyDigits                                           *int                      `json:"otpPolicyDigits,omitempty"`
 	OtpPolicyLookAheadWindow                                  *int                      `json:"otpPolicyLookAheadWindow,omitempty"`
 	OtpPolicyPeriod                                           *int                      `json:"otpPolicyPeriod,omitempty"`
+	OtpPolicyCodeReusable                                     *bool                     `json:"otpPolicyCodeReusable,omitempty"`
 	OtpSupportedApplications                                  []string                  `json:"otpSupportedApplications,omitempty"`
 	WebAuthnPolicyRpEntityName                                *string                   `json:"webAuthnPolicyRpEntityName,omitempty"`
 	WebAuthnPolicySignatureAlgorithms                         []string                  `json:"webAuthnPolicySignatureAlgorithms,omitempty"`
@@ -84,6 +90,7 @@ type Realm struct {
 	WebAuthnPolicyCreateTimeout                               *int                      `json:"webAuthnPolicyCreateTimeout,omitempty"`
 	WebAuthnPolicyAvoidSameAuthenticatorRegister              *bool                     `json:"webAuthnPolicyAvoidSameAuthenticatorRegister,omitempty"`
 	WebAuthnPolicyAcceptableAaguids                           []string                  `json:"webAuthnPolicyAcceptableAaguids,omitempty"`
+	WebAuthnPolicyExtraOrigins                                []string                  `json:"webAuthnPolicyExtraOrigins,omitempty"`
 	WebAuthnPolicyPasswordlessRpEntityName                    *string                   `json:"webAuthnPolicyPasswordlessRpEntityName,omitempty"`
 	WebAuthnPolicyPasswordlessSignatureAlgorithms             []string                  `json:"webAuthnPolicyPasswordlessSignatureAlgorithms,omitempty"`
 	WebAuthnPolicyPasswordlessRpID                            *string                   `json:"webAuthnPolicyPasswordlessRpId,omitempty"`
@@ -94,10 +101,12 @@ type Realm struct {
 	WebAuthnPolicyPasswordlessCreateTimeout                   *int                      `json:"webAuthnPolicyPasswordlessCreateTimeout,omitempty"`
 	WebAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister  *bool                     `json:"webAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister,omitempty"`
 	WebAuthnPolicyPasswordlessAcceptableAaguids               []string                  `json:"webAuthnPolicyPasswordlessAcceptableAaguids,omitempty"`
+	WebAuthnPolicyPasswordlessExtraOrigins                    []string                  `json:"webAuthnPolicyPasswordlessExtraOrigins,omitempty"`
 	BrowserSecurityHeaders                                    *map[string]string        `json:"browserSecurityHeaders,omitempty"`
 	SMTPServer                                                *map[string]string        `json:"smtpServer,omitempty"`
 	EventsEnabled                                             *bool                     `json:"eventsEnabled,omitempty"`
 	EventsListeners                                           []string                  `json:"eventsListeners,omitempty"`
+	EventsExpiration                                          *int64                    `json:"eventsExpiration,omitempty"`
 	EnabledEventTypes                                         []string                  `json:"enabledEventTypes,omitempty"`
 	AdminEventsEnabled                                        *bool                     `json:"adminEventsEnabled,omitempty"`
 	AdminEventsDetailsEnabled                                 *bool                     `json:"adminEventsDetailsEnabled,omitempty"`
@@ -105,14 +114,48 @@ type Realm struct {
 	IdentityProviderMappers                                   []*IdentityProviderMapper `json:"identityProviderMappers,omitempty"`
 	InternationalizationEnabled                               *bool                     `json:"internationalizationEnabled,omitempty"`
 	SupportedLocales                                          []string                  `json:"supportedLocales,omitempty"`
+	DefaultLocale                                             *string                   `json:"defaultLocale,omitempty"`
+	LoginTheme                                                *string                   `json:"loginTheme,omitempty"`
+	AccountTheme                                              *string                   `json:"accountTheme,omitempty"`
+	AdminTheme                                                *string                   `json:"adminTheme,omitempty"`
+	EmailTheme                                                *string                   `json:"emailTheme,omitempty"`
 	BrowserFlow                                               *string                   `json:"browserFlow,omitempty"`
 	RegistrationFlow                                          *string                   `json:"registrationFlow,omitempty"`
 	DirectGrantFlow                                           *string                   `json:"directGrantFlow,omitempty"`
 	ResetCredentialsFlow                                      *string                   `json:"resetCredentialsFlow,omitempty"`
 	ClientAuthenticationFlow                                  *string                   `json:"clientAuthenticationFlow,omitempty"`
 	DockerAuthenticationFlow                                  *string                   `json:"dockerAuthenticationFlow,omitempty"`
+	FirstBrokerLoginFlow                                      *string                   `json:"firstBrokerLoginFlow,omitempty"`
 	Attributes                                                *map[string]string        `json:"attributes,omitempty"`
 	UserManagedAccessAllowed                                  *bool                     `json:"userManagedAccessAllowed,omitempty"`
+	DefaultRole                                               *Role                     `json:"defaultRole,omitempty"`
+	DefaultDefaultClientScopes                                []string                  `json:"defaultDefaultClientScopes,omitempty"`
+	DefaultOptionalClientScopes                               []string                  `json:"defaultOptionalClientScopes,omitempty"`
+	DefaultGroups                                             []string                  `json:"defaultGroups,omitempty"`
+	Users                                                     []*User                   `json:"users,omitempty"`
+	Clients                                                   []*Client                 `json:"clients,omitempty"`
+	ClientScopes                                              []*ClientScope            `json:"clientScopes,omitempty"`
+	Groups                                                    []*Group                  `json:"groups,omitempty"`
+	Roles                                                     *Roles                    `json:"roles,omitempty"`
+	Components                                                map[string][]*Component   `json:"components,omitempty"`
+	AuthenticationFlows                                       []*AuthenticationFlow     `json:"authenticationFlows,omitempty"`
+	AuthenticatorConfig                                       []*AuthenticatorConfig    `json:"authenticatorConfig,omitempty"`
+	RequiredActions                                           []*RequiredAction         `json:"requiredActions,omitempty"`
+	KeycloakVersion                                           *string                   `json:"keycloakVersion,omitempty"`
+}
+
+// Roles lists the realm and client roles of an exported realm.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/RolesRepresentation.java
+type Roles struct {
+	Realm  []*Role            `json:"realm,omitempty"`
+	Client map[string][]*Role `json:"client,omitempty"`
+}
+
+// PartialExportOptions specifies the optional parameters to the RealmsService.PartialExport method.
+type PartialExportOptions struct {
+	ExportClients        bool `url:"exportClients"`
+	ExportGroupsAndRoles bool `url:"exportGroupsAndRoles"`
 }
 
 // Configuration represents a UMA configuration.
@@ -135,11 +178,29 @@ type Configuration struct {
 	PolicyEndpoint                             *string  `json:"policy_endpoint,omitempty"`
 }
 
+// GlobalRequestResult reports the outcome of a request Keycloak forwarded
+// to the admin URLs of all clients.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/adapters/action/GlobalRequestResult.java
+type GlobalRequestResult struct {
+	SuccessRequests []string `json:"successRequests,omitempty"`
+	FailedRequests  []string `json:"failedRequests,omitempty"`
+}
+
+// ClientSessionStats represents the number of active and offline sessions of a client.
+// Keycloak reports the counts as strings.
+type ClientSessionStats struct {
+	ID       *string `json:"id,omitempty"`
+	ClientID *string `json:"clientId,omitempty"`
+	Active   *string `json:"active,omitempty"`
+	Offline  *string `json:"offline,omitempty"`
+}
+
 // RealmsService ...
 type RealmsService service
 
 // Create a new realm.
-func (s *RealmsService) Create(ctx context.Context, realm *Realm) (*http.Response, error) {
+func (s *RealmsService) Create(ctx context.Context, realm *Realm) (*Response, error) {
 	req, err := s.keycloak.NewRequest(http.MethodPost, "admin/realms", realm)
 	if err != nil {
 		return nil, err
@@ -149,7 +210,7 @@ func (s *RealmsService) Create(ctx context.Context, realm *Realm) (*http.Respons
 }
 
 // List all realms.
-func (s *RealmsService) List(ctx context.Context) ([]*Realm, *http.Response, error) {
+func (s *RealmsService) List(ctx context.Context) ([]*Realm, *Response, error) {
 	req, err := s.keycloak.NewRequest(http.MethodGet, "admin/realms", nil)
 	if err != nil {
 		return nil, nil, err
@@ -165,8 +226,8 @@ func (s *RealmsService) List(ctx context.Context) ([]*Realm, *http.Response, err
 }
 
 // Get realm.
-func (s *RealmsService) Get(ctx context.Context, name string) (*Realm, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s", name)
+func (s *RealmsService) Get(ctx context.Context, name string) (*Realm, *Response, error) {
+	u := pathf("admin/realms/%s", name)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -181,9 +242,20 @@ func (s *RealmsService) Get(ctx context.Context, name string) (*Realm, *http.Res
 	return &realm, res, nil
 }
 
+// Update realm. Only the fields set in realm are changed.
+func (s *RealmsService) Update(ctx context.Context, name string, realm *Realm) (*Response, error) {
+	u := pathf("admin/realms/%s", name)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, realm)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
 // Delete realm.
-func (s *RealmsService) Delete(ctx context.Context, name string) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s", name)
+func (s *RealmsService) Delete(ctx context.Context, name string) (*Response, error) {
+	u := pathf("admin/realms/%s", name)
 	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
 	if err != nil {
 		return nil, err
@@ -192,9 +264,42 @@ func (s *RealmsService) Delete(ctx context.Context, name string) (*http.Response
 	return s.keycloak.Do(ctx, req, nil)
 }
 
+// ClearRealmCache clears the realm cache.
+func (s *RealmsService) ClearRealmCache(ctx context.Context, name string) (*Response, error) {
+	u := pathf("admin/realms/%s/clear-realm-cache", name)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ClearUserCache clears the user cache.
+func (s *RealmsService) ClearUserCache(ctx context.Context, name string) (*Response, error) {
+	u := pathf("admin/realms/%s/clear-user-cache", name)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ClearKeysCache clears the cache of external public keys, e.g. keys of identity providers or clients.
+func (s *RealmsService) ClearKeysCache(ctx context.Context, name string) (*Response, error) {
+	u := pathf("admin/realms/%s/clear-keys-cache", name)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
 // GetConfig gets realm configuration.
-func (s *RealmsService) GetConfig(ctx context.Context, name string) (*Configuration, *http.Response, error) {
-	u := fmt.Sprintf("realms/%s/.well-known/uma2-configuration", name)
+func (s *RealmsService) GetConfig(ctx context.Context, name string) (*Configuration, *Response, error) {
+	u := pathf("realms/%s/.well-known/uma2-configuration", name)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -208,3 +313,136 @@ func (s *RealmsService) GetConfig(ctx context.Context, name string) (*Configurat
 
 	return &config, res, nil
 }
+
+// LogoutAll removes all user sessions. Any client that has an admin url will
+// also be told to invalidate any sessions they have.
+func (s *RealmsService) LogoutAll(ctx context.Context, name string) (*GlobalRequestResult, *Response, error) {
+	u := pathf("admin/realms/%s/logout-all", name)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result GlobalRequestResult
+	res, err := s.keycloak.Do(ctx, req, &result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &result, res, nil
+}
+
+// PushRevocation pushes the realm's revocation policy to any client that has
+// an admin url associated with it.
+func (s *RealmsService) PushRevocation(ctx context.Context, name string) (*GlobalRequestResult, *Response, error) {
+	u := pathf("admin/realms/%s/push-revocation", name)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result GlobalRequestResult
+	res, err := s.keycloak.Do(ctx, req, &result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &result, res, nil
+}
+
+// GetClientSessionStats returns the number of active and offline sessions
+// for every client that has at least one session.
+func (s *RealmsService) GetClientSessionStats(ctx context.Context, name string) ([]*ClientSessionStats, *Response, error) {
+	u := pathf("admin/realms/%s/client-session-stats", name)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stats []*ClientSessionStats
+	res, err := s.keycloak.Do(ctx, req, &stats)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stats, res, nil
+}
+
+// PartialExport exports the realm including, optionally, its clients and its
+// groups and roles. Secrets are masked in the export.
+func (s *RealmsService) PartialExport(ctx context.Context, name string, exportClients, exportGroupsAndRoles bool) (*Realm, *Response, error) {
+	u := pathf("admin/realms/%s/partial-export", name)
+	u, err := addOptions(u, &PartialExportOptions{
+		ExportClients:        exportClients,
+		ExportGroupsAndRoles: exportGroupsAndRoles,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var realm Realm
+	res, err := s.keycloak.Do(ctx, req, &realm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &realm, res, nil
+}
+
+// ListDefaultGroups lists the groups new users are added to.
+func (s *RealmsService) ListDefaultGroups(ctx context.Context, name string) ([]*Group, *Response, error) {
+	u := pathf("admin/realms/%s/default-groups", name)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groups []*Group
+	res, err := s.keycloak.Do(ctx, req, &groups)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return groups, res, nil
+}
+
+// AddDefaultGroup adds the group to the groups new users are added to.
+func (s *RealmsService) AddDefaultGroup(ctx context.Context, name, groupID string) (*Response, error) {
+	u := pathf("admin/realms/%s/default-groups/%s", name, groupID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// RemoveDefaultGroup removes the group from the groups new users are added to.
+func (s *RealmsService) RemoveDefaultGroup(ctx context.Context, name, groupID string) (*Response, error) {
+	u := pathf("admin/realms/%s/default-groups/%s", name, groupID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// TestSMTPConnection sends a test email to the email address of the admin
+// user making the request, using settings in the same format as
+// Realm.SMTPServer, e.g. "host", "port", "from" and "auth". The admin user
+// needs an email address.
+func (s *RealmsService) TestSMTPConnection(ctx context.Context, name string, settings map[string]string) (*Response, error) {
+	u := pathf("admin/realms/%s/testSMTPConnection", name)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
@@ -2,7 +2,9 @@ package keycloak
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -106,6 +108,79 @@ func TestRealmsService_Get(t *testing.T) {
 	}
 }
 
+func TestRealmsService_Update(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+
+	ctx := context.Background()
+
+	res, err := k.Realms.Update(ctx, "first", &Realm{
+		DisplayName: String("First"),
+	})
+	if err != nil {
+		t.Errorf("Realms.Update returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	realm, _, err := k.Realms.Get(ctx, "first")
+	if err != nil {
+		t.Errorf("Realms.Get returned error: %v", err)
+	}
+
+	if *realm.DisplayName != "First" {
+		t.Errorf("got: %s, want: %s", *realm.DisplayName, "First")
+	}
+}
+
+func TestRealmsService_Update_Settings(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+	createGroup(t, k, "first", "users")
+
+	ctx := context.Background()
+	expiration := int64(3600)
+
+	_, err := k.Realms.Update(ctx, "first", &Realm{
+		LoginTheme:                  String("keycloak"),
+		EmailTheme:                  String("keycloak"),
+		InternationalizationEnabled: Bool(true),
+		SupportedLocales:            []string{"en", "de"},
+		DefaultLocale:               String("de"),
+		EventsExpiration:            &expiration,
+		DefaultSignatureAlgorithm:   String("ES256"),
+		DefaultGroups:               []string{"/users"},
+	})
+	if err != nil {
+		t.Errorf("Realms.Update returned error: %v", err)
+	}
+
+	realm, _, err := k.Realms.Get(ctx, "first")
+	if err != nil {
+		t.Errorf("Realms.Get returned error: %v", err)
+	}
+
+	if *realm.DefaultLocale != "de" {
+		t.Errorf("got: %s, want: %s", *realm.DefaultLocale, "de")
+	}
+
+	if *realm.EventsExpiration != expiration {
+		t.Errorf("got: %d, want: %d", *realm.EventsExpiration, expiration)
+	}
+
+	if *realm.DefaultSignatureAlgorithm != "ES256" {
+		t.Errorf("got: %s, want: %s", *realm.DefaultSignatureAlgorithm, "ES256")
+	}
+
+	if len(realm.DefaultGroups) != 1 || realm.DefaultGroups[0] != "/users" {
+		t.Errorf("got: %v, want: %v", realm.DefaultGroups, []string{"/users"})
+	}
+}
+
 func TestRealmsService_Delete(t *testing.T) {
 	k := client(t)
 
@@ -139,3 +214,209 @@ func TestRealmsService_GetConfig(t *testing.T) {
 		t.Errorf("got: %s, want: %s", *config.Issuer, "http://localhost:8080/realms/first")
 	}
 }
+
+func TestRealmsService_ClearCaches(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+
+	ctx := context.Background()
+
+	clear := map[string]func(context.Context, string) (*Response, error){
+		"ClearRealmCache": k.Realms.ClearRealmCache,
+		"ClearUserCache":  k.Realms.ClearUserCache,
+		"ClearKeysCache":  k.Realms.ClearKeysCache,
+	}
+
+	for name, fn := range clear {
+		res, err := fn(ctx, "first")
+		if err != nil {
+			t.Errorf("Realms.%s returned error: %v", name, err)
+		}
+
+		if res.StatusCode != http.StatusNoContent {
+			t.Errorf("Realms.%s got: %d, want: %d", name, res.StatusCode, http.StatusNoContent)
+		}
+	}
+}
+
+func TestRealmsService_LogoutAll(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+	userID := createSession(t, k, "first", "user")
+
+	ctx := context.Background()
+
+	result, res, err := k.Realms.LogoutAll(ctx, "first")
+	if err != nil {
+		t.Errorf("Realms.LogoutAll returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(result.FailedRequests) != 0 {
+		t.Errorf("got: %v, want no failed requests", result.FailedRequests)
+	}
+
+	sessions, _, err := k.Users.ListSessions(ctx, "first", userID)
+	if err != nil {
+		t.Errorf("Users.ListSessions returned error: %v", err)
+	}
+
+	if len(sessions) != 0 {
+		t.Errorf("got: %d, want: %d", len(sessions), 0)
+	}
+}
+
+func TestRealmsService_PushRevocation(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+
+	_, res, err := k.Realms.PushRevocation(context.Background(), "first")
+	if err != nil {
+		t.Errorf("Realms.PushRevocation returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+}
+
+func TestRealmsService_GetClientSessionStats(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+	createSession(t, k, "first", "user")
+
+	stats, res, err := k.Realms.GetClientSessionStats(context.Background(), "first")
+	if err != nil {
+		t.Errorf("Realms.GetClientSessionStats returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(stats) != 1 || *stats[0].ClientID != "admin-cli" {
+		t.Errorf("got: %v, want stats for admin-cli", stats)
+	}
+}
+
+func TestRealmsService_PartialExport(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+	createClient(t, k, "first", "client")
+	createRealmRole(t, k, "first", "role")
+
+	realm, res, err := k.Realms.PartialExport(context.Background(), "first", true, true)
+	if err != nil {
+		t.Errorf("Realms.PartialExport returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if *realm.Realm != "first" {
+		t.Errorf("got: %s, want: %s", *realm.Realm, "first")
+	}
+
+	found := false
+	for _, c := range realm.Clients {
+		if *c.ClientID == "client" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("client is missing from the export")
+	}
+
+	found = false
+	for _, r := range realm.Roles.Realm {
+		if *r.Name == "role" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("realm role is missing from the export")
+	}
+}
+
+func TestRealmsService_AddDefaultGroup(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+	groupID := createGroup(t, k, "first", "group")
+
+	ctx := context.Background()
+
+	res, err := k.Realms.AddDefaultGroup(ctx, "first", groupID)
+	if err != nil {
+		t.Errorf("Realms.AddDefaultGroup returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	groups, _, err := k.Realms.ListDefaultGroups(ctx, "first")
+	if err != nil {
+		t.Errorf("Realms.ListDefaultGroups returned error: %v", err)
+	}
+
+	if len(groups) != 1 || *groups[0].ID != groupID {
+		t.Errorf("got: %v, want group %s", groups, groupID)
+	}
+
+	res, err = k.Realms.RemoveDefaultGroup(ctx, "first", groupID)
+	if err != nil {
+		t.Errorf("Realms.RemoveDefaultGroup returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestRealmsService_TestSMTPConnection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/realms/first/testSMTPConnection" {
+			t.Errorf("got: %s, want: %s", r.URL.Path, "/admin/realms/first/testSMTPConnection")
+		}
+
+		var settings map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+			t.Fatal(err)
+		}
+
+		if settings["host"] != "smtp.example.com" {
+			t.Errorf("got: %s, want: %s", settings["host"], "smtp.example.com")
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := k.Realms.TestSMTPConnection(context.Background(), "first", map[string]string{
+		"host": "smtp.example.com",
+		"port": "25",
+		"from": "keycloak@example.com",
+	})
+	if err != nil {
+		t.Errorf("Realms.TestSMTPConnection returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
@@ -0,0 +1,104 @@
+package reconcile
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// object returns the JSON object of v.
+func object(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// drift returns the sorted names of the fields set in desired whose value
+// is not contained in live. Fields not set in desired are left alone.
+func drift(desired, live interface{}, ignore ...string) ([]string, error) {
+	d, err := object(desired)
+	if err != nil {
+		return nil, err
+	}
+	l, err := object(live)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range ignore {
+		delete(d, field)
+	}
+
+	var fields []string
+	for field, value := range d {
+		if !contained(value, l[field]) {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// contained reports whether desired is contained in live. Objects may have
+// additional keys in live, e.g. attributes with server defaults. Arrays must
+// have the same length and every desired element must be contained in an
+// element of live, in any order. Missing values in live equal zero values.
+func contained(desired, live interface{}) bool {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok && live != nil {
+			return false
+		}
+		for key, value := range d {
+			if !contained(value, l[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(d) != len(l) {
+			return len(d) == 0 && live == nil
+		}
+		for _, dv := range d {
+			found := false
+			for _, lv := range l {
+				if contained(dv, lv) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	default:
+		// Keycloak omits empty values
+		if live == nil {
+			return desired == nil || reflect.ValueOf(desired).IsZero()
+		}
+		return reflect.DeepEqual(desired, live)
+	}
+}
+
+// merge decodes live and then desired into out, so that out holds the live
+// representation with the fields set in desired applied.
+func merge(live, desired, out interface{}) error {
+	for _, v := range []interface{}{live, desired} {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(b, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package reconcile
+
+import (
+	"sort"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+// Kinds compared by Diff in addition to the reconciled ones.
+const (
+	KindProtocolMapper     = "protocol mapper"
+	KindClientScope        = "client scope"
+	KindAuthenticationFlow = "authentication flow"
+)
+
+// Diff returns the changes that turn realm a into realm b, e.g. to compare
+// the exports of two environments in CI. Resources are matched by their
+// natural key, so ids that differ between servers don't count as changes,
+// and neither do credentials. Protocol mappers of clients are named
+// "clientId/name" and authentication flows by their alias.
+//
+// The report is stable: changes are ordered by kind, starting with the
+// realm settings, and by name within a kind.
+func Diff(a, b *keycloak.Realm) (*Report, error) {
+	x, err := index(a)
+	if err != nil {
+		return nil, err
+	}
+	y, err := index(b)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	for _, kind := range kinds {
+		names := map[string]bool{}
+		for name := range x[kind] {
+			names[name] = true
+		}
+		for name := range y[kind] {
+			names[name] = true
+		}
+
+		for _, key := range sortedKeys(names) {
+			from, inA := x[kind][key]
+			to, inB := y[kind][key]
+
+			name := key
+			if kind == KindRealm {
+				name = b.GetRealm()
+				if !inB {
+					name = a.GetRealm()
+				}
+			}
+
+			switch {
+			case !inA:
+				report.Changes = append(report.Changes, Change{Action: ActionCreate, Kind: kind, Name: name})
+			case !inB:
+				report.Changes = append(report.Changes, Change{Action: ActionDelete, Kind: kind, Name: name})
+			default:
+				if fields := changed(from, to); len(fields) > 0 {
+					report.Changes = append(report.Changes, Change{Action: ActionUpdate, Kind: kind, Name: name, Fields: fields})
+				}
+			}
+		}
+	}
+	return report, nil
+}
+
+// kinds in the order of the report.
+var kinds = []string{
+	KindRealm, KindRole, KindClient, KindClientRole, KindProtocolMapper,
+	KindClientScope, KindGroup, KindUser, KindAuthenticationFlow,
+}
+
+// changed returns the sorted names of the fields that differ between a and b.
+func changed(a, b map[string]interface{}) []string {
+	var fields []string
+	for field, value := range a {
+		if !contained(value, b[field]) || !contained(b[field], value) {
+			fields = append(fields, field)
+		}
+	}
+	for field, value := range b {
+		if _, ok := a[field]; !ok && !contained(value, nil) {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// resources are JSON objects of a kind by name.
+type resources map[string]map[string]interface{}
+
+// index returns the resources of realm by kind, without the fields that
+// are not compared.
+func index(realm *keycloak.Realm) (map[string]resources, error) {
+	idx := map[string]resources{}
+	for _, kind := range kinds {
+		idx[kind] = resources{}
+	}
+	if realm == nil {
+		return idx, nil
+	}
+
+	add := func(kind, name string, v interface{}, ignore ...string) error {
+		obj, err := object(v)
+		if err != nil {
+			return err
+		}
+		for _, field := range ignore {
+			delete(obj, field)
+		}
+		idx[kind][name] = obj
+		return nil
+	}
+
+	// realms are compared by settings, the names may differ
+	if err := add(KindRealm, "", realm, nested...); err != nil {
+		return nil, err
+	}
+
+	if realm.Roles != nil {
+		for _, role := range realm.Roles.Realm {
+			if err := add(KindRole, role.GetName(), role, "id", "containerId"); err != nil {
+				return nil, err
+			}
+		}
+		for clientID, roles := range realm.Roles.Client {
+			for _, role := range roles {
+				if err := add(KindClientRole, clientID+"/"+role.GetName(), role, "id", "containerId"); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	for _, client := range realm.Clients {
+		if err := add(KindClient, client.GetClientID(), client, "id", "protocolMappers"); err != nil {
+			return nil, err
+		}
+		for _, mapper := range client.ProtocolMappers {
+			if err := add(KindProtocolMapper, client.GetClientID()+"/"+mapper.GetName(), mapper, "id"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, scope := range realm.ClientScopes {
+		obj, err := object(scope)
+		if err != nil {
+			return nil, err
+		}
+		// mapper ids differ between servers
+		if mappers, ok := obj["protocolMappers"].([]interface{}); ok {
+			for _, mapper := range mappers {
+				delete(mapper.(map[string]interface{}), "id")
+			}
+		}
+		if err := add(KindClientScope, scope.GetName(), obj, "id"); err != nil {
+			return nil, err
+		}
+	}
+
+	var groups func(parent string, gs []*keycloak.Group) error
+	groups = func(parent string, gs []*keycloak.Group) error {
+		for _, g := range gs {
+			path := parent + "/" + g.GetName()
+			if err := add(KindGroup, path, g, "id", "path", "parentId", "subGroups", "subGroupCount"); err != nil {
+				return err
+			}
+			if err := groups(path, g.SubGroups); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := groups("", realm.Groups); err != nil {
+		return nil, err
+	}
+
+	for _, user := range realm.Users {
+		if err := add(KindUser, user.GetUsername(), user, "id", "createdTimestamp", "credentials", "access"); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, flow := range realm.AuthenticationFlows {
+		if err := add(KindAuthenticationFlow, flow.GetAlias(), flow, "id"); err != nil {
+			return nil, err
+		}
+	}
+
+	return idx, nil
+}
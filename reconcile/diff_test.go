@@ -0,0 +1,52 @@
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+func TestDiff(t *testing.T) {
+	a := desiredRealm()
+	a.ID = keycloak.String("1")
+	a.Clients[0].ProtocolMappers = []*keycloak.ProtocolMapper{
+		{ID: keycloak.String("1"), Name: keycloak.String("email"), ProtocolMapper: keycloak.String("oidc-usermodel-property-mapper")},
+	}
+	a.AuthenticationFlows = []*keycloak.AuthenticationFlow{
+		{ID: keycloak.String("1"), Alias: keycloak.String("browser"), ProviderID: keycloak.String("basic-flow")},
+	}
+
+	b := desiredRealm()
+	b.ID = keycloak.String("2")
+	b.Realm = keycloak.String("second")
+	b.Roles.Realm = append(b.Roles.Realm, &keycloak.Role{Name: keycloak.String("auditor")})
+	b.Clients[0].RedirectUris = []string{"https://example.com/*"}
+	b.Clients[0].ProtocolMappers = []*keycloak.ProtocolMapper{
+		{ID: keycloak.String("2"), Name: keycloak.String("email"), ProtocolMapper: keycloak.String("oidc-usermodel-property-mapper")},
+	}
+	b.Groups[0].SubGroups = nil
+	b.Users[0].Email = keycloak.String("john@example.org")
+
+	report, err := Diff(a, b)
+	if err != nil {
+		t.Fatalf("Diff returned error: %v", err)
+	}
+
+	want := `~ realm second: realm
++ role auditor
+~ client app: redirectUris
+- group /org/team
+~ user john: email
+- authentication flow browser`
+	if report.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", report, want)
+	}
+
+	report, err = Diff(a, a)
+	if err != nil {
+		t.Fatalf("Diff returned error: %v", err)
+	}
+	if report.HasChanges() {
+		t.Errorf("got:\n%s\nwant no changes", report)
+	}
+}
@@ -0,0 +1,758 @@
+// Package reconcile converges a live realm to a desired state, like a
+// lightweight Terraform for Keycloak.
+//
+//	desired, err := reconcile.Load("realm.json")
+//	if err != nil {
+//		return err
+//	}
+//	report, err := reconcile.Realm(ctx, k, desired, &reconcile.Options{DryRun: true})
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Println(report)
+//
+// The desired state is a realm representation as exported by Keycloak,
+// stored as JSON or YAML. The realm settings, realm roles, clients, client
+// roles, groups and users are reconciled: missing resources are created and
+// drifted ones updated. Only the fields set in the desired state are
+// compared, so a minimal file only manages what it mentions. Resources
+// missing from the desired state are deleted if Options.Prune is set.
+//
+// Diff compares two realm representations without a server, e.g. the
+// exports of staging and production. Both return a Report.
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/zemirco/keycloak/v2"
+	"github.com/zemirco/keycloak/v2/realmfile"
+)
+
+// Options configures Realm.
+type Options struct {
+	// DryRun reports the changes without applying them.
+	DryRun bool
+
+	// Prune deletes realm roles, clients, client roles of desired clients,
+	// groups and users that are not part of the desired state. Built-in
+	// roles and clients and service account users are kept.
+	Prune bool
+}
+
+// Load reads a desired realm from a JSON file, e.g. a realm export, or from
+// a YAML file if path ends in ".yaml" or ".yml".
+func Load(path string) (*keycloak.Realm, error) {
+	var realm keycloak.Realm
+	if err := realmfile.ReadFile(path, &realm); err != nil {
+		return nil, err
+	}
+	return &realm, nil
+}
+
+// nested are the fields of a realm representation reconciled separately or
+// not at all.
+var nested = []string{
+	"id", "users", "clients", "clientScopes", "groups", "roles", "components",
+	"authenticationFlows", "authenticatorConfig", "requiredActions",
+	"defaultRole", "defaultDefaultClientScopes", "defaultOptionalClientScopes",
+}
+
+// Realm converges the live realm to desired and returns the changes made.
+// On error the report holds the changes made so far.
+func Realm(ctx context.Context, k *keycloak.Keycloak, desired *keycloak.Realm, opts *Options) (*Report, error) {
+	if desired.GetRealm() == "" {
+		return nil, fmt.Errorf("reconcile: realm name is missing")
+	}
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	r := &reconciler{
+		k:      k,
+		realm:  desired.GetRealm(),
+		opts:   opts,
+		report: &Report{},
+	}
+	for _, step := range []func(context.Context, *keycloak.Realm) error{
+		r.reconcileRealm,
+		r.reconcileRoles,
+		r.reconcileClients,
+		r.reconcileClientRoles,
+		r.reconcileGroups,
+		r.reconcileUsers,
+	} {
+		if err := step(ctx, desired); err != nil {
+			return r.report, err
+		}
+	}
+	return r.report, nil
+}
+
+type reconciler struct {
+	k      *keycloak.Keycloak
+	realm  string
+	opts   *Options
+	report *Report
+
+	// created is set if the realm does not exist yet. Nothing is listed
+	// from the live realm then, which matters for dry runs.
+	created bool
+
+	// roles by name
+	roles map[string]*keycloak.Role
+
+	// clients by clientId
+	clients map[string]*keycloak.Client
+}
+
+// change records c and applies it unless this is a dry run.
+func (r *reconciler) change(c Change, apply func() error) error {
+	r.report.Changes = append(r.report.Changes, c)
+	if r.opts.DryRun {
+		return nil
+	}
+	if err := apply(); err != nil {
+		return fmt.Errorf("reconcile: %s %s %s: %w", c.Action, c.Kind, c.Name, err)
+	}
+	return nil
+}
+
+func (r *reconciler) reconcileRealm(ctx context.Context, desired *keycloak.Realm) error {
+	settings, err := object(desired)
+	if err != nil {
+		return err
+	}
+	for _, field := range nested {
+		delete(settings, field)
+	}
+
+	live, _, err := r.k.Realms.Get(ctx, r.realm)
+	if keycloak.IsNotFound(err) {
+		r.created = true
+		return r.change(Change{Action: ActionCreate, Kind: KindRealm, Name: r.realm}, func() error {
+			var realm keycloak.Realm
+			if err := merge(nil, settings, &realm); err != nil {
+				return err
+			}
+			_, err := r.k.Realms.Create(ctx, &realm)
+			return err
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	fields, err := drift(settings, live)
+	if err != nil || len(fields) == 0 {
+		return err
+	}
+	return r.change(Change{Action: ActionUpdate, Kind: KindRealm, Name: r.realm, Fields: fields}, func() error {
+		var realm keycloak.Realm
+		if err := merge(live, settings, &realm); err != nil {
+			return err
+		}
+		_, err := r.k.Realms.Update(ctx, r.realm, &realm)
+		return err
+	})
+}
+
+// roleIgnore are role fields that are not reconciled.
+var roleIgnore = []string{"id", "containerId", "clientRole", "composite", "composites"}
+
+// newRole returns the fields of role that are reconciled. Ids of exports
+// belong to another server and composites are not reconciled.
+func newRole(role *keycloak.Role) *keycloak.Role {
+	return &keycloak.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Attributes:  role.Attributes,
+	}
+}
+
+// builtinRole reports whether the realm role name is created by Keycloak.
+func (r *reconciler) builtinRole(name string) bool {
+	switch name {
+	case "offline_access", "uma_authorization", "default-roles-" + strings.ToLower(r.realm):
+		return true
+	case "admin", "create-realm":
+		return r.realm == "master"
+	}
+	return false
+}
+
+func (r *reconciler) reconcileRoles(ctx context.Context, desired *keycloak.Realm) error {
+	if err := r.loadRoles(ctx); err != nil {
+		return err
+	}
+
+	var want []*keycloak.Role
+	if desired.Roles != nil {
+		want = desired.Roles.Realm
+	}
+
+	wanted := map[string]bool{}
+	for _, role := range want {
+		role := role
+		name := role.GetName()
+		wanted[name] = true
+
+		live, ok := r.roles[name]
+		if !ok {
+			err := r.change(Change{Action: ActionCreate, Kind: KindRole, Name: name}, func() error {
+				_, err := r.k.RealmRoles.Create(ctx, r.realm, newRole(role))
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		// roles are listed without attributes
+		if role.Attributes != nil {
+			var err error
+			live, _, err = r.k.RealmRoles.GetByName(ctx, r.realm, name)
+			if err != nil {
+				return err
+			}
+		}
+
+		fields, err := drift(role, live, roleIgnore...)
+		if err != nil {
+			return err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		err = r.change(Change{Action: ActionUpdate, Kind: KindRole, Name: name, Fields: fields}, func() error {
+			var update keycloak.Role
+			if err := merge(live, role, &update); err != nil {
+				return err
+			}
+			update.ID = live.ID
+			_, err := r.k.RealmRoles.Update(ctx, r.realm, name, &update)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.opts.Prune {
+		for _, name := range sortedKeys(r.roles) {
+			if wanted[name] || r.builtinRole(name) {
+				continue
+			}
+			err := r.change(Change{Action: ActionDelete, Kind: KindRole, Name: name}, func() error {
+				_, err := r.k.RealmRoles.Delete(ctx, r.realm, name)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// role mappings need the ids of created roles
+	return r.loadRoles(ctx)
+}
+
+func (r *reconciler) loadRoles(ctx context.Context) error {
+	r.roles = map[string]*keycloak.Role{}
+	if r.created && r.opts.DryRun {
+		return nil
+	}
+	roles, _, err := r.k.RealmRoles.List(ctx, r.realm, nil)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		r.roles[role.GetName()] = role
+	}
+	return nil
+}
+
+// clientIgnore are client fields that are not reconciled. Protocol mappers
+// and authorization settings have endpoints of their own.
+var clientIgnore = []string{"id", "protocolMappers", "authorizationSettings", "access"}
+
+// builtinClient reports whether clientID is created by Keycloak.
+func builtinClient(clientID string) bool {
+	switch clientID {
+	case "account", "account-console", "admin-cli", "broker", "realm-management", "security-admin-console":
+		return true
+	}
+	// clients of the master realm managing other realms
+	return strings.HasSuffix(clientID, "-realm")
+}
+
+func (r *reconciler) reconcileClients(ctx context.Context, desired *keycloak.Realm) error {
+	if err := r.loadClients(ctx); err != nil {
+		return err
+	}
+
+	wanted := map[string]bool{}
+	for _, client := range desired.Clients {
+		client := client
+		clientID := client.GetClientID()
+		wanted[clientID] = true
+
+		live, ok := r.clients[clientID]
+		if !ok {
+			err := r.change(Change{Action: ActionCreate, Kind: KindClient, Name: clientID}, func() error {
+				create := *client
+				create.ID = nil
+				_, err := r.k.Clients.Create(ctx, r.realm, &create)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		fields, err := drift(client, live, clientIgnore...)
+		if err != nil {
+			return err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		err = r.change(Change{Action: ActionUpdate, Kind: KindClient, Name: clientID, Fields: fields}, func() error {
+			var update keycloak.Client
+			if err := merge(live, client, &update); err != nil {
+				return err
+			}
+			update.ID = live.ID
+			_, err := r.k.Clients.Update(ctx, r.realm, &update)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.opts.Prune {
+		for _, clientID := range sortedKeys(r.clients) {
+			if wanted[clientID] || builtinClient(clientID) {
+				continue
+			}
+			id := r.clients[clientID].GetID()
+			err := r.change(Change{Action: ActionDelete, Kind: KindClient, Name: clientID}, func() error {
+				_, err := r.k.Clients.Delete(ctx, r.realm, id)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// client roles need the ids of created clients
+	return r.loadClients(ctx)
+}
+
+func (r *reconciler) loadClients(ctx context.Context) error {
+	r.clients = map[string]*keycloak.Client{}
+	if r.created && r.opts.DryRun {
+		return nil
+	}
+	clients, err := r.k.Clients.ListAll(ctx, r.realm, nil)
+	if err != nil {
+		return err
+	}
+	for _, client := range clients {
+		r.clients[client.GetClientID()] = client
+	}
+	return nil
+}
+
+func (r *reconciler) reconcileClientRoles(ctx context.Context, desired *keycloak.Realm) error {
+	if desired.Roles == nil {
+		return nil
+	}
+
+	for _, clientID := range sortedKeys(desired.Roles.Client) {
+		id := r.clients[clientID].GetID()
+
+		live := map[string]*keycloak.Role{}
+		if id != "" {
+			roles, _, err := r.k.ClientRoles.List(ctx, r.realm, id)
+			if err != nil {
+				return err
+			}
+			for _, role := range roles {
+				live[role.GetName()] = role
+			}
+		} else if !r.opts.DryRun {
+			return fmt.Errorf("reconcile: roles of unknown client %s", clientID)
+		}
+
+		wanted := map[string]bool{}
+		for _, role := range desired.Roles.Client[clientID] {
+			role := role
+			name := role.GetName()
+			wanted[name] = true
+
+			current, ok := live[name]
+			if !ok {
+				err := r.change(Change{Action: ActionCreate, Kind: KindClientRole, Name: clientID + "/" + name}, func() error {
+					_, err := r.k.ClientRoles.Create(ctx, r.realm, id, newRole(role))
+					return err
+				})
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			if role.Attributes != nil {
+				var err error
+				current, _, err = r.k.ClientRoles.Get(ctx, r.realm, id, name)
+				if err != nil {
+					return err
+				}
+			}
+
+			fields, err := drift(role, current, roleIgnore...)
+			if err != nil {
+				return err
+			}
+			if len(fields) == 0 {
+				continue
+			}
+			err = r.change(Change{Action: ActionUpdate, Kind: KindClientRole, Name: clientID + "/" + name, Fields: fields}, func() error {
+				var update keycloak.Role
+				if err := merge(current, role, &update); err != nil {
+					return err
+				}
+				update.ID = current.ID
+				_, err := r.k.ClientRoles.Update(ctx, r.realm, id, name, &update)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if r.opts.Prune && !builtinClient(clientID) {
+			for _, name := range sortedKeys(live) {
+				if wanted[name] {
+					continue
+				}
+				err := r.change(Change{Action: ActionDelete, Kind: KindClientRole, Name: clientID + "/" + name}, func() error {
+					_, err := r.k.ClientRoles.Delete(ctx, r.realm, id, name)
+					return err
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// groupIgnore are group fields that are not reconciled. Realm roles are
+// reconciled as role mappings.
+var groupIgnore = []string{"id", "path", "parentId", "subGroups", "subGroupCount", "realmRoles", "clientRoles", "access"}
+
+func (r *reconciler) reconcileGroups(ctx context.Context, desired *keycloak.Realm) error {
+	live := map[string]*keycloak.Group{}
+	if !r.created || !r.opts.DryRun {
+		groups, err := r.k.Groups.ListAll(ctx, r.realm, nil)
+		if err != nil {
+			return err
+		}
+		if err := r.walkGroups(ctx, "", groups, live); err != nil {
+			return err
+		}
+	}
+
+	wanted := map[string]bool{}
+	if err := r.reconcileSubGroups(ctx, "", "", desired.Groups, live, wanted); err != nil {
+		return err
+	}
+
+	if !r.opts.Prune {
+		return nil
+	}
+	var pruned []string
+	for _, path := range sortedKeys(live) {
+		if wanted[path] || below(path, pruned) {
+			continue
+		}
+		pruned = append(pruned, path)
+		id := live[path].GetID()
+		err := r.change(Change{Action: ActionDelete, Kind: KindGroup, Name: path}, func() error {
+			_, err := r.k.Groups.Delete(ctx, r.realm, id)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// below reports whether path is below one of the parents.
+func below(path string, parents []string) bool {
+	for _, parent := range parents {
+		if strings.HasPrefix(path, parent+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// walkGroups adds groups and their subgroups to live by path. Subgroups are
+// listed separately if the server does not include them.
+func (r *reconciler) walkGroups(ctx context.Context, parent string, groups []*keycloak.Group, live map[string]*keycloak.Group) error {
+	for _, group := range groups {
+		path := parent + "/" + group.GetName()
+		live[path] = group
+
+		children := group.SubGroups
+		if len(children) == 0 && group.GetSubGroupCount() > 0 {
+			var err error
+			children, _, err = r.k.Groups.ListChildren(ctx, r.realm, group.GetID(), nil)
+			if err != nil {
+				return err
+			}
+		}
+		if err := r.walkGroups(ctx, path, children, live); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *reconciler) reconcileSubGroups(ctx context.Context, parentPath, parentID string, groups []*keycloak.Group, live map[string]*keycloak.Group, wanted map[string]bool) error {
+	for _, group := range groups {
+		group := group
+		path := parentPath + "/" + group.GetName()
+		wanted[path] = true
+
+		current, ok := live[path]
+		var id string
+		if !ok {
+			err := r.change(Change{Action: ActionCreate, Kind: KindGroup, Name: path}, func() error {
+				create := &keycloak.Group{Name: group.Name, Attributes: group.Attributes}
+				var res *keycloak.Response
+				var err error
+				if parentID == "" {
+					res, err = r.k.Groups.Create(ctx, r.realm, create)
+				} else {
+					res, err = r.k.Groups.CreateChild(ctx, r.realm, parentID, create)
+				}
+				if err != nil {
+					return err
+				}
+				id = res.LocationID
+				return r.addGroupRoles(ctx, id, group.RealmRoles)
+			})
+			if err != nil {
+				return err
+			}
+		} else {
+			id = current.GetID()
+			if err := r.updateGroup(ctx, path, group, current); err != nil {
+				return err
+			}
+		}
+
+		if err := r.reconcileSubGroups(ctx, path, id, group.SubGroups, live, wanted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *reconciler) updateGroup(ctx context.Context, path string, group, current *keycloak.Group) error {
+	fields, err := drift(group, current, groupIgnore...)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	if len(group.RealmRoles) > 0 {
+		mapped, _, err := r.k.Groups.ListRealmRoles(ctx, r.realm, current.GetID())
+		if err != nil {
+			return err
+		}
+		has := map[string]bool{}
+		for _, role := range mapped {
+			has[role.GetName()] = true
+		}
+		for _, name := range group.RealmRoles {
+			if !has[name] {
+				missing = append(missing, name)
+			}
+		}
+		if len(missing) > 0 {
+			fields = append(fields, "realmRoles")
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+	return r.change(Change{Action: ActionUpdate, Kind: KindGroup, Name: path, Fields: fields}, func() error {
+		var update keycloak.Group
+		if err := merge(current, group, &update); err != nil {
+			return err
+		}
+		update.ID = current.ID
+		update.SubGroups = nil
+		if _, err := r.k.Groups.Update(ctx, r.realm, &update); err != nil {
+			return err
+		}
+		return r.addGroupRoles(ctx, current.GetID(), missing)
+	})
+}
+
+func (r *reconciler) addGroupRoles(ctx context.Context, groupID string, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	var roles []*keycloak.Role
+	for _, name := range names {
+		role, ok := r.roles[name]
+		if !ok {
+			return fmt.Errorf("unknown realm role %s", name)
+		}
+		roles = append(roles, role)
+	}
+	_, err := r.k.Groups.AddRealmRoles(ctx, r.realm, groupID, roles)
+	return err
+}
+
+// userIgnore are user fields that are not reconciled. Credentials are only
+// set when a user is created, groups are reconciled as memberships.
+var userIgnore = []string{"id", "createdTimestamp", "credentials", "groups", "access", "totp", "disableableCredentialTypes", "notBefore"}
+
+func (r *reconciler) reconcileUsers(ctx context.Context, desired *keycloak.Realm) error {
+	live := map[string]*keycloak.User{}
+	if !r.created || !r.opts.DryRun {
+		users, err := r.k.Users.ListAll(ctx, r.realm, nil)
+		if err != nil {
+			return err
+		}
+		for _, user := range users {
+			live[user.GetUsername()] = user
+		}
+	}
+
+	wanted := map[string]bool{}
+	for _, user := range desired.Users {
+		user := user
+		// usernames are stored in lower case
+		username := strings.ToLower(user.GetUsername())
+		wanted[username] = true
+
+		current, ok := live[username]
+		if !ok {
+			err := r.change(Change{Action: ActionCreate, Kind: KindUser, Name: username}, func() error {
+				create := *user
+				create.ID = nil
+				_, err := r.k.Users.Create(ctx, r.realm, &create)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := r.updateUser(ctx, username, user, current); err != nil {
+			return err
+		}
+	}
+
+	if r.opts.Prune {
+		for _, username := range sortedKeys(live) {
+			if wanted[username] || strings.HasPrefix(username, "service-account-") {
+				continue
+			}
+			id := live[username].GetID()
+			err := r.change(Change{Action: ActionDelete, Kind: KindUser, Name: username}, func() error {
+				_, err := r.k.Users.Delete(ctx, r.realm, id)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *reconciler) updateUser(ctx context.Context, username string, user, current *keycloak.User) error {
+	want := *user
+	want.Username = keycloak.String(username)
+	fields, err := drift(&want, current, userIgnore...)
+	if err != nil {
+		return err
+	}
+
+	var join []string
+	if len(user.Groups) > 0 {
+		groups, _, err := r.k.Users.ListGroups(ctx, r.realm, current.GetID(), nil)
+		if err != nil {
+			return err
+		}
+		member := map[string]bool{}
+		for _, group := range groups {
+			member[group.GetPath()] = true
+		}
+		for _, path := range user.Groups {
+			if !member["/"+strings.Trim(path, "/")] {
+				join = append(join, path)
+			}
+		}
+		if len(join) > 0 {
+			fields = append(fields, "groups")
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+	return r.change(Change{Action: ActionUpdate, Kind: KindUser, Name: username, Fields: fields}, func() error {
+		var update keycloak.User
+		if err := merge(current, &want, &update); err != nil {
+			return err
+		}
+		update.ID = current.ID
+		update.Credentials = nil
+		update.Groups = nil
+		if _, err := r.k.Users.Update(ctx, r.realm, &update); err != nil {
+			return err
+		}
+		for _, path := range join {
+			group, _, err := r.k.Groups.GetByPath(ctx, r.realm, path)
+			if err != nil {
+				return err
+			}
+			if _, err := r.k.Users.JoinGroup(ctx, r.realm, current.GetID(), group.GetID()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// sortedKeys returns the sorted keys of a map with string keys.
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	for _, key := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
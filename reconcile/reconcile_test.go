@@ -0,0 +1,157 @@
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zemirco/keycloak/v2"
+	"github.com/zemirco/keycloak/v2/keycloaktest"
+)
+
+func desiredRealm() *keycloak.Realm {
+	return &keycloak.Realm{
+		Realm:       keycloak.String("first"),
+		Enabled:     keycloak.Bool(true),
+		DisplayName: keycloak.String("First"),
+		Roles: &keycloak.Roles{
+			Realm: []*keycloak.Role{
+				{Name: keycloak.String("admin"), Description: keycloak.String("Administrators")},
+			},
+			Client: map[string][]*keycloak.Role{
+				"app": {{Name: keycloak.String("viewer")}},
+			},
+		},
+		Clients: []*keycloak.Client{
+			{ClientID: keycloak.String("app"), RedirectUris: []string{"http://localhost:4200/*"}},
+		},
+		Groups: []*keycloak.Group{
+			{
+				Name:       keycloak.String("org"),
+				RealmRoles: []string{"admin"},
+				SubGroups:  []*keycloak.Group{{Name: keycloak.String("team")}},
+			},
+		},
+		Users: []*keycloak.User{
+			keycloak.NewUser().WithUsername("john").WithEmail("john@example.com").WithGroups("/org/team"),
+		},
+	}
+}
+
+func TestRealm(t *testing.T) {
+	srv := keycloaktest.NewServer()
+	defer srv.Close()
+
+	k := srv.Keycloak()
+	ctx := context.Background()
+
+	report, err := Realm(ctx, k, desiredRealm(), &Options{DryRun: true})
+	if err != nil {
+		t.Fatalf("Realm returned error: %v", err)
+	}
+	want := `+ realm first
++ role admin
++ client app
++ client role app/viewer
++ group /org
++ group /org/team
++ user john`
+	if report.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", report, want)
+	}
+
+	if _, _, err := k.Realms.Get(ctx, "first"); !keycloak.IsNotFound(err) {
+		t.Errorf("got: %v, want not found after a dry run", err)
+	}
+
+	if _, err := Realm(ctx, k, desiredRealm(), nil); err != nil {
+		t.Fatalf("Realm returned error: %v", err)
+	}
+
+	report, err = Realm(ctx, k, desiredRealm(), nil)
+	if err != nil {
+		t.Fatalf("Realm returned error: %v", err)
+	}
+	if report.HasChanges() {
+		t.Errorf("got:\n%s\nwant no changes", report)
+	}
+
+	group, _, err := k.Groups.GetByPath(ctx, "first", "/org")
+	if err != nil {
+		t.Fatalf("Groups.GetByPath returned error: %v", err)
+	}
+	roles, _, err := k.Groups.ListRealmRoles(ctx, "first", group.GetID())
+	if err != nil {
+		t.Fatalf("Groups.ListRealmRoles returned error: %v", err)
+	}
+	if len(roles) != 1 {
+		t.Errorf("got: %d, want: %d", len(roles), 1)
+	}
+}
+
+func TestRealm_Drift(t *testing.T) {
+	srv := keycloaktest.NewServer()
+	defer srv.Close()
+
+	k := srv.Keycloak()
+	ctx := context.Background()
+
+	if _, err := Realm(ctx, k, desiredRealm(), nil); err != nil {
+		t.Fatalf("Realm returned error: %v", err)
+	}
+
+	users, _, err := k.Users.GetByUsername(ctx, "first", "john")
+	if err != nil {
+		t.Fatalf("Users.GetByUsername returned error: %v", err)
+	}
+	users[0].Email = keycloak.String("john@example.org")
+	if _, err := k.Users.Update(ctx, "first", users[0]); err != nil {
+		t.Fatalf("Users.Update returned error: %v", err)
+	}
+	if _, err := k.Groups.Create(ctx, "first", &keycloak.Group{Name: keycloak.String("old")}); err != nil {
+		t.Fatalf("Groups.Create returned error: %v", err)
+	}
+
+	desired := desiredRealm()
+	desired.Clients[0].RedirectUris = append(desired.Clients[0].RedirectUris, "https://example.com/*")
+
+	report, err := Realm(ctx, k, desired, &Options{Prune: true})
+	if err != nil {
+		t.Fatalf("Realm returned error: %v", err)
+	}
+	want := `~ client app: redirectUris
+- group /old
+~ user john: email`
+	if report.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", report, want)
+	}
+
+	report, err = Realm(ctx, k, desired, &Options{Prune: true})
+	if err != nil {
+		t.Fatalf("Realm returned error: %v", err)
+	}
+	if report.HasChanges() {
+		t.Errorf("got:\n%s\nwant no changes", report)
+	}
+}
+
+func TestContained(t *testing.T) {
+	tests := []struct {
+		desired, live interface{}
+		want          bool
+	}{
+		{"a", "a", true},
+		{"a", "b", false},
+		{"", nil, true},
+		{map[string]interface{}{"a": "b"}, map[string]interface{}{"a": "b", "c": "d"}, true},
+		{map[string]interface{}{"a": "b"}, map[string]interface{}{}, false},
+		{[]interface{}{"a", "b"}, []interface{}{"b", "a"}, true},
+		{[]interface{}{"a"}, []interface{}{"a", "b"}, false},
+		{[]interface{}{}, nil, true},
+	}
+
+	for _, tt := range tests {
+		if got := contained(tt.desired, tt.live); got != tt.want {
+			t.Errorf("contained(%v, %v): got: %t, want: %t", tt.desired, tt.live, got, tt.want)
+		}
+	}
+}
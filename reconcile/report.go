@@ -0,0 +1,79 @@
+package reconcile
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Actions of a Change.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// Kinds of resources changed by a Change.
+const (
+	KindRealm      = "realm"
+	KindRole       = "role"
+	KindClient     = "client"
+	KindClientRole = "client role"
+	KindGroup      = "group"
+	KindUser       = "user"
+)
+
+// Change is a single change made to the live realm, or that would be made
+// in a dry run.
+type Change struct {
+	Action string
+	Kind   string
+
+	// Name is the natural key of the resource: the name of realms and
+	// roles, the clientId of clients, "clientId/name" of client roles, the
+	// path of groups and the username of users.
+	Name string
+
+	// Fields that drifted from the desired state, set for updates.
+	Fields []string
+}
+
+func (c Change) String() string {
+	var sign string
+	switch c.Action {
+	case ActionCreate:
+		sign = "+"
+	case ActionUpdate:
+		sign = "~"
+	case ActionDelete:
+		sign = "-"
+	}
+	s := fmt.Sprintf("%s %s %s", sign, c.Kind, c.Name)
+	if len(c.Fields) > 0 {
+		s += ": " + strings.Join(c.Fields, ", ")
+	}
+	return s
+}
+
+// Report lists the changes in the order they were made.
+type Report struct {
+	Changes []Change
+}
+
+// HasChanges reports whether the live realm differs from the desired one,
+// e.g. to fail a CI check after a dry run.
+func (r *Report) HasChanges() bool {
+	return r != nil && len(r.Changes) > 0
+}
+
+// String returns a diff like summary with one change per line like
+// "+ client app", "~ user john: email, firstName" or "- group /old".
+func (r *Report) String() string {
+	if !r.HasChanges() {
+		return "no changes"
+	}
+	lines := make([]string, len(r.Changes))
+	for i, c := range r.Changes {
+		lines[i] = c.String()
+	}
+	return strings.Join(lines, "\n")
+}
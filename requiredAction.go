@@ -0,0 +1,42 @@
+package keycloak
+
+// RequiredActionType is the alias of a required action, e.g.
+// RequiredActionUpdatePassword. Custom required actions are converted with
+// RequiredActionType("my-action").
+type RequiredActionType string
+
+// Required actions are actions a user must perform before being allowed to log in.
+// They are set with User.WithRequiredActions and passed to UsersService.ExecuteActionsEmail.
+//
+// https://github.com/keycloak/keycloak/blob/main/server-spi-private/src/main/java/org/keycloak/models/UserModel.java
+const (
+	// RequiredActionConfigureTOTP asks the user to set up a one-time password generator.
+	RequiredActionConfigureTOTP RequiredActionType = "CONFIGURE_TOTP"
+
+	// RequiredActionTermsAndConditions asks the user to accept the terms and conditions.
+	RequiredActionTermsAndConditions RequiredActionType = "TERMS_AND_CONDITIONS"
+
+	// RequiredActionUpdatePassword asks the user to set a new password.
+	RequiredActionUpdatePassword RequiredActionType = "UPDATE_PASSWORD"
+
+	// RequiredActionUpdateProfile asks the user to review and update their profile.
+	RequiredActionUpdateProfile RequiredActionType = "UPDATE_PROFILE"
+
+	// RequiredActionVerifyEmail sends the user a link to verify their email address.
+	RequiredActionVerifyEmail RequiredActionType = "VERIFY_EMAIL"
+
+	// RequiredActionConfigureRecoveryAuthnCodes asks the user to generate recovery codes.
+	RequiredActionConfigureRecoveryAuthnCodes RequiredActionType = "CONFIGURE_RECOVERY_AUTHN_CODES"
+
+	// RequiredActionWebAuthnRegister asks the user to register a security key as second factor.
+	RequiredActionWebAuthnRegister RequiredActionType = "webauthn-register"
+
+	// RequiredActionWebAuthnRegisterPasswordless asks the user to register a security key for passwordless login.
+	RequiredActionWebAuthnRegisterPasswordless RequiredActionType = "webauthn-register-passwordless"
+
+	// RequiredActionUpdateUserLocale stores the locale selected by the user.
+	RequiredActionUpdateUserLocale RequiredActionType = "update_user_locale"
+
+	// RequiredActionDeleteAccount allows the user to delete their own account.
+	RequiredActionDeleteAccount RequiredActionType = "delete_account"
+)
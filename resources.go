@@ -2,7 +2,6 @@ package keycloak
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 )
 
@@ -32,8 +31,8 @@ type Resource struct {
 }
 
 // List lists all resources.
-func (s *ResourcesService) List(ctx context.Context, realm, clientID string) ([]*Resource, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/resource", realm, clientID)
+func (s *ResourcesService) List(ctx context.Context, realm, clientID string) ([]*Resource, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/resource", realm, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -49,8 +48,8 @@ func (s *ResourcesService) List(ctx context.Context, realm, clientID string) ([]
 }
 
 // Create creates a new resource.
-func (s *ResourcesService) Create(ctx context.Context, realm, clientID string, resource *Resource) (*Resource, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/resource", realm, clientID)
+func (s *ResourcesService) Create(ctx context.Context, realm, clientID string, resource *Resource) (*Resource, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/resource", realm, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, resource)
 	if err != nil {
 		return nil, nil, err
@@ -66,8 +65,8 @@ func (s *ResourcesService) Create(ctx context.Context, realm, clientID string, r
 }
 
 // Get gets a single resource.
-func (s *ResourcesService) Get(ctx context.Context, realm, clientID, resourceID string) (*Resource, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/resource/%s", realm, clientID, resourceID)
+func (s *ResourcesService) Get(ctx context.Context, realm, clientID, resourceID string) (*Resource, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/resource/%s", realm, clientID, resourceID)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -83,8 +82,8 @@ func (s *ResourcesService) Get(ctx context.Context, realm, clientID, resourceID
 }
 
 // Delete deletes a single resource.
-func (s *ResourcesService) Delete(ctx context.Context, realm, clientID, resourceID string) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/resource/%s", realm, clientID, resourceID)
+func (s *ResourcesService) Delete(ctx context.Context, realm, clientID, resourceID string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/resource/%s", realm, clientID, resourceID)
 	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
 	if err != nil {
 		return nil, err
@@ -92,3 +91,14 @@ func (s *ResourcesService) Delete(ctx context.Context, realm, clientID, resource
 
 	return s.keycloak.Do(ctx, req, nil)
 }
+
+// Update updates a single resource.
+func (s *ResourcesService) Update(ctx context.Context, realm, clientID string, resource *Resource) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/resource/%s", realm, clientID, *resource.ID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
@@ -131,3 +131,35 @@ func TestResourcesService_Deletee(t *testing.T) {
 		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
 	}
 }
+
+func TestResourcesService_Update(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	clientID := createClient(t, k, realm, "client")
+	resource := createResource(t, k, realm, clientID, "resource")
+
+	ctx := context.Background()
+
+	resource.DisplayName = String("updated")
+
+	res, err := k.Resources.Update(ctx, realm, clientID, resource)
+	if err != nil {
+		t.Errorf("Resources.Update returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	resource, _, err = k.Resources.Get(ctx, realm, clientID, *resource.ID)
+	if err != nil {
+		t.Errorf("Resources.Get returned error: %v", err)
+	}
+
+	if *resource.DisplayName != "updated" {
+		t.Errorf("got: %s, want: %s", *resource.DisplayName, "updated")
+	}
+}
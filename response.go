@@ -0,0 +1,89 @@
+package keycloak
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Response wraps the http.Response returned by the API and exposes the
+// metadata call sites commonly need. The body has already been consumed.
+type Response struct {
+	*http.Response
+
+	// RequestID is the X-Request-Id header, set by many proxies in front of
+	// Keycloak to correlate logs.
+	RequestID string
+
+	// LocationID is the last path segment of the Location header, i.e. the
+	// id of a created resource. Some endpoints, e.g. for roles, respond with
+	// the name instead.
+	LocationID string
+
+	// Rate holds the rate limit headers, if a proxy sets them.
+	Rate Rate
+}
+
+// Rate represents the rate limit headers of a response. Both the
+// X-RateLimit-* headers and the RateLimit-* headers of the IETF draft are
+// supported.
+type Rate struct {
+	// Limit is the number of requests allowed in the current window.
+	Limit int
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+	// Reset is when the current window resets.
+	Reset time.Time
+}
+
+// newResponse wraps res, which may be nil.
+func newResponse(res *http.Response) *Response {
+	if res == nil {
+		return nil
+	}
+	r := &Response{
+		Response:  res,
+		RequestID: res.Header.Get("X-Request-Id"),
+		Rate:      parseRate(res),
+	}
+	if location := res.Header.Get("Location"); location != "" {
+		segments := strings.Split(strings.TrimSuffix(location, "/"), "/")
+		r.LocationID = segments[len(segments)-1]
+	}
+	return r
+}
+
+func parseRate(res *http.Response) Rate {
+	var rate Rate
+	header := func(name string) string {
+		if v := res.Header.Get("X-RateLimit-" + name); v != "" {
+			return v
+		}
+		return res.Header.Get("RateLimit-" + name)
+	}
+	if v, err := strconv.Atoi(header("Limit")); err == nil {
+		rate.Limit = v
+	}
+	if v, err := strconv.Atoi(header("Remaining")); err == nil {
+		rate.Remaining = v
+	}
+	if v, err := strconv.ParseInt(header("Reset"), 10, 64); err == nil {
+		// X-RateLimit-Reset is usually an epoch timestamp whereas the IETF
+		// draft sends the seconds until the reset
+		if v > 1e9 {
+			rate.Reset = time.Unix(v, 0)
+		} else {
+			rate.Reset = time.Now().Add(time.Duration(v) * time.Second)
+		}
+	}
+	return rate
+}
+
+// raw returns the wrapped response of r, which may be nil.
+func (r *Response) raw() *http.Response {
+	if r == nil {
+		return nil
+	}
+	return r.Response
+}
@@ -0,0 +1,78 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestNewResponse(t *testing.T) {
+	reset := time.Now().Add(time.Minute).Unix()
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Set("X-Request-Id", "abc")
+	res.Header.Set("Location", "http://localhost:8080/admin/realms/first/users/1234")
+	res.Header.Set("X-RateLimit-Limit", "100")
+	res.Header.Set("X-RateLimit-Remaining", "99")
+	res.Header.Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+
+	r := newResponse(res)
+	if r.RequestID != "abc" {
+		t.Errorf("got: %s, want: %s", r.RequestID, "abc")
+	}
+	if r.LocationID != "1234" {
+		t.Errorf("got: %s, want: %s", r.LocationID, "1234")
+	}
+	if r.Rate.Limit != 100 || r.Rate.Remaining != 99 {
+		t.Errorf("got: %+v", r.Rate)
+	}
+	if r.Rate.Reset.Unix() != reset {
+		t.Errorf("got: %d, want: %d", r.Rate.Reset.Unix(), reset)
+	}
+
+	// IETF draft headers send the seconds until the reset
+	res = &http.Response{Header: http.Header{}}
+	res.Header.Set("RateLimit-Limit", "10")
+	res.Header.Set("RateLimit-Reset", "30")
+	r = newResponse(res)
+	if r.Rate.Limit != 10 {
+		t.Errorf("got: %d, want: %d", r.Rate.Limit, 10)
+	}
+	if d := time.Until(r.Rate.Reset); d < 25*time.Second || d > 30*time.Second {
+		t.Errorf("got: %s", d)
+	}
+
+	if newResponse(nil) != nil {
+		t.Error("expected nil response")
+	}
+}
+
+func TestDo_Response(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "http://"+r.Host+r.URL.Path+"/5678")
+		w.Header().Set("X-Request-Id", "xyz")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := k.Users.Create(context.Background(), "first", &User{Username: String("john")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+	if res.LocationID != "5678" {
+		t.Errorf("got: %s, want: %s", res.LocationID, "5678")
+	}
+	if res.RequestID != "xyz" {
+		t.Errorf("got: %s, want: %s", res.RequestID, "xyz")
+	}
+}
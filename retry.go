@@ -0,0 +1,195 @@
+package keycloak
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how failed requests are retried.
+//
+// Idempotent requests (GET, HEAD, OPTIONS, PUT and DELETE) are retried on
+// network errors and retryable status codes. Other requests are only retried
+// on 429 Too Many Requests and 503 Service Unavailable, since the server did
+// not process them. The Retry-After header of those responses is honored.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first one.
+	// Defaults to 3.
+	MaxAttempts int
+
+	// Backoff returns how long to wait before the given retry, starting at 1.
+	// Defaults to ExponentialBackoff(100*time.Millisecond, 5*time.Second).
+	Backoff func(retry int) time.Duration
+
+	// Retryable reports whether a response should be retried. Defaults to
+	// DefaultRetryable.
+	Retryable func(res *http.Response) bool
+}
+
+// DefaultRetryable reports whether res has one of the status codes 429, 502,
+// 503 or 504.
+func DefaultRetryable(res *http.Response) bool {
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// ExponentialBackoff returns a backoff strategy that doubles the wait time
+// with every retry, starting at base and never exceeding max. Up to 20% of
+// random jitter is added to spread retries of concurrent callers.
+func ExponentialBackoff(base, max time.Duration) func(retry int) time.Duration {
+	return func(retry int) time.Duration {
+		d := base
+		for i := 1; i < retry && d < max; i++ {
+			d *= 2
+		}
+		if d > max {
+			d = max
+		}
+		return d + time.Duration(rand.Int63n(int64(d)/5+1))
+	}
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts > 0 {
+		return p.MaxAttempts
+	}
+	return 3
+}
+
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	if p.Backoff != nil {
+		return p.Backoff(retry)
+	}
+	return ExponentialBackoff(100*time.Millisecond, 5*time.Second)(retry)
+}
+
+func (p *RetryPolicy) retryable(req *http.Request, res *http.Response) bool {
+	if res.StatusCode != http.StatusTooManyRequests && res.StatusCode != http.StatusServiceUnavailable && !idempotent(req) {
+		return false
+	}
+	if p.Retryable != nil {
+		return p.Retryable(res)
+	}
+	return DefaultRetryable(res)
+}
+
+func idempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryAfter parses the Retry-After header given either in seconds or as an
+// HTTP date.
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	v := res.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t), true
+	}
+	return 0, false
+}
+
+type noRetryKey struct{}
+
+// withoutRetry returns a context whose requests are not retried by
+// k.Retry, for callers that retry on their own.
+func withoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+// send sends req and retries it according to k.Retry.
+func (k *Keycloak) send(req *http.Request) (*http.Response, error) {
+	if k.Retry == nil || req.Context().Value(noRetryKey{}) != nil {
+		return k.roundTrip(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		res, err := k.roundTrip(req)
+
+		last := attempt >= k.Retry.maxAttempts() || (req.Body != nil && req.GetBody == nil)
+		if last {
+			return res, err
+		}
+
+		var wait time.Duration
+		switch {
+		case err != nil:
+			if req.Context().Err() != nil || !idempotent(req) {
+				return res, err
+			}
+			wait = k.Retry.backoff(attempt)
+		case k.Retry.retryable(req, res):
+			wait = k.Retry.backoff(attempt)
+			if res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusServiceUnavailable {
+				if d, ok := retryAfter(res); ok {
+					wait = d
+				}
+			}
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		default:
+			return res, err
+		}
+
+		if k.logger != nil {
+			kv := []interface{}{"method", req.Method, "url", redactURL(req.URL), "attempt", attempt, "wait", wait}
+			if err != nil {
+				kv = append(kv, "error", err.Error())
+			} else {
+				kv = append(kv, "status", res.StatusCode)
+			}
+			k.logger.Warn("keycloak: retrying request", kv...)
+		}
+
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// roundTrip sends a single attempt of req, honoring the rate of k.Limiter.
+func (k *Keycloak) roundTrip(req *http.Request) (*http.Response, error) {
+	if k.Limiter != nil {
+		if err := k.Limiter.wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return k.client.Do(req)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
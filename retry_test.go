@@ -0,0 +1,123 @@
+package keycloak
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func noBackoff(int) time.Duration { return 0 }
+
+// statusCode returns the status code of an *ErrorResponse or 0.
+func statusCode(err error) int {
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) {
+		return errorResponse.StatusCode()
+	}
+	return 0
+}
+
+func TestKeycloak_Do_Retry(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "{\"name\":\"group\"}\n" {
+			t.Errorf("got: %q, want the request body on every attempt", body)
+		}
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.Retry = &RetryPolicy{Backoff: noBackoff}
+
+	res, err := k.Groups.Create(context.Background(), "first", &Group{Name: String("group")})
+	if err != nil {
+		t.Errorf("Groups.Create returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusCreated {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusCreated)
+	}
+
+	if attempts != 3 {
+		t.Errorf("got: %d, want: %d", attempts, 3)
+	}
+}
+
+func TestKeycloak_Do_RetryGivesUp(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.Retry = &RetryPolicy{MaxAttempts: 2, Backoff: noBackoff}
+
+	ctx := context.Background()
+
+	// GET is idempotent and retried
+	if _, _, err := k.Groups.List(ctx, "first", nil); statusCode(err) != http.StatusBadGateway {
+		t.Errorf("got: %v, want: 502", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("got: %d, want: %d", attempts, 2)
+	}
+
+	// POST is not retried on 502
+	attempts = 0
+	if _, err := k.Groups.Create(ctx, "first", &Group{Name: String("group")}); statusCode(err) != http.StatusBadGateway {
+		t.Errorf("got: %v, want: 502", err)
+	}
+
+	if attempts != 1 {
+		t.Errorf("got: %d, want: %d", attempts, 1)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Set("Retry-After", "2")
+
+	d, ok := retryAfter(res)
+	if !ok || d != 2*time.Second {
+		t.Errorf("got: %v, want: %v", d, 2*time.Second)
+	}
+
+	res.Header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+
+	d, ok = retryAfter(res)
+	if !ok || d <= 50*time.Second {
+		t.Errorf("got: %v, want about a minute", d)
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(100*time.Millisecond, time.Second)
+
+	for retry, want := range map[int]time.Duration{1: 100 * time.Millisecond, 2: 200 * time.Millisecond, 5: time.Second} {
+		d := backoff(retry)
+		if d < want || d > want+want/5 {
+			t.Errorf("retry %d got: %v, want: %v", retry, d, want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+)
+
+// RoleByIDService handles realm and client roles by their id, which role
+// mappings and composites contain, without looking up their names first.
+type RoleByIDService service
+
+// Get gets the role with id.
+func (s *RoleByIDService) Get(ctx context.Context, realm, id string) (*Role, *Response, error) {
+	u := pathf("admin/realms/%s/roles-by-id/%s", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var role Role
+	res, err := s.keycloak.Do(ctx, req, &role)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &role, res, nil
+}
+
+// Update updates the role with id.
+func (s *RoleByIDService) Update(ctx context.Context, realm, id string, role *Role) (*Response, error) {
+	u := pathf("admin/realms/%s/roles-by-id/%s", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// Delete deletes the role with id.
+func (s *RoleByIDService) Delete(ctx context.Context, realm, id string) (*Response, error) {
+	u := pathf("admin/realms/%s/roles-by-id/%s", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// AddComposites adds roles to the composite of the role with id.
+func (s *RoleByIDService) AddComposites(ctx context.Context, realm, id string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/roles-by-id/%s/composites", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListComposites lists the realm and client roles the composite role with
+// id consists of.
+func (s *RoleByIDService) ListComposites(ctx context.Context, realm, id string, opts *Options) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/roles-by-id/%s/composites", realm, id)
+	return s.listComposites(ctx, u, opts)
+}
+
+// ListRealmComposites lists the realm roles the composite role with id
+// consists of.
+func (s *RoleByIDService) ListRealmComposites(ctx context.Context, realm, id string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/roles-by-id/%s/composites/realm", realm, id)
+	return s.listComposites(ctx, u, nil)
+}
+
+// ListClientComposites lists the roles of the client clientID, the client's
+// id and not its client id, the composite role with id consists of.
+func (s *RoleByIDService) ListClientComposites(ctx context.Context, realm, id, clientID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/roles-by-id/%s/composites/clients/%s", realm, id, clientID)
+	return s.listComposites(ctx, u, nil)
+}
+
+func (s *RoleByIDService) listComposites(ctx context.Context, u string, opts *Options) ([]*Role, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// RemoveComposites removes roles from the composite of the role with id.
+func (s *RoleByIDService) RemoveComposites(ctx context.Context, realm, id string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/roles-by-id/%s/composites", realm, id)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
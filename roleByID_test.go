@@ -0,0 +1,81 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestRoleByIDService(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	createRealmRole(t, k, realm, "parent")
+	createRealmRole(t, k, realm, "child")
+
+	ctx := context.Background()
+
+	parent, _, err := k.RealmRoles.GetByName(ctx, realm, "parent")
+	if err != nil {
+		t.Errorf("RealmRoles.GetByName returned error: %v", err)
+	}
+
+	child, _, err := k.RealmRoles.GetByName(ctx, realm, "child")
+	if err != nil {
+		t.Errorf("RealmRoles.GetByName returned error: %v", err)
+	}
+
+	role, res, err := k.RoleByID.Get(ctx, realm, parent.GetID())
+	if err != nil {
+		t.Errorf("RoleByID.Get returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if role.GetName() != "parent" {
+		t.Errorf("got: %s, want: %s", role.GetName(), "parent")
+	}
+
+	role.Description = String("updated")
+	if _, err := k.RoleByID.Update(ctx, realm, parent.GetID(), role); err != nil {
+		t.Errorf("RoleByID.Update returned error: %v", err)
+	}
+
+	if _, err := k.RoleByID.AddComposites(ctx, realm, parent.GetID(), []*Role{child}); err != nil {
+		t.Errorf("RoleByID.AddComposites returned error: %v", err)
+	}
+
+	composites, _, err := k.RoleByID.ListRealmComposites(ctx, realm, parent.GetID())
+	if err != nil {
+		t.Errorf("RoleByID.ListRealmComposites returned error: %v", err)
+	}
+
+	if len(composites) != 1 || composites[0].GetID() != child.GetID() {
+		t.Errorf("got: %v, want: %s", composites, child.GetID())
+	}
+
+	if _, err := k.RoleByID.RemoveComposites(ctx, realm, parent.GetID(), []*Role{child}); err != nil {
+		t.Errorf("RoleByID.RemoveComposites returned error: %v", err)
+	}
+
+	composites, _, err = k.RoleByID.ListComposites(ctx, realm, parent.GetID(), nil)
+	if err != nil {
+		t.Errorf("RoleByID.ListComposites returned error: %v", err)
+	}
+
+	if len(composites) != 0 {
+		t.Errorf("got: %d, want: %d", len(composites), 0)
+	}
+
+	res, err = k.RoleByID.Delete(ctx, realm, child.GetID())
+	if err != nil {
+		t.Errorf("RoleByID.Delete returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
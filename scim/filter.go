@@ -0,0 +1,473 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Filter is a parsed SCIM filter expression, e.g.
+//
+//	userName eq "bjensen" and emails[type eq "work" and value co "@example.com"]
+//
+// https://datatracker.ietf.org/doc/html/rfc7644#section-3.4.2.2
+type Filter struct {
+	root node
+}
+
+// ParseFilter parses the filter expression s. Attribute names and operators
+// are case insensitive, string comparisons too.
+func ParseFilter(s string) (*Filter, error) {
+	p, err := newParser(s)
+	if err != nil {
+		return nil, err
+	}
+	root, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, invalidFilter("unexpected %q", p.peek())
+	}
+	return &Filter{root: root}, nil
+}
+
+// Match reports whether the resource, e.g. a *User, matches the filter.
+func (f *Filter) Match(resource interface{}) bool {
+	obj, err := toObject(resource)
+	if err != nil {
+		return false
+	}
+	return f.root.match(obj)
+}
+
+// equal returns the string value if the filter is a plain comparison of
+// attr with eq, e.g. userName eq "bjensen". Those are looked up with the
+// admin API instead of being evaluated on all resources.
+func (f *Filter) equal(attr string) (string, bool) {
+	c, ok := f.root.(*compare)
+	if !ok || c.op != "eq" || c.path.sub != "" || !strings.EqualFold(c.path.attr, attr) {
+		return "", false
+	}
+	s, ok := c.value.(string)
+	return s, ok
+}
+
+func invalidFilter(format string, args ...interface{}) *Error {
+	return newError(http.StatusBadRequest, "invalidFilter", format, args...)
+}
+
+type node interface {
+	match(obj map[string]interface{}) bool
+}
+
+// attrPath is an attribute and an optional sub-attribute, e.g. name.givenName.
+type attrPath struct {
+	attr string
+	sub  string
+}
+
+// parseAttrPath parses s and strips the schema URN of the core resources.
+func parseAttrPath(s string) attrPath {
+	for _, schema := range []string{SchemaUser, SchemaGroup} {
+		if len(s) > len(schema) && strings.EqualFold(s[:len(schema)+1], schema+":") {
+			s = s[len(schema)+1:]
+			break
+		}
+	}
+	if i := strings.Index(s, "."); i >= 0 {
+		return attrPath{attr: s[:i], sub: s[i+1:]}
+	}
+	return attrPath{attr: s}
+}
+
+// values returns the values of the path in obj. Multi-valued attributes
+// return one value per element, for elements without sub-attribute their
+// "value".
+func (p attrPath) values(obj map[string]interface{}) []interface{} {
+	v, ok := lookup(obj, p.attr)
+	if !ok {
+		return nil
+	}
+	list, multi := v.([]interface{})
+	if !multi {
+		list = []interface{}{v}
+	}
+
+	var values []interface{}
+	for _, v := range list {
+		m, complex := v.(map[string]interface{})
+		switch {
+		case p.sub != "" && complex:
+			if v, ok := lookup(m, p.sub); ok {
+				values = append(values, v)
+			}
+		case p.sub != "":
+		case complex && multi:
+			if v, ok := lookup(m, "value"); ok {
+				values = append(values, v)
+			}
+		default:
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// lookup returns the value of the case insensitive key in obj.
+func lookup(obj map[string]interface{}, key string) (interface{}, bool) {
+	if v, ok := obj[key]; ok {
+		return v, true
+	}
+	for k, v := range obj {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+type compare struct {
+	path  attrPath
+	op    string
+	value interface{}
+}
+
+func (c *compare) match(obj map[string]interface{}) bool {
+	values := c.path.values(obj)
+	if c.op == "pr" {
+		for _, v := range values {
+			if v != nil && v != "" {
+				return true
+			}
+		}
+		return false
+	}
+	if c.op == "ne" {
+		return !(&compare{path: c.path, op: "eq", value: c.value}).match(obj)
+	}
+	if len(values) == 0 {
+		return c.op == "eq" && c.value == nil
+	}
+	for _, v := range values {
+		if compareValue(v, c.op, c.value) {
+			return true
+		}
+	}
+	return false
+}
+
+func compareValue(actual interface{}, op string, want interface{}) bool {
+	switch want := want.(type) {
+	case nil:
+		return op == "eq" && actual == nil
+	case bool:
+		b, ok := actual.(bool)
+		return ok && op == "eq" && b == want
+	case float64:
+		f, ok := actual.(float64)
+		if !ok {
+			return false
+		}
+		return order(op, compareFloat(f, want))
+	case string:
+		s, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		s, want = strings.ToLower(s), strings.ToLower(want)
+		switch op {
+		case "eq":
+			return s == want
+		case "co":
+			return strings.Contains(s, want)
+		case "sw":
+			return strings.HasPrefix(s, want)
+		case "ew":
+			return strings.HasSuffix(s, want)
+		}
+		return order(op, strings.Compare(s, want))
+	}
+	return false
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// order reports whether the result of a comparison satisfies op.
+func order(op string, cmp int) bool {
+	switch op {
+	case "eq":
+		return cmp == 0
+	case "gt":
+		return cmp > 0
+	case "ge":
+		return cmp >= 0
+	case "lt":
+		return cmp < 0
+	case "le":
+		return cmp <= 0
+	}
+	return false
+}
+
+type logical struct {
+	and         bool
+	left, right node
+}
+
+func (l *logical) match(obj map[string]interface{}) bool {
+	if l.and {
+		return l.left.match(obj) && l.right.match(obj)
+	}
+	return l.left.match(obj) || l.right.match(obj)
+}
+
+type not struct {
+	node node
+}
+
+func (n *not) match(obj map[string]interface{}) bool {
+	return !n.node.match(obj)
+}
+
+// valuePath filters the elements of a multi-valued attribute, e.g.
+// emails[type eq "work"].
+type valuePath struct {
+	attr   string
+	filter node
+}
+
+func (v *valuePath) match(obj map[string]interface{}) bool {
+	return len(v.elements(obj)) > 0
+}
+
+// elements returns the indexes of the matching elements.
+func (v *valuePath) elements(obj map[string]interface{}) []int {
+	list, _ := lookupList(obj, v.attr)
+	var indexes []int
+	for i, e := range list {
+		if m, ok := e.(map[string]interface{}); ok && v.filter.match(m) {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+func lookupList(obj map[string]interface{}, attr string) ([]interface{}, bool) {
+	v, ok := lookup(obj, attr)
+	list, _ := v.([]interface{})
+	return list, ok
+}
+
+var operators = map[string]bool{
+	"eq": true, "ne": true, "co": true, "sw": true, "ew": true,
+	"gt": true, "ge": true, "lt": true, "le": true, "pr": true,
+}
+
+// parser is a recursive descent parser of filter expressions.
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func newParser(s string) (*parser, error) {
+	var tokens []string
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '(' || c == ')' || c == '[' || c == ']':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(s) {
+				return nil, invalidFilter("unterminated string")
+			}
+			tokens = append(tokens, s[i:j+1])
+			i = j + 1
+		default:
+			j := i
+			for j < len(s) && !strings.ContainsRune(" \t\n()[]\"", rune(s[j])) {
+				j++
+			}
+			tokens = append(tokens, s[i:j])
+			i = j
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, invalidFilter("empty filter")
+	}
+	return &parser{tokens: tokens}, nil
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() string {
+	if p.done() {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *parser) keyword(k string) bool {
+	if strings.EqualFold(p.peek(), k) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(t string) error {
+	if got := p.next(); got != t {
+		return invalidFilter("expected %q, got %q", t, got)
+	}
+	return nil
+}
+
+func (p *parser) or() (node, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") {
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		left = &logical{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) and() (node, error) {
+	left, err := p.factor()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and") {
+		right, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		left = &logical{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) factor() (node, error) {
+	switch {
+	case p.keyword("not"):
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		n, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		return &not{node: n}, p.expect(")")
+	case p.peek() == "(":
+		p.next()
+		n, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		return n, p.expect(")")
+	}
+
+	attr := p.next()
+	if !isAttr(attr) {
+		return nil, invalidFilter("expected attribute, got %q", attr)
+	}
+	if p.peek() == "[" {
+		p.next()
+		filter, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		return &valuePath{attr: parseAttrPath(attr).attr, filter: filter}, p.expect("]")
+	}
+
+	op := strings.ToLower(p.next())
+	if !operators[op] {
+		return nil, invalidFilter("unknown operator %q", op)
+	}
+	c := &compare{path: parseAttrPath(attr), op: op}
+	if op == "pr" {
+		return c, nil
+	}
+	value, err := parseValue(p.next())
+	if err != nil {
+		return nil, err
+	}
+	c.value = value
+	return c, nil
+}
+
+func isAttr(s string) bool {
+	if s == "" || !unicode.IsLetter(rune(s[0])) {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("_-.:$", r) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseValue(t string) (interface{}, error) {
+	switch {
+	case strings.HasPrefix(t, `"`):
+		var s string
+		if err := json.Unmarshal([]byte(t), &s); err != nil {
+			return nil, invalidFilter("invalid string %s", t)
+		}
+		return s, nil
+	case strings.EqualFold(t, "true"):
+		return true, nil
+	case strings.EqualFold(t, "false"):
+		return false, nil
+	case strings.EqualFold(t, "null"):
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(t, 64)
+	if err != nil {
+		return nil, invalidFilter("invalid value %q", t)
+	}
+	return f, nil
+}
+
+// toObject returns the JSON object of v.
+func toObject(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]interface{}
+	return obj, json.Unmarshal(b, &obj)
+}
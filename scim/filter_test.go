@@ -0,0 +1,84 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+func TestFilter_Match(t *testing.T) {
+	user := &User{
+		Schemas:  []string{SchemaUser},
+		ID:       "1",
+		UserName: "bjensen",
+		Name:     &Name{GivenName: "Barbara", FamilyName: "Jensen"},
+		Emails: []MultiValued{
+			{Value: "bjensen@example.com", Type: "work", Primary: true},
+			{Value: "babs@home.example", Type: "home"},
+		},
+		Active: keycloak.Bool(true),
+		Meta:   &Meta{ResourceType: "User", Created: "2021-01-23T04:56:22Z"},
+	}
+
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{`userName eq "bjensen"`, true},
+		{`USERNAME EQ "BJensen"`, true},
+		{`userName ne "bjensen"`, false},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:userName sw "bj"`, true},
+		{`name.familyName co "ens"`, true},
+		{`name.givenName ew "x"`, false},
+		{`emails co "@home.example"`, true},
+		{`emails.type eq "home"`, true},
+		{`emails[type eq "work" and value ew "example.com"]`, true},
+		{`emails[type eq "home" and primary eq true]`, false},
+		{`active eq true and not (userName eq "other")`, true},
+		{`userName eq "other" or (active eq true and externalId pr)`, false},
+		{`externalId pr`, false},
+		{`meta.created gt "2021-01-01T00:00:00Z"`, true},
+		{`meta.created lt "2021-01-01T00:00:00Z"`, false},
+		{`title eq null`, true},
+	}
+	for _, tt := range tests {
+		f, err := ParseFilter(tt.filter)
+		if err != nil {
+			t.Errorf("%s: %v", tt.filter, err)
+			continue
+		}
+		if got := f.Match(user); got != tt.want {
+			t.Errorf("%s: got: %t, want: %t", tt.filter, got, tt.want)
+		}
+	}
+}
+
+func TestParseFilter_Error(t *testing.T) {
+	for _, filter := range []string{
+		``,
+		`userName`,
+		`userName eq`,
+		`userName xx "a"`,
+		`userName eq "a`,
+		`(userName eq "a"`,
+		`emails[type eq "work"`,
+		`userName eq "a" extra`,
+	} {
+		_, err := ParseFilter(filter)
+		e, ok := err.(*Error)
+		if !ok || e.ScimType != "invalidFilter" {
+			t.Errorf("%q: got: %v, want invalidFilter", filter, err)
+		}
+	}
+}
+
+func TestFilter_equal(t *testing.T) {
+	f, _ := ParseFilter(`userName eq "bjensen"`)
+	if v, ok := f.equal("username"); !ok || v != "bjensen" {
+		t.Errorf("got: %q, %t", v, ok)
+	}
+	f, _ = ParseFilter(`userName eq "bjensen" and active eq true`)
+	if _, ok := f.equal("userName"); ok {
+		t.Error("expected no lookup for compound filter")
+	}
+}
@@ -0,0 +1,230 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Patch applies the operations of req to the resource v, e.g. a *User, in
+// place. Operation names are case insensitive and paths may filter
+// multi-valued attributes, e.g. members[value eq "id"] or
+// emails[type eq "work"].value.
+func Patch(v interface{}, req *PatchRequest) error {
+	obj, err := toObject(v)
+	if err != nil {
+		return err
+	}
+	for _, op := range req.Operations {
+		if err := patch(obj, op); err != nil {
+			return err
+		}
+	}
+
+	// some clients send booleans as strings, e.g. "active": "False"
+	if s, ok := obj["active"].(string); ok {
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return invalidValue("invalid active %q", s)
+		}
+		obj["active"] = b
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	// clear v first, json.Unmarshal keeps fields missing from b
+	rv := reflect.ValueOf(v).Elem()
+	rv.Set(reflect.Zero(rv.Type()))
+	if err := json.Unmarshal(b, v); err != nil {
+		return invalidValue("%v", err)
+	}
+	return nil
+}
+
+func invalidValue(format string, args ...interface{}) *Error {
+	return newError(http.StatusBadRequest, "invalidValue", format, args...)
+}
+
+// patchPath is the target of an operation, e.g. emails[type eq "work"].value.
+type patchPath struct {
+	attr   string
+	filter *valuePath
+	sub    string
+}
+
+func parsePatchPath(s string) (*patchPath, error) {
+	if i := strings.Index(s, "["); i >= 0 {
+		j := strings.LastIndex(s, "]")
+		if j < i {
+			return nil, newError(http.StatusBadRequest, "invalidPath", "invalid path %q", s)
+		}
+		f, err := ParseFilter(s[i+1 : j])
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidPath", "invalid path %q", s)
+		}
+		attr := parseAttrPath(s[:i]).attr
+		return &patchPath{
+			attr:   attr,
+			filter: &valuePath{attr: attr, filter: f.root},
+			sub:    strings.TrimPrefix(s[j+1:], "."),
+		}, nil
+	}
+	p := parseAttrPath(s)
+	return &patchPath{attr: p.attr, sub: p.sub}, nil
+}
+
+func patch(obj map[string]interface{}, op PatchOperation) error {
+	name := strings.ToLower(op.Op)
+	if name != "add" && name != "replace" && name != "remove" {
+		return invalidValue("unknown operation %q", op.Op)
+	}
+
+	if op.Path == "" {
+		if name == "remove" {
+			return newError(http.StatusBadRequest, "noTarget", "remove requires a path")
+		}
+		values, ok := op.Value.(map[string]interface{})
+		if !ok {
+			return invalidValue("value must be an object without path")
+		}
+		for k, v := range values {
+			if err := patch(obj, PatchOperation{Op: name, Path: k, Value: v}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	p, err := parsePatchPath(op.Path)
+	if err != nil {
+		return err
+	}
+	key := canonical(obj, p.attr)
+
+	if p.filter != nil {
+		return patchElements(obj, key, p, name, op.Value)
+	}
+
+	if p.sub != "" {
+		m, _ := obj[key].(map[string]interface{})
+		if m == nil {
+			if name == "remove" {
+				return nil
+			}
+			m = map[string]interface{}{}
+			obj[key] = m
+		}
+		sub := canonical(m, p.sub)
+		if name == "remove" {
+			delete(m, sub)
+		} else {
+			m[sub] = op.Value
+		}
+		return nil
+	}
+
+	switch name {
+	case "remove":
+		delete(obj, key)
+	case "add":
+		if list, ok := obj[key].([]interface{}); ok {
+			obj[key] = append(list, asList(op.Value)...)
+			return nil
+		}
+		if m, ok := obj[key].(map[string]interface{}); ok {
+			if values, ok := op.Value.(map[string]interface{}); ok {
+				for k, v := range values {
+					m[canonical(m, k)] = v
+				}
+				return nil
+			}
+		}
+		obj[key] = op.Value
+	case "replace":
+		obj[key] = op.Value
+	}
+	return nil
+}
+
+// patchElements applies an operation to the elements of a multi-valued
+// attribute matching the filter of p.
+func patchElements(obj map[string]interface{}, key string, p *patchPath, name string, value interface{}) error {
+	list, _ := obj[key].([]interface{})
+	indexes := p.filter.elements(obj)
+	if len(indexes) == 0 {
+		if name == "remove" {
+			return nil
+		}
+		// clients set e.g. emails[type eq "work"].value on users without a
+		// work email, create the element the filter asks for
+		c, ok := p.filter.filter.(*compare)
+		if !ok || c.op != "eq" || c.path.sub != "" {
+			return newError(http.StatusBadRequest, "noTarget", "no %s match the filter", key)
+		}
+		list = append(list, map[string]interface{}{c.path.attr: c.value})
+		obj[key] = list
+		indexes = []int{len(list) - 1}
+	}
+
+	if name == "remove" && p.sub == "" {
+		keep := make([]interface{}, 0, len(list))
+		next := 0
+		for i, e := range list {
+			if next < len(indexes) && indexes[next] == i {
+				next++
+				continue
+			}
+			keep = append(keep, e)
+		}
+		obj[key] = keep
+		return nil
+	}
+
+	for _, i := range indexes {
+		m := list[i].(map[string]interface{})
+		switch {
+		case name == "remove":
+			delete(m, canonical(m, p.sub))
+		case p.sub != "":
+			m[canonical(m, p.sub)] = value
+		default:
+			values, ok := value.(map[string]interface{})
+			if !ok {
+				return invalidValue("value must be an object")
+			}
+			if name == "replace" {
+				list[i] = values
+				continue
+			}
+			for k, v := range values {
+				m[canonical(m, k)] = v
+			}
+		}
+	}
+	return nil
+}
+
+// canonical returns the key in obj matching name case insensitively, or
+// name if there is none.
+func canonical(obj map[string]interface{}, name string) string {
+	if _, ok := obj[name]; ok {
+		return name
+	}
+	for k := range obj {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+	return name
+}
+
+func asList(v interface{}) []interface{} {
+	if list, ok := v.([]interface{}); ok {
+		return list
+	}
+	return []interface{}{v}
+}
@@ -0,0 +1,77 @@
+package scim
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestPatch(t *testing.T) {
+	user := &User{
+		Schemas:  []string{SchemaUser},
+		UserName: "bjensen",
+		Name:     &Name{GivenName: "Barbara", FamilyName: "Jensen"},
+		Emails:   []MultiValued{{Value: "bjensen@example.com", Type: "work", Primary: true}},
+	}
+
+	var req PatchRequest
+	err := json.Unmarshal([]byte(`{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "Replace", "path": "active", "value": "False"},
+			{"op": "replace", "path": "name.givenName", "value": "Babs"},
+			{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "babs@example.com"},
+			{"op": "add", "path": "emails", "value": [{"value": "babs@home.example", "type": "home"}]},
+			{"op": "replace", "value": {"externalId": "e-1", "displayName": "Babs Jensen"}},
+			{"op": "remove", "path": "name.familyName"}
+		]
+	}`), &req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Patch(user, &req); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &User{
+		Schemas:     []string{SchemaUser},
+		ExternalID:  "e-1",
+		UserName:    "bjensen",
+		Name:        &Name{GivenName: "Babs"},
+		DisplayName: "Babs Jensen",
+		Emails: []MultiValued{
+			{Value: "babs@example.com", Type: "work", Primary: true},
+			{Value: "babs@home.example", Type: "home"},
+		},
+		Active: new(bool),
+	}
+	if !reflect.DeepEqual(user, want) {
+		t.Errorf("got: %+v, want: %+v", user, want)
+	}
+}
+
+func TestPatch_members(t *testing.T) {
+	group := &Group{
+		DisplayName: "staff",
+		Members:     []MultiValued{{Value: "1"}, {Value: "2"}},
+	}
+	req := &PatchRequest{Operations: []PatchOperation{
+		{Op: "add", Path: "members", Value: []interface{}{map[string]interface{}{"value": "3"}}},
+		{Op: "remove", Path: `members[value eq "1"]`},
+	}}
+	if err := Patch(group, req); err != nil {
+		t.Fatal(err)
+	}
+	want := []MultiValued{{Value: "2"}, {Value: "3"}}
+	if !reflect.DeepEqual(group.Members, want) {
+		t.Errorf("got: %+v, want: %+v", group.Members, want)
+	}
+
+	// new elements are only created for simple filters
+	req = &PatchRequest{Operations: []PatchOperation{
+		{Op: "replace", Path: `members[value sw "9"].display`, Value: "x"},
+	}}
+	if err, ok := Patch(group, req).(*Error); !ok || err.ScimType != "noTarget" {
+		t.Errorf("got: %v, want noTarget", err)
+	}
+}
@@ -0,0 +1,244 @@
+package scim
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+// Schemas of the resources and messages.
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// AttributeExternalID is the Keycloak user and group attribute the SCIM
+// externalId is stored in.
+const AttributeExternalID = "scim.externalId"
+
+// Meta holds the resource metadata.
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	Location     string `json:"location,omitempty"`
+}
+
+// Name is the name of a user.
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+// MultiValued is an element of a multi-valued attribute, e.g. an email of
+// a user or a member of a group.
+type MultiValued struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// User is a SCIM user resource.
+//
+// https://datatracker.ietf.org/doc/html/rfc7643#section-4.1
+type User struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	ExternalID  string        `json:"externalId,omitempty"`
+	UserName    string        `json:"userName"`
+	Name        *Name         `json:"name,omitempty"`
+	DisplayName string        `json:"displayName,omitempty"`
+	Emails      []MultiValued `json:"emails,omitempty"`
+	Active      *bool         `json:"active,omitempty"`
+	Groups      []MultiValued `json:"groups,omitempty"`
+	Meta        *Meta         `json:"meta,omitempty"`
+}
+
+// Group is a SCIM group resource.
+//
+// https://datatracker.ietf.org/doc/html/rfc7643#section-4.2
+type Group struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	ExternalID  string        `json:"externalId,omitempty"`
+	DisplayName string        `json:"displayName"`
+	Members     []MultiValued `json:"members,omitempty"`
+	Meta        *Meta         `json:"meta,omitempty"`
+}
+
+// ListResponse is the response of a query.
+type ListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+// PatchOperation is a single operation of a PatchRequest.
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// PatchRequest modifies a resource.
+//
+// https://datatracker.ietf.org/doc/html/rfc7644#section-3.5.2
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// Error is a SCIM error response.
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("scim: %s %s", e.Status, e.Detail)
+}
+
+// newError returns an error response with status and the SCIM error type,
+// e.g. "invalidFilter".
+func newError(status int, scimType, format string, args ...interface{}) *Error {
+	return &Error{
+		Schemas:  []string{SchemaError},
+		Status:   fmt.Sprint(status),
+		ScimType: scimType,
+		Detail:   fmt.Sprintf(format, args...),
+	}
+}
+
+// ToUser returns the SCIM user of u, member of groups.
+func ToUser(u *keycloak.User, groups []*keycloak.Group) *User {
+	user := &User{
+		Schemas:    []string{SchemaUser},
+		ID:         u.GetID(),
+		ExternalID: attribute(u.Attributes, AttributeExternalID),
+		UserName:   u.GetUsername(),
+		Active:     keycloak.Bool(u.GetEnabled()),
+		Meta:       &Meta{ResourceType: "User"},
+	}
+	if u.FirstName != nil || u.LastName != nil {
+		user.Name = &Name{
+			GivenName:  u.GetFirstName(),
+			FamilyName: u.GetLastName(),
+			Formatted:  strings.TrimSpace(u.GetFirstName() + " " + u.GetLastName()),
+		}
+		user.DisplayName = user.Name.Formatted
+	}
+	if u.GetEmail() != "" {
+		user.Emails = []MultiValued{{Value: u.GetEmail(), Type: "work", Primary: true}}
+	}
+	if u.CreatedTimestamp != nil {
+		user.Meta.Created = time.Unix(0, u.GetCreatedTimestamp()*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+	}
+	for _, g := range groups {
+		user.Groups = append(user.Groups, MultiValued{Value: g.GetID(), Display: g.GetName()})
+	}
+	return user
+}
+
+// FromUser applies the SCIM user to u. Fields of u not mapped to SCIM are
+// left alone, so u may be the current representation of the user.
+func FromUser(user *User, u *keycloak.User) {
+	u.Username = keycloak.String(user.UserName)
+	if user.Active != nil {
+		u.Enabled = keycloak.Bool(*user.Active)
+	}
+
+	u.FirstName, u.LastName = nil, nil
+	if user.Name != nil {
+		u.FirstName = keycloak.String(user.Name.GivenName)
+		u.LastName = keycloak.String(user.Name.FamilyName)
+	}
+
+	u.Email = keycloak.String("")
+	for i, email := range user.Emails {
+		if i == 0 || email.Primary {
+			u.Email = keycloak.String(email.Value)
+		}
+		if email.Primary {
+			break
+		}
+	}
+
+	u.Attributes = setAttribute(u.Attributes, AttributeExternalID, user.ExternalID)
+}
+
+// ToGroup returns the SCIM group of g with members.
+func ToGroup(g *keycloak.Group, members []*keycloak.User) *Group {
+	group := &Group{
+		Schemas:     []string{SchemaGroup},
+		ID:          g.GetID(),
+		ExternalID:  attribute(g.Attributes, AttributeExternalID),
+		DisplayName: g.GetName(),
+		Meta:        &Meta{ResourceType: "Group"},
+	}
+	for _, u := range members {
+		group.Members = append(group.Members, MultiValued{Value: u.GetID(), Display: u.GetUsername()})
+	}
+	return group
+}
+
+func attribute(attributes *map[string][]string, name string) string {
+	if attributes == nil || len((*attributes)[name]) == 0 {
+		return ""
+	}
+	return (*attributes)[name][0]
+}
+
+func setAttribute(attributes *map[string][]string, name, value string) *map[string][]string {
+	if attributes == nil {
+		if value == "" {
+			return nil
+		}
+		attributes = &map[string][]string{}
+	}
+	if value == "" {
+		delete(*attributes, name)
+	} else {
+		(*attributes)[name] = []string{value}
+	}
+	return attributes
+}
+
+// serviceProviderConfig describes the supported features.
+var serviceProviderConfig = map[string]interface{}{
+	"schemas":               []string{SchemaServiceProviderConfig},
+	"patch":                 map[string]bool{"supported": true},
+	"bulk":                  map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+	"filter":                map[string]interface{}{"supported": true, "maxResults": maxResults},
+	"changePassword":        map[string]bool{"supported": false},
+	"sort":                  map[string]bool{"supported": false},
+	"etag":                  map[string]bool{"supported": false},
+	"authenticationSchemes": []interface{}{},
+	"meta":                  map[string]string{"resourceType": "ServiceProviderConfig"},
+}
+
+// toError maps an error of the admin API to a SCIM error.
+func toError(err error) *Error {
+	switch {
+	case keycloak.IsNotFound(err):
+		return newError(http.StatusNotFound, "", "resource not found")
+	case keycloak.IsConflict(err):
+		return newError(http.StatusConflict, "uniqueness", "%v", err)
+	case errors.Is(err, keycloak.ErrBadRequest):
+		return newError(http.StatusBadRequest, "invalidValue", "%v", err)
+	}
+	return newError(http.StatusInternalServerError, "", "%v", err)
+}
@@ -0,0 +1,549 @@
+// Package scim exposes the users and groups of a Keycloak realm as SCIM 2.0
+// resources, so that HR systems and identity providers like Azure AD or
+// Okta can provision accounts into Keycloak.
+//
+//	s := &scim.Server{Keycloak: k, Realm: "myrealm", BaseURL: "https://example.com/scim/v2"}
+//	http.Handle("/scim/v2/", http.StripPrefix("/scim/v2", requireToken(s)))
+//
+// The server doesn't authenticate requests, wrap it with a handler checking
+// the bearer token configured in the provisioning client.
+//
+// Users map to Keycloak users: userName to the username, name to the first
+// and last name, the primary email to the email and active to enabled.
+// Groups map to top level Keycloak groups. The externalId of both is stored
+// in the AttributeExternalID attribute.
+//
+// Lists without a filter are paged with the admin API. Equality on
+// userName, externalId and displayName is looked up with the admin API as
+// well, which is what provisioning clients mostly use. Other filters are
+// evaluated by the server on all users or groups of the realm.
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+// maxResults is the default and maximum page size of queries.
+const maxResults = 100
+
+// ContentType is the media type of SCIM messages.
+const ContentType = "application/scim+json"
+
+// Server is an http.Handler serving the SCIM endpoints /Users, /Groups and
+// /ServiceProviderConfig backed by the admin API.
+type Server struct {
+	// Keycloak is the admin client. Its token must be allowed to manage
+	// the users and groups of the realm.
+	Keycloak *keycloak.Keycloak
+
+	// Realm is the realm provisioned into.
+	Realm string
+
+	// BaseURL is the absolute URL the server is mounted at. It is used for
+	// the locations of the resources, which are omitted if empty.
+	BaseURL string
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	id := ""
+	if len(segments) == 2 {
+		id = segments[1]
+	}
+
+	var err error
+	switch {
+	case segments[0] == "Users" && len(segments) <= 2:
+		err = s.serveUsers(w, r, id)
+	case segments[0] == "Groups" && len(segments) <= 2:
+		err = s.serveGroups(w, r, id)
+	case segments[0] == "ServiceProviderConfig" && len(segments) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, serviceProviderConfig)
+	default:
+		err = newError(http.StatusNotFound, "", "no resource at %s", r.URL.Path)
+	}
+	if err != nil {
+		writeError(w, err)
+	}
+}
+
+func (s *Server) serveUsers(w http.ResponseWriter, r *http.Request, id string) error {
+	ctx := r.Context()
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		return s.listUsers(w, r)
+	case id == "" && r.Method == http.MethodPost:
+		var user User
+		if err := decode(r, &user); err != nil {
+			return err
+		}
+		created, err := s.createUser(ctx, &user)
+		if err != nil {
+			return err
+		}
+		w.Header().Set("Location", created.Meta.Location)
+		writeJSON(w, http.StatusCreated, created)
+		return nil
+	case id == "":
+		return methodNotAllowed()
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		user, err := s.getUser(ctx, id)
+		if err != nil {
+			return err
+		}
+		writeJSON(w, http.StatusOK, user)
+	case http.MethodPut:
+		var user User
+		if err := decode(r, &user); err != nil {
+			return err
+		}
+		updated, err := s.replaceUser(ctx, id, &user)
+		if err != nil {
+			return err
+		}
+		writeJSON(w, http.StatusOK, updated)
+	case http.MethodPatch:
+		var req PatchRequest
+		if err := decode(r, &req); err != nil {
+			return err
+		}
+		user, err := s.getUser(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := Patch(user, &req); err != nil {
+			return err
+		}
+		updated, err := s.replaceUser(ctx, id, user)
+		if err != nil {
+			return err
+		}
+		writeJSON(w, http.StatusOK, updated)
+	case http.MethodDelete:
+		if _, err := s.Keycloak.Users.Delete(ctx, s.Realm, id); err != nil {
+			return toError(err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		return methodNotAllowed()
+	}
+	return nil
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) error {
+	filter, start, count, err := query(r)
+	if err != nil {
+		return err
+	}
+	ctx := r.Context()
+
+	if filter == nil {
+		// page with the admin API instead of loading the whole realm
+		total, _, err := s.Keycloak.Users.Count(ctx, s.Realm, nil)
+		if err != nil {
+			return toError(err)
+		}
+		var users []*keycloak.User
+		if count > 0 {
+			opts := &keycloak.UserListOptions{Options: keycloak.Options{First: start - 1, Max: count}}
+			if users, _, err = s.Keycloak.Users.List(ctx, s.Realm, opts); err != nil {
+				return toError(err)
+			}
+		}
+		resources := make([]interface{}, len(users))
+		for i, u := range users {
+			resources[i] = s.toUser(u, nil)
+		}
+		writeJSON(w, http.StatusOK, listPage(resources, start, total))
+		return nil
+	}
+
+	opts := &keycloak.UserListOptions{}
+	if v, ok := filter.equal("userName"); ok {
+		opts.Username, opts.Exact = v, keycloak.Bool(true)
+	} else if v, ok := filter.equal("externalId"); ok {
+		opts.Q = keycloak.AttributeQuery(map[string]string{AttributeExternalID: v})
+	}
+	users, err := s.Keycloak.Users.ListAll(ctx, s.Realm, opts)
+	if err != nil {
+		return toError(err)
+	}
+
+	var resources []interface{}
+	for _, u := range users {
+		user := s.toUser(u, nil)
+		if filter.Match(user) {
+			resources = append(resources, user)
+		}
+	}
+	writeJSON(w, http.StatusOK, list(resources, start, count))
+	return nil
+}
+
+func (s *Server) getUser(ctx context.Context, id string) (*User, error) {
+	u, _, err := s.Keycloak.Users.GetByID(ctx, s.Realm, id)
+	if err != nil {
+		return nil, toError(err)
+	}
+	var groups []*keycloak.Group
+	err = keycloak.Paginate(ctx, keycloak.Options{}, func(ctx context.Context, page keycloak.Options) (int, error) {
+		next, _, err := s.Keycloak.Users.ListGroups(ctx, s.Realm, id, &keycloak.UserGroupsListOptions{Options: page})
+		groups = append(groups, next...)
+		return len(next), err
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+	return s.toUser(u, groups), nil
+}
+
+func (s *Server) createUser(ctx context.Context, user *User) (*User, error) {
+	if user.UserName == "" {
+		return nil, invalidValue("userName is required")
+	}
+	u := &keycloak.User{Enabled: keycloak.Bool(true)}
+	FromUser(user, u)
+	res, err := s.Keycloak.Users.Create(ctx, s.Realm, u)
+	if err != nil {
+		return nil, toError(err)
+	}
+	return s.getUser(ctx, res.LocationID)
+}
+
+func (s *Server) replaceUser(ctx context.Context, id string, user *User) (*User, error) {
+	if user.UserName == "" {
+		return nil, invalidValue("userName is required")
+	}
+	u, _, err := s.Keycloak.Users.GetByID(ctx, s.Realm, id)
+	if err != nil {
+		return nil, toError(err)
+	}
+	FromUser(user, u)
+	if _, err := s.Keycloak.Users.Update(ctx, s.Realm, u); err != nil {
+		return nil, toError(err)
+	}
+	return s.getUser(ctx, id)
+}
+
+func (s *Server) toUser(u *keycloak.User, groups []*keycloak.Group) *User {
+	user := ToUser(u, groups)
+	user.Meta.Location = s.location("Users", user.ID)
+	return user
+}
+
+func (s *Server) serveGroups(w http.ResponseWriter, r *http.Request, id string) error {
+	ctx := r.Context()
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		return s.listGroups(w, r)
+	case id == "" && r.Method == http.MethodPost:
+		var group Group
+		if err := decode(r, &group); err != nil {
+			return err
+		}
+		created, err := s.createGroup(ctx, &group)
+		if err != nil {
+			return err
+		}
+		w.Header().Set("Location", created.Meta.Location)
+		writeJSON(w, http.StatusCreated, created)
+		return nil
+	case id == "":
+		return methodNotAllowed()
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		group, err := s.getGroup(ctx, id)
+		if err != nil {
+			return err
+		}
+		writeJSON(w, http.StatusOK, group)
+	case http.MethodPut:
+		var group Group
+		if err := decode(r, &group); err != nil {
+			return err
+		}
+		current, err := s.getGroup(ctx, id)
+		if err != nil {
+			return err
+		}
+		updated, err := s.replaceGroup(ctx, current, &group)
+		if err != nil {
+			return err
+		}
+		writeJSON(w, http.StatusOK, updated)
+	case http.MethodPatch:
+		var req PatchRequest
+		if err := decode(r, &req); err != nil {
+			return err
+		}
+		current, err := s.getGroup(ctx, id)
+		if err != nil {
+			return err
+		}
+		group := *current
+		if err := Patch(&group, &req); err != nil {
+			return err
+		}
+		updated, err := s.replaceGroup(ctx, current, &group)
+		if err != nil {
+			return err
+		}
+		writeJSON(w, http.StatusOK, updated)
+	case http.MethodDelete:
+		if _, err := s.Keycloak.Groups.Delete(ctx, s.Realm, id); err != nil {
+			return toError(err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		return methodNotAllowed()
+	}
+	return nil
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request) error {
+	filter, start, count, err := query(r)
+	if err != nil {
+		return err
+	}
+	ctx := r.Context()
+
+	if filter == nil {
+		total, _, err := s.Keycloak.Groups.Count(ctx, s.Realm, &keycloak.GroupCountOptions{Top: keycloak.Bool(true)})
+		if err != nil {
+			return toError(err)
+		}
+		var groups []*keycloak.Group
+		if count > 0 {
+			opts := &keycloak.GroupListOptions{Options: keycloak.Options{First: start - 1, Max: count}}
+			if groups, _, err = s.Keycloak.Groups.List(ctx, s.Realm, opts); err != nil {
+				return toError(err)
+			}
+		}
+		resources := make([]interface{}, len(groups))
+		for i, g := range groups {
+			resources[i] = s.toGroup(g, nil)
+		}
+		writeJSON(w, http.StatusOK, listPage(resources, start, total))
+		return nil
+	}
+
+	opts := &keycloak.GroupListOptions{}
+	if v, ok := filter.equal("displayName"); ok {
+		opts.Search, opts.Exact = v, keycloak.Bool(true)
+	}
+	groups, err := s.Keycloak.Groups.ListAll(ctx, s.Realm, opts)
+	if err != nil {
+		return toError(err)
+	}
+
+	var resources []interface{}
+	for _, g := range groups {
+		group := s.toGroup(g, nil)
+		if filter.Match(group) {
+			resources = append(resources, group)
+		}
+	}
+	writeJSON(w, http.StatusOK, list(resources, start, count))
+	return nil
+}
+
+func (s *Server) getGroup(ctx context.Context, id string) (*Group, error) {
+	g, _, err := s.Keycloak.Groups.Get(ctx, s.Realm, id)
+	if err != nil {
+		return nil, toError(err)
+	}
+	members, err := s.Keycloak.Groups.ListAllMembers(ctx, s.Realm, id, &keycloak.GroupMembersListOptions{BriefRepresentation: keycloak.Bool(true)})
+	if err != nil {
+		return nil, toError(err)
+	}
+	return s.toGroup(g, members), nil
+}
+
+func (s *Server) createGroup(ctx context.Context, group *Group) (*Group, error) {
+	if group.DisplayName == "" {
+		return nil, invalidValue("displayName is required")
+	}
+	g := &keycloak.Group{
+		Name:       keycloak.String(group.DisplayName),
+		Attributes: setAttribute(nil, AttributeExternalID, group.ExternalID),
+	}
+	res, err := s.Keycloak.Groups.Create(ctx, s.Realm, g)
+	if err != nil {
+		return nil, toError(err)
+	}
+	id := res.LocationID
+	if err := s.syncMembers(ctx, id, nil, group.Members); err != nil {
+		return nil, err
+	}
+	return s.getGroup(ctx, id)
+}
+
+// replaceGroup updates the current group to group.
+func (s *Server) replaceGroup(ctx context.Context, current, group *Group) (*Group, error) {
+	if group.DisplayName == "" {
+		return nil, invalidValue("displayName is required")
+	}
+	g, _, err := s.Keycloak.Groups.Get(ctx, s.Realm, current.ID)
+	if err != nil {
+		return nil, toError(err)
+	}
+	if g.GetName() != group.DisplayName || attribute(g.Attributes, AttributeExternalID) != group.ExternalID {
+		g.Name = keycloak.String(group.DisplayName)
+		g.Attributes = setAttribute(g.Attributes, AttributeExternalID, group.ExternalID)
+		if _, err := s.Keycloak.Groups.Update(ctx, s.Realm, g); err != nil {
+			return nil, toError(err)
+		}
+	}
+	if err := s.syncMembers(ctx, current.ID, current.Members, group.Members); err != nil {
+		return nil, err
+	}
+	return s.getGroup(ctx, current.ID)
+}
+
+// syncMembers adds the users of members missing from current to the group
+// and removes the others.
+func (s *Server) syncMembers(ctx context.Context, groupID string, current, members []MultiValued) error {
+	want := map[string]bool{}
+	for _, m := range members {
+		want[m.Value] = true
+	}
+	have := map[string]bool{}
+	for _, m := range current {
+		have[m.Value] = true
+		if !want[m.Value] {
+			if _, err := s.Keycloak.Users.LeaveGroup(ctx, s.Realm, m.Value, groupID); err != nil && !keycloak.IsNotFound(err) {
+				return toError(err)
+			}
+		}
+	}
+	for _, m := range members {
+		if have[m.Value] {
+			continue
+		}
+		have[m.Value] = true
+		if _, err := s.Keycloak.Users.JoinGroup(ctx, s.Realm, m.Value, groupID); err != nil {
+			if keycloak.IsNotFound(err) {
+				return invalidValue("member %s not found", m.Value)
+			}
+			return toError(err)
+		}
+	}
+	return nil
+}
+
+func (s *Server) toGroup(g *keycloak.Group, members []*keycloak.User) *Group {
+	group := ToGroup(g, members)
+	group.Meta.Location = s.location("Groups", group.ID)
+	for i := range group.Members {
+		group.Members[i].Ref = s.location("Users", group.Members[i].Value)
+	}
+	return group
+}
+
+func (s *Server) location(resource, id string) string {
+	if s.BaseURL == "" {
+		return ""
+	}
+	return strings.TrimSuffix(s.BaseURL, "/") + "/" + resource + "/" + id
+}
+
+// query returns the filter and the 1-based page of a list request.
+func query(r *http.Request) (*Filter, int, int, error) {
+	q := r.URL.Query()
+
+	var filter *Filter
+	if s := q.Get("filter"); s != "" {
+		f, err := ParseFilter(s)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		filter = f
+	}
+
+	start, count := 1, maxResults
+	if s := q.Get("startIndex"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, 0, 0, invalidValue("invalid startIndex %q", s)
+		}
+		if n > 1 {
+			start = n
+		}
+	}
+	if s := q.Get("count"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, 0, 0, invalidValue("invalid count %q", s)
+		}
+		if n < 0 {
+			n = 0
+		}
+		if n < count {
+			count = n
+		}
+	}
+	return filter, start, count, nil
+}
+
+// list returns the page of resources starting at the 1-based start.
+func list(resources []interface{}, start, count int) *ListResponse {
+	total := len(resources)
+	first := start - 1
+	if first > total {
+		first = total
+	}
+	end := first + count
+	if end > total {
+		end = total
+	}
+	return listPage(resources[first:end], start, total)
+}
+
+// listPage returns the response for a page of total resources starting at
+// the 1-based start.
+func listPage(page []interface{}, start, total int) *ListResponse {
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   start,
+		ItemsPerPage: len(page),
+		Resources:    append([]interface{}{}, page...),
+	}
+}
+
+func decode(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "%v", err)
+	}
+	return nil
+}
+
+func methodNotAllowed() error {
+	return newError(http.StatusMethodNotAllowed, "", "method not allowed")
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*Error)
+	if !ok {
+		e = toError(err)
+	}
+	code, _ := strconv.Atoi(e.Status)
+	writeJSON(w, code, e)
+}
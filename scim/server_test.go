@@ -0,0 +1,179 @@
+package scim_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zemirco/keycloak/v2"
+	"github.com/zemirco/keycloak/v2/keycloaktest"
+	"github.com/zemirco/keycloak/v2/scim"
+)
+
+func do(t *testing.T, h http.Handler, method, path string, body interface{}, v interface{}) int {
+	t.Helper()
+	var b bytes.Buffer
+	if body != nil {
+		if s, ok := body.(string); ok {
+			b.WriteString(s)
+		} else if err := json.NewEncoder(&b).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	req := httptest.NewRequest(method, path, &b)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if got := rec.Header().Get("Content-Type"); rec.Code != http.StatusNoContent && got != scim.ContentType {
+		t.Errorf("got: %s, want: %s", got, scim.ContentType)
+	}
+	if v != nil {
+		if err := json.Unmarshal(rec.Body.Bytes(), v); err != nil {
+			t.Fatalf("%s %s: %v: %s", method, path, err, rec.Body)
+		}
+	}
+	return rec.Code
+}
+
+func TestServer(t *testing.T) {
+	srv := keycloaktest.NewServer()
+	defer srv.Close()
+
+	s := &scim.Server{Keycloak: srv.Keycloak(), Realm: "master", BaseURL: "https://example.com/scim/v2"}
+
+	var user scim.User
+	code := do(t, s, http.MethodPost, "/Users", `{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+		"externalId": "e-1",
+		"userName": "bjensen",
+		"name": {"givenName": "Barbara", "familyName": "Jensen"},
+		"emails": [{"value": "bjensen@example.com", "type": "work", "primary": true}],
+		"active": true
+	}`, &user)
+	if code != http.StatusCreated {
+		t.Fatalf("got: %d, want: %d", code, http.StatusCreated)
+	}
+	if user.ID == "" || user.ExternalID != "e-1" || user.Name.FamilyName != "Jensen" || !*user.Active {
+		t.Errorf("got: %+v", user)
+	}
+	if want := "https://example.com/scim/v2/Users/" + user.ID; user.Meta.Location != want {
+		t.Errorf("got: %s, want: %s", user.Meta.Location, want)
+	}
+
+	var e scim.Error
+	if code := do(t, s, http.MethodPost, "/Users", map[string]string{"userName": "bjensen"}, &e); code != http.StatusConflict || e.ScimType != "uniqueness" {
+		t.Errorf("got: %d %+v, want: %d", code, e, http.StatusConflict)
+	}
+
+	do(t, s, http.MethodPost, "/Users", map[string]string{"userName": "other", "externalId": "e 2:x"}, nil)
+
+	var list scim.ListResponse
+	do(t, s, http.MethodGet, `/Users?filter=userName+eq+"bjensen"`, nil, &list)
+	if list.TotalResults != 1 {
+		t.Errorf("got: %d, want: %d", list.TotalResults, 1)
+	}
+	do(t, s, http.MethodGet, `/Users?filter=externalId+eq+"e-1"`, nil, &list)
+	if list.TotalResults != 1 {
+		t.Errorf("got: %d, want: %d", list.TotalResults, 1)
+	}
+	do(t, s, http.MethodGet, `/Users?filter=externalId+eq+"e+2:x"`, nil, &list)
+	if list.TotalResults != 1 {
+		t.Errorf("got: %d, want: %d", list.TotalResults, 1)
+	}
+	do(t, s, http.MethodGet, "/Users?startIndex=2&count=5", nil, &list)
+	if list.TotalResults != 2 || list.ItemsPerPage != 1 || list.StartIndex != 2 {
+		t.Errorf("got: %+v", list)
+	}
+	if code := do(t, s, http.MethodGet, `/Users?filter=userName+eq`, nil, &e); code != http.StatusBadRequest || e.ScimType != "invalidFilter" {
+		t.Errorf("got: %d %+v, want: %d", code, e, http.StatusBadRequest)
+	}
+
+	var group scim.Group
+	code = do(t, s, http.MethodPost, "/Groups", map[string]interface{}{
+		"displayName": "staff",
+		"members":     []map[string]string{{"value": user.ID}},
+	}, &group)
+	if code != http.StatusCreated {
+		t.Fatalf("got: %d, want: %d", code, http.StatusCreated)
+	}
+	if len(group.Members) != 1 || group.Members[0].Display != "bjensen" {
+		t.Errorf("got: %+v", group.Members)
+	}
+
+	do(t, s, http.MethodGet, "/Users/"+user.ID, nil, &user)
+	if len(user.Groups) != 1 || user.Groups[0].Value != group.ID {
+		t.Errorf("got: %+v", user.Groups)
+	}
+
+	// Azure AD deactivates users with a string value
+	do(t, s, http.MethodPatch, "/Users/"+user.ID, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "Replace", "path": "active", "value": "False"}]
+	}`, &user)
+	if *user.Active || user.Name.GivenName != "Barbara" {
+		t.Errorf("got: %+v", user)
+	}
+
+	var updated scim.Group
+	do(t, s, http.MethodPatch, "/Groups/"+group.ID, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "remove", "path": "members[value eq \"`+user.ID+`\"]"},
+			{"op": "replace", "path": "displayName", "value": "employees"}
+		]
+	}`, &updated)
+	if updated.DisplayName != "employees" || len(updated.Members) != 0 {
+		t.Errorf("got: %+v", updated)
+	}
+
+	do(t, s, http.MethodGet, `/Groups?filter=displayName+eq+"employees"`, nil, &list)
+	if list.TotalResults != 1 {
+		t.Errorf("got: %d, want: %d", list.TotalResults, 1)
+	}
+
+	if code := do(t, s, http.MethodDelete, "/Users/"+user.ID, nil, nil); code != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", code, http.StatusNoContent)
+	}
+	if code := do(t, s, http.MethodGet, "/Users/"+user.ID, nil, &e); code != http.StatusNotFound {
+		t.Errorf("got: %d, want: %d", code, http.StatusNotFound)
+	}
+}
+
+func TestServer_Paging(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI())
+		switch r.URL.Path {
+		case "/admin/realms/first/users/count":
+			w.Write([]byte(`5`))
+		case "/admin/realms/first/users":
+			w.Write([]byte(`[{"id":"3","username":"three"},{"id":"4","username":"four"}]`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	k, err := keycloak.NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &scim.Server{Keycloak: k, Realm: "first"}
+
+	var list scim.ListResponse
+	do(t, s, http.MethodGet, "/Users?startIndex=3&count=2", nil, &list)
+	if list.TotalResults != 5 || list.StartIndex != 3 || list.ItemsPerPage != 2 {
+		t.Errorf("got: %+v", list)
+	}
+
+	want := []string{"/admin/realms/first/users/count", "/admin/realms/first/users?first=2&max=2"}
+	if len(requests) != len(want) {
+		t.Fatalf("got: %v, want: %v", requests, want)
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Errorf("got: %s, want: %s", requests[i], want[i])
+		}
+	}
+}
@@ -2,7 +2,6 @@ package keycloak
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 )
 
@@ -20,8 +19,8 @@ type Scope struct {
 }
 
 // List lists all resources.
-func (s *ScopesService) List(ctx context.Context, realm, clientID string) ([]*Scope, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/scope", realm, clientID)
+func (s *ScopesService) List(ctx context.Context, realm, clientID string) ([]*Scope, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/scope", realm, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -37,8 +36,8 @@ func (s *ScopesService) List(ctx context.Context, realm, clientID string) ([]*Sc
 }
 
 // Create creates a new scope.
-func (s *ScopesService) Create(ctx context.Context, realm, clientID string, scope *Scope) (*Scope, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/scope", realm, clientID)
+func (s *ScopesService) Create(ctx context.Context, realm, clientID string, scope *Scope) (*Scope, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/scope", realm, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, scope)
 	if err != nil {
 		return nil, nil, err
@@ -54,8 +53,8 @@ func (s *ScopesService) Create(ctx context.Context, realm, clientID string, scop
 }
 
 // Get gets a single scope.
-func (s *ScopesService) Get(ctx context.Context, realm, clientID, scopeID string) (*Scope, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/scope/%s", realm, clientID, scopeID)
+func (s *ScopesService) Get(ctx context.Context, realm, clientID, scopeID string) (*Scope, *Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/scope/%s", realm, clientID, scopeID)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -71,8 +70,8 @@ func (s *ScopesService) Get(ctx context.Context, realm, clientID, scopeID string
 }
 
 // Delete deletes a single scope.
-func (s *ScopesService) Delete(ctx context.Context, realm, clientID, scopeID string) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/scope/%s", realm, clientID, scopeID)
+func (s *ScopesService) Delete(ctx context.Context, realm, clientID, scopeID string) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/scope/%s", realm, clientID, scopeID)
 	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
 	if err != nil {
 		return nil, err
@@ -82,8 +81,8 @@ func (s *ScopesService) Delete(ctx context.Context, realm, clientID, scopeID str
 }
 
 // Update creates a new scope.
-func (s *ScopesService) Update(ctx context.Context, realm, clientID string, scope *Scope) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/clients/%s/authz/resource-server/scope/%s", realm, clientID, *scope.ID)
+func (s *ScopesService) Update(ctx context.Context, realm, clientID string, scope *Scope) (*Response, error) {
+	u := pathf("admin/realms/%s/clients/%s/authz/resource-server/scope/%s", realm, clientID, *scope.ID)
 	req, err := s.keycloak.NewRequest(http.MethodPut, u, scope)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,207 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// Strategies of how the lockout time grows with the number of failures.
+const (
+	BruteForceStrategyMultiple = "MULTIPLE"
+	BruteForceStrategyLinear   = "LINEAR"
+)
+
+// BruteForceDetection are the brute force detection settings of a realm.
+type BruteForceDetection struct {
+	// Enabled turns brute force detection on, see Realm.BruteForceProtected.
+	Enabled *bool
+
+	// PermanentLockout disables users instead of locking them temporarily.
+	PermanentLockout *bool
+
+	// MaxTemporaryLockouts is the number of temporary lockouts before a
+	// permanent one when PermanentLockout is set, 0 for none.
+	MaxTemporaryLockouts *int
+
+	// Strategy is BruteForceStrategyMultiple or BruteForceStrategyLinear.
+	Strategy *string
+
+	// FailureFactor is the number of login failures before a lockout.
+	FailureFactor *int
+
+	// WaitIncrementSeconds is the time a user is locked out for when
+	// FailureFactor is reached.
+	WaitIncrementSeconds *int
+
+	// MaxFailureWaitSeconds is the maximum time a user is locked out for.
+	MaxFailureWaitSeconds *int
+
+	// MaxDeltaTimeSeconds is the time after which the failure count is
+	// reset.
+	MaxDeltaTimeSeconds *int
+
+	// QuickLoginCheckMilliSeconds and MinimumQuickLoginWaitSeconds lock
+	// users out who fail logins faster than a human would.
+	QuickLoginCheckMilliSeconds  *int
+	MinimumQuickLoginWaitSeconds *int
+}
+
+// Validate checks the settings against the values Keycloak accepts. Unset
+// fields are not checked.
+func (b *BruteForceDetection) Validate() error {
+	if b.Strategy != nil {
+		err := oneOf("brute force detection", map[string][]string{
+			"strategy": {*b.Strategy, BruteForceStrategyMultiple, BruteForceStrategyLinear},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for name, v := range map[string]*int{
+		"max temporary lockouts":           b.MaxTemporaryLockouts,
+		"failure factor":                   b.FailureFactor,
+		"wait increment seconds":           b.WaitIncrementSeconds,
+		"max failure wait seconds":         b.MaxFailureWaitSeconds,
+		"max delta time seconds":           b.MaxDeltaTimeSeconds,
+		"quick login check milliseconds":   b.QuickLoginCheckMilliSeconds,
+		"minimum quick login wait seconds": b.MinimumQuickLoginWaitSeconds,
+	} {
+		if v != nil && *v < 0 {
+			return fmt.Errorf("keycloak: brute force detection: negative %s %d", name, *v)
+		}
+	}
+	return nil
+}
+
+// BruteForceDetection returns the brute force detection settings of the
+// realm.
+func (r *Realm) BruteForceDetection() *BruteForceDetection {
+	return &BruteForceDetection{
+		Enabled:                      r.BruteForceProtected,
+		PermanentLockout:             r.PermanentLockout,
+		MaxTemporaryLockouts:         r.MaxTemporaryLockouts,
+		Strategy:                     r.BruteForceStrategy,
+		FailureFactor:                r.FailureFactor,
+		WaitIncrementSeconds:         r.WaitIncrementSeconds,
+		MaxFailureWaitSeconds:        r.MaxFailureWaitSeconds,
+		MaxDeltaTimeSeconds:          r.MaxDeltaTimeSeconds,
+		QuickLoginCheckMilliSeconds:  r.QuickLoginCheckMilliSeconds,
+		MinimumQuickLoginWaitSeconds: r.MinimumQuickLoginWaitSeconds,
+	}
+}
+
+// SetBruteForceDetection stores the set fields of b in the realm.
+func (r *Realm) SetBruteForceDetection(b *BruteForceDetection) {
+	if b.Enabled != nil {
+		r.BruteForceProtected = b.Enabled
+	}
+	if b.PermanentLockout != nil {
+		r.PermanentLockout = b.PermanentLockout
+	}
+	if b.MaxTemporaryLockouts != nil {
+		r.MaxTemporaryLockouts = b.MaxTemporaryLockouts
+	}
+	if b.Strategy != nil {
+		r.BruteForceStrategy = b.Strategy
+	}
+	if b.FailureFactor != nil {
+		r.FailureFactor = b.FailureFactor
+	}
+	if b.WaitIncrementSeconds != nil {
+		r.WaitIncrementSeconds = b.WaitIncrementSeconds
+	}
+	if b.MaxFailureWaitSeconds != nil {
+		r.MaxFailureWaitSeconds = b.MaxFailureWaitSeconds
+	}
+	if b.MaxDeltaTimeSeconds != nil {
+		r.MaxDeltaTimeSeconds = b.MaxDeltaTimeSeconds
+	}
+	if b.QuickLoginCheckMilliSeconds != nil {
+		r.QuickLoginCheckMilliSeconds = b.QuickLoginCheckMilliSeconds
+	}
+	if b.MinimumQuickLoginWaitSeconds != nil {
+		r.MinimumQuickLoginWaitSeconds = b.MinimumQuickLoginWaitSeconds
+	}
+}
+
+// BrowserSecurityHeaders are the HTTP headers Keycloak adds to the pages it
+// renders, stored in Realm.BrowserSecurityHeaders. An empty value disables
+// a header.
+type BrowserSecurityHeaders struct {
+	ContentSecurityPolicy           *string `header:"contentSecurityPolicy"`
+	ContentSecurityPolicyReportOnly *string `header:"contentSecurityPolicyReportOnly"`
+	XFrameOptions                   *string `header:"xFrameOptions"`
+	XContentTypeOptions             *string `header:"xContentTypeOptions"`
+	XRobotsTag                      *string `header:"xRobotsTag"`
+	XXSSProtection                  *string `header:"xXSSProtection"`
+	StrictTransportSecurity         *string `header:"strictTransportSecurity"`
+	ReferrerPolicy                  *string `header:"referrerPolicy"`
+}
+
+// SecurityHeaders returns the browser security headers of the realm.
+// Headers missing from the realm are nil.
+func (r *Realm) SecurityHeaders() *BrowserSecurityHeaders {
+	h := &BrowserSecurityHeaders{}
+	headers := r.GetBrowserSecurityHeaders()
+
+	v := reflect.ValueOf(h).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		value, ok := headers[v.Type().Field(i).Tag.Get("header")]
+		if !ok {
+			continue
+		}
+		v.Field(i).Set(reflect.ValueOf(&value))
+	}
+	return h
+}
+
+// SetSecurityHeaders stores the set fields of h in the browser security
+// headers of the realm.
+func (r *Realm) SetSecurityHeaders(h *BrowserSecurityHeaders) {
+	if r.BrowserSecurityHeaders == nil {
+		r.BrowserSecurityHeaders = &map[string]string{}
+	}
+
+	v := reflect.ValueOf(h).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).IsNil() {
+			continue
+		}
+		(*r.BrowserSecurityHeaders)[v.Type().Field(i).Tag.Get("header")] = v.Field(i).Elem().String()
+	}
+}
+
+// SecurityDefenses groups the settings of the "Security defenses" tab of a
+// realm. Either of them may be nil.
+type SecurityDefenses struct {
+	BruteForceDetection *BruteForceDetection
+	Headers             *BrowserSecurityHeaders
+}
+
+// UpdateSecurityDefenses validates the settings and changes their set fields
+// in the realm, leaving the other realm settings as they are. Keycloak
+// replaces the browser security headers as a whole, so the current ones are
+// fetched first and the set headers merged into them.
+func (s *RealmsService) UpdateSecurityDefenses(ctx context.Context, name string, defenses *SecurityDefenses) (*Response, error) {
+	realm := &Realm{}
+
+	if defenses.BruteForceDetection != nil {
+		if err := defenses.BruteForceDetection.Validate(); err != nil {
+			return nil, err
+		}
+		realm.SetBruteForceDetection(defenses.BruteForceDetection)
+	}
+
+	if defenses.Headers != nil {
+		current, _, err := s.Get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		realm.BrowserSecurityHeaders = current.BrowserSecurityHeaders
+		realm.SetSecurityHeaders(defenses.Headers)
+	}
+
+	return s.Update(ctx, name, realm)
+}
@@ -0,0 +1,99 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBruteForceDetection_Validate(t *testing.T) {
+	valid := &BruteForceDetection{Enabled: Bool(true), Strategy: String(BruteForceStrategyLinear), FailureFactor: Int(5)}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("got: %v, want: nil", err)
+	}
+
+	if err := (&BruteForceDetection{Strategy: String("EXPONENTIAL")}).Validate(); err == nil || !strings.Contains(err.Error(), `invalid strategy "EXPONENTIAL"`) {
+		t.Errorf("got: %v, want: invalid strategy", err)
+	}
+
+	if err := (&BruteForceDetection{FailureFactor: Int(-1)}).Validate(); err == nil || !strings.Contains(err.Error(), "negative failure factor") {
+		t.Errorf("got: %v, want: negative failure factor", err)
+	}
+}
+
+func TestRealm_SecurityHeaders(t *testing.T) {
+	realm := &Realm{BrowserSecurityHeaders: &map[string]string{
+		"xFrameOptions":         "SAMEORIGIN",
+		"contentSecurityPolicy": "frame-src 'self';",
+	}}
+
+	realm.SetSecurityHeaders(&BrowserSecurityHeaders{
+		XFrameOptions:           String("DENY"),
+		StrictTransportSecurity: String("max-age=31536000; includeSubDomains"),
+	})
+
+	got := realm.SecurityHeaders()
+	want := &BrowserSecurityHeaders{
+		ContentSecurityPolicy:   String("frame-src 'self';"),
+		XFrameOptions:           String("DENY"),
+		StrictTransportSecurity: String("max-age=31536000; includeSubDomains"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %+v, want: %+v", got, want)
+	}
+}
+
+func TestRealmsService_UpdateSecurityDefenses(t *testing.T) {
+	var updated Realm
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(&Realm{BrowserSecurityHeaders: &map[string]string{
+				"xFrameOptions":  "SAMEORIGIN",
+				"referrerPolicy": "no-referrer",
+			}})
+		case http.MethodPut:
+			json.NewDecoder(r.Body).Decode(&updated)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = k.Realms.UpdateSecurityDefenses(context.Background(), "first", &SecurityDefenses{
+		BruteForceDetection: &BruteForceDetection{Enabled: Bool(true), FailureFactor: Int(5)},
+		Headers:             &BrowserSecurityHeaders{XFrameOptions: String("DENY")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !updated.GetBruteForceProtected() || updated.GetFailureFactor() != 5 {
+		t.Errorf("got: %t %d, want: true 5", updated.GetBruteForceProtected(), updated.GetFailureFactor())
+	}
+
+	want := map[string]string{"xFrameOptions": "DENY", "referrerPolicy": "no-referrer"}
+	if got := updated.GetBrowserSecurityHeaders(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	// only the security defenses are sent
+	if updated.Enabled != nil || updated.PasswordPolicy != nil {
+		t.Errorf("got: %+v, want only security defenses", updated)
+	}
+
+	_, err = k.Realms.UpdateSecurityDefenses(context.Background(), "first", &SecurityDefenses{
+		BruteForceDetection: &BruteForceDetection{Strategy: String("unknown")},
+	})
+	if err == nil {
+		t.Errorf("got: nil, want: error")
+	}
+}
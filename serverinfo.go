@@ -3,35 +3,40 @@ package keycloak
 import (
 	"context"
 	"net/http"
+	"sync"
+	"time"
 )
 
+// ServerInfo represents information about the Keycloak server.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/info/ServerInfoRepresentation.java
 type ServerInfo struct {
 	SystemInfo struct {
-		Version        string    `json:"version"`
-		SystemTime     string    `json:"serverTime"` // TODO: parse as time.Time
-		UptimeMillis   int64     `json:"uptimeMillis"`
-		JavaVersion    string    `json:"javaVersion"`
-		JavaVendor     string    `json:"javaVendor"`
-		JavaVM         string    `json:"javaVM"`
-		JavaVMVersion  string    `json:"javaVMVersion"`
-		JavaRuntime    string    `json:"javaRuntime"`
-		JavaHome       string    `json:"javaHome"`
-		OSName         string    `json:"osName"`
-		OSArchitecture string    `json:"osArchitecture"`
-		OSVersion      string    `json:"osVersion"`
-		FileEncoding   string    `json:"fileEncoding"`
-		UserName       string    `json:"userName"`
-		UserDir        string    `json:"userDir"`
-		UserTimezone   string    `json:"userTimezone"`
-		UserLocale     string    `json:"userLocale"`
+		Version        string `json:"version"`
+		SystemTime     string `json:"serverTime"` // TODO: parse as time.Time
+		UptimeMillis   int64  `json:"uptimeMillis"`
+		JavaVersion    string `json:"javaVersion"`
+		JavaVendor     string `json:"javaVendor"`
+		JavaVM         string `json:"javaVM"`
+		JavaVMVersion  string `json:"javaVMVersion"`
+		JavaRuntime    string `json:"javaRuntime"`
+		JavaHome       string `json:"javaHome"`
+		OSName         string `json:"osName"`
+		OSArchitecture string `json:"osArchitecture"`
+		OSVersion      string `json:"osVersion"`
+		FileEncoding   string `json:"fileEncoding"`
+		UserName       string `json:"userName"`
+		UserDir        string `json:"userDir"`
+		UserTimezone   string `json:"userTimezone"`
+		UserLocale     string `json:"userLocale"`
 	} `json:"systemInfo"`
 	MemoryInfo struct {
 		Total          int64  `json:"total"`
 		TotalFormatted string `json:"totalFormatted"`
 		Used           int64  `json:"used"`
-		UsedFormatted  int64  `json:"usedFormatted"`
+		UsedFormatted  string `json:"usedFormatted"`
 		Free           int64  `json:"free"`
-		FreeFormatted  int64  `json:"freeFormatted"`
+		FreeFormatted  string `json:"freeFormatted"`
 		FreePercentage int    `json:"freePercentage"`
 	} `json:"memoryInfo"`
 	ProfileInfo struct {
@@ -48,15 +53,82 @@ type ServerInfo struct {
 	// Additional Theme, Locale, and Provider info omitted for now
 }
 
-func (k *Keycloak) GetServerInfo() (*ServerInfo, error) {
-	if req, err := k.NewRequest(http.MethodGet, "/admin/serverinfo", nil); err == nil {
-		serverInfo := &ServerInfo{}
-		if _, e := k.Do(context.Background(), req, serverInfo); e != nil {
-			return nil, e
-		} else {
-			return serverInfo, nil
-		}
-	} else {
+// ServerInfoService handles communication with the server info related
+// methods of the Keycloak API.
+type ServerInfoService service
+
+// DefaultServerInfoTTL is how long the server version is cached when
+// Keycloak.ServerInfoTTL is not set.
+const DefaultServerInfoTTL = 5 * time.Minute
+
+// serverInfoCache holds the result of the last server info request made by
+// ServerInfoService.Version. Failures are cached as well so an unreachable
+// server is not asked again on every version dependent request.
+type serverInfoCache struct {
+	mu        sync.Mutex
+	info      *ServerInfo
+	err       error
+	fetchedAt time.Time
+}
+
+// Get returns information about the server like its version and enabled features.
+func (s *ServerInfoService) Get(ctx context.Context) (*ServerInfo, *Response, error) {
+	req, err := s.keycloak.NewRequest(http.MethodGet, "admin/serverinfo", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var info ServerInfo
+	res, err := s.keycloak.Do(ctx, req, &info)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &info, res, nil
+}
+
+// Version returns the version of the server. The server info, or the error
+// fetching it, is cached for Keycloak.ServerInfoTTL so the version can be
+// consulted before every version dependent request. It is safe for
+// concurrent use.
+func (s *ServerInfoService) Version(ctx context.Context) (Version, error) {
+	info, err := s.cached(ctx)
+	if err != nil {
+		return Version{}, err
+	}
+
+	return ParseVersion(info.SystemInfo.Version)
+}
+
+func (s *ServerInfoService) cached(ctx context.Context) (*ServerInfo, error) {
+	cache := &s.keycloak.serverInfo
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	ttl := s.keycloak.ServerInfoTTL
+	if ttl == 0 {
+		ttl = DefaultServerInfoTTL
+	}
+	if !cache.fetchedAt.IsZero() && time.Since(cache.fetchedAt) < ttl {
+		return cache.info, cache.err
+	}
+
+	info, _, err := s.Get(ctx)
+	if err != nil && ctx.Err() != nil {
+		// the caller gave up, which says nothing about the server
 		return nil, err
 	}
+	cache.info = info
+	cache.err = err
+	cache.fetchedAt = time.Now()
+
+	return info, err
+}
+
+// GetServerInfo returns information about the server.
+//
+// Deprecated: Use ServerInfoService.Get instead.
+func (k *Keycloak) GetServerInfo() (*ServerInfo, error) {
+	info, _, err := k.ServerInfo.Get(context.Background())
+	return info, err
 }
@@ -0,0 +1,155 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// serverInfoJSON is an abbreviated response of a Keycloak 24 server.
+const serverInfoJSON = `{
+  "systemInfo": {
+    "version": "24.0.1",
+    "serverTime": "Tue Mar 12 09:14:05 UTC 2024",
+    "uptime": "0 days, 0 hours, 3 minutes, 27 seconds",
+    "uptimeMillis": 207641,
+    "javaVersion": "17.0.10",
+    "javaVendor": "Red Hat, Inc.",
+    "javaVm": "OpenJDK 64-Bit Server VM",
+    "javaVmVersion": "17.0.10+7-LTS",
+    "javaRuntime": "OpenJDK Runtime Environment",
+    "javaHome": "/usr/lib/jvm/java-17-openjdk-17.0.10.0.7-2.el9.x86_64",
+    "osName": "Linux",
+    "osArchitecture": "amd64",
+    "osVersion": "6.5.0-21-generic",
+    "fileEncoding": "UTF-8",
+    "userName": "keycloak",
+    "userDir": "/opt/keycloak",
+    "userTimezone": "UTC",
+    "userLocale": "en_US"
+  },
+  "memoryInfo": {
+    "total": 536870912,
+    "totalFormatted": "512 MB",
+    "used": 125829120,
+    "usedFormatted": "120 MB",
+    "free": 411041792,
+    "freePercentage": 76,
+    "freeFormatted": "392 MB"
+  },
+  "profileInfo": {
+    "name": "community",
+    "disabledFeatures": ["ACCOUNT2", "ADMIN_FINE_GRAINED_AUTHZ", "DYNAMIC_SCOPES"],
+    "previewFeatures": ["ADMIN_FINE_GRAINED_AUTHZ", "DYNAMIC_SCOPES", "RECOVERY_CODES"],
+    "experimentalFeatures": ["DYNAMIC_SCOPES"]
+  },
+  "cryptoInfo": {
+    "cryptoProvider": "DefaultCryptoProvider",
+    "supportedKeystoreTypes": ["JKS", "PKCS12", "BCFKS"],
+    "clientSignatureSymmetricAlgorithms": ["HS256", "HS384", "HS512"],
+    "clientSignatureAsymmetricAlgorithms": ["ES256", "ES384", "ES512", "PS256", "RS256"]
+  },
+  "themes": {},
+  "providers": {},
+  "enums": {}
+}`
+
+func TestServerInfoService_Get(t *testing.T) {
+	k := client(t)
+
+	info, res, err := k.ServerInfo.Get(context.Background())
+	if err != nil {
+		t.Errorf("ServerInfo.Get returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if info.SystemInfo.Version == "" {
+		t.Error("got empty version")
+	}
+}
+
+func TestServerInfoService_Version(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, serverInfoJSON)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		version, err := k.ServerInfo.Version(context.Background())
+		if err != nil {
+			t.Fatalf("ServerInfo.Version returned error: %v", err)
+		}
+		if version.String() != "24.0.1" {
+			t.Errorf("got: %s, want: %s", version, "24.0.1")
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("got: %d, want: %d", requests, 1)
+	}
+}
+
+func TestServerInfoService_Get_MemoryInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, serverInfoJSON)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, _, err := k.ServerInfo.Get(context.Background())
+	if err != nil {
+		t.Fatalf("ServerInfo.Get returned error: %v", err)
+	}
+
+	if info.MemoryInfo.UsedFormatted != "120 MB" {
+		t.Errorf("got: %s, want: %s", info.MemoryInfo.UsedFormatted, "120 MB")
+	}
+	if info.MemoryInfo.FreeFormatted != "392 MB" {
+		t.Errorf("got: %s, want: %s", info.MemoryInfo.FreeFormatted, "392 MB")
+	}
+	if info.MemoryInfo.FreePercentage != 76 {
+		t.Errorf("got: %d, want: %d", info.MemoryInfo.FreePercentage, 76)
+	}
+}
+
+func TestServerInfoService_Version_CachesError(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := k.ServerInfo.Version(context.Background()); err == nil {
+			t.Fatal("expected error")
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("got: %d, want: %d", requests, 1)
+	}
+}
@@ -0,0 +1,73 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+)
+
+// SessionsService handles communication with the session related methods of the Keycloak API.
+type SessionsService service
+
+// UserSession representation.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/UserSessionRepresentation.java
+type UserSession struct {
+	ID         *string            `json:"id,omitempty"`
+	Username   *string            `json:"username,omitempty"`
+	UserID     *string            `json:"userId,omitempty"`
+	IPAddress  *string            `json:"ipAddress,omitempty"`
+	Start      *int64             `json:"start,omitempty"`
+	LastAccess *int64             `json:"lastAccess,omitempty"`
+	RememberMe *bool              `json:"rememberMe,omitempty"`
+	Clients    *map[string]string `json:"clients,omitempty"`
+}
+
+// Delete removes a specific user session. Any client that has an admin url
+// will also be told to invalidate this particular session.
+func (s *SessionsService) Delete(ctx context.Context, realm, sessionID string) (*Response, error) {
+	u := pathf("admin/realms/%s/sessions/%s", realm, sessionID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListAllUserSessions lists all active user sessions of the client by
+// requesting one page after another until the result set is exhausted.
+func (s *SessionsService) ListAllUserSessions(ctx context.Context, realm, clientID string, opts *Options) ([]*UserSession, error) {
+	return s.listAll(ctx, opts, func(ctx context.Context, page *Options) ([]*UserSession, *Response, error) {
+		return s.keycloak.Clients.ListUserSessions(ctx, realm, clientID, page)
+	})
+}
+
+// ListAllOfflineSessions lists all offline sessions of the client by
+// requesting one page after another until the result set is exhausted.
+func (s *SessionsService) ListAllOfflineSessions(ctx context.Context, realm, clientID string, opts *Options) ([]*UserSession, error) {
+	return s.listAll(ctx, opts, func(ctx context.Context, page *Options) ([]*UserSession, *Response, error) {
+		return s.keycloak.Clients.ListOfflineSessions(ctx, realm, clientID, page)
+	})
+}
+
+func (s *SessionsService) listAll(ctx context.Context, opts *Options, list func(context.Context, *Options) ([]*UserSession, *Response, error)) ([]*UserSession, error) {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+
+	var sessions []*UserSession
+	err := Paginate(ctx, o, func(ctx context.Context, page Options) (int, error) {
+		next, _, err := list(ctx, &page)
+		if err != nil {
+			return 0, err
+		}
+		sessions = append(sessions, next...)
+		return len(next), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
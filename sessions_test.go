@@ -0,0 +1,245 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+// create a user with a password and log in to create a session.
+func createSession(t *testing.T, k *Keycloak, realm, username string) string {
+	t.Helper()
+
+	userID := createUser(t, k, realm, username)
+
+	ctx := context.Background()
+
+	credential := &Credential{
+		Type:      String("password"),
+		Value:     String("mypassword"),
+		Temporary: Bool(false),
+	}
+	if _, err := k.Users.ResetPassword(ctx, realm, userID, credential); err != nil {
+		t.Errorf("Users.ResetPassword returned error: %v", err)
+	}
+
+	config := oauth2.Config{
+		ClientID: "admin-cli",
+		Endpoint: oauth2.Endpoint{
+			TokenURL: fmt.Sprintf("http://localhost:8080/realms/%s/protocol/openid-connect/token", realm),
+		},
+	}
+
+	if _, err := config.PasswordCredentialsToken(ctx, username, "mypassword"); err != nil {
+		t.Errorf("PasswordCredentialsToken returned error: %v", err)
+	}
+
+	return userID
+}
+
+func TestUsersService_ListSessions(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	userID := createSession(t, k, realm, "user")
+
+	sessions, res, err := k.Users.ListSessions(context.Background(), realm, userID)
+	if err != nil {
+		t.Errorf("Users.ListSessions returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(sessions) != 1 {
+		t.Errorf("got: %d, want: %d", len(sessions), 1)
+	}
+}
+
+func TestUsersService_Logout(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	userID := createSession(t, k, realm, "user")
+
+	ctx := context.Background()
+
+	res, err := k.Users.Logout(ctx, realm, userID)
+	if err != nil {
+		t.Errorf("Users.Logout returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	sessions, _, err := k.Users.ListSessions(ctx, realm, userID)
+	if err != nil {
+		t.Errorf("Users.ListSessions returned error: %v", err)
+	}
+
+	if len(sessions) != 0 {
+		t.Errorf("got: %d, want: %d", len(sessions), 0)
+	}
+}
+
+func TestSessionsService_Delete(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	userID := createSession(t, k, realm, "user")
+
+	ctx := context.Background()
+
+	sessions, _, err := k.Users.ListSessions(ctx, realm, userID)
+	if err != nil {
+		t.Errorf("Users.ListSessions returned error: %v", err)
+	}
+
+	if len(sessions) != 1 {
+		t.Fatalf("got: %d, want: %d", len(sessions), 1)
+	}
+
+	res, err := k.Sessions.Delete(ctx, realm, *sessions[0].ID)
+	if err != nil {
+		t.Errorf("Sessions.Delete returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestClientsService_ListUserSessions(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	createSession(t, k, realm, "user")
+
+	ctx := context.Background()
+
+	c, _, err := k.Clients.GetByClientID(ctx, realm, "admin-cli")
+	if err != nil {
+		t.Errorf("Clients.GetByClientID returned error: %v", err)
+	}
+
+	sessions, res, err := k.Clients.ListUserSessions(ctx, realm, c.GetID(), &Options{Max: 10})
+	if err != nil {
+		t.Errorf("Clients.ListUserSessions returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(sessions) != 1 || sessions[0].GetUsername() != "user" {
+		t.Errorf("got: %v, want a session of user", sessions)
+	}
+
+	count, _, err := k.Clients.GetSessionCount(ctx, realm, c.GetID())
+	if err != nil {
+		t.Errorf("Clients.GetSessionCount returned error: %v", err)
+	}
+
+	if count != 1 {
+		t.Errorf("got: %d, want: %d", count, 1)
+	}
+
+	offline, _, err := k.Clients.GetOfflineSessionCount(ctx, realm, c.GetID())
+	if err != nil {
+		t.Errorf("Clients.GetOfflineSessionCount returned error: %v", err)
+	}
+
+	if offline != 0 {
+		t.Errorf("got: %d, want: %d", offline, 0)
+	}
+
+	sessions, _, err = k.Clients.ListOfflineSessions(ctx, realm, c.GetID(), nil)
+	if err != nil {
+		t.Errorf("Clients.ListOfflineSessions returned error: %v", err)
+	}
+
+	if len(sessions) != 0 {
+		t.Errorf("got: %d, want: %d", len(sessions), 0)
+	}
+}
+
+func TestSessionsService_ListAllUserSessions(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	createSession(t, k, realm, "john")
+	createSession(t, k, realm, "mark")
+	createSession(t, k, realm, "paul")
+
+	ctx := context.Background()
+
+	c, _, err := k.Clients.GetByClientID(ctx, realm, "admin-cli")
+	if err != nil {
+		t.Errorf("Clients.GetByClientID returned error: %v", err)
+	}
+
+	sessions, err := k.Sessions.ListAllUserSessions(ctx, realm, c.GetID(), &Options{Max: 2})
+	if err != nil {
+		t.Errorf("Sessions.ListAllUserSessions returned error: %v", err)
+	}
+
+	if len(sessions) != 3 {
+		t.Errorf("got: %d, want: %d", len(sessions), 3)
+	}
+}
+
+func TestSessionsService_ListAllOfflineSessions(t *testing.T) {
+	var pages []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/realms/first/clients/id/offline-sessions" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			return
+		}
+		pages = append(pages, r.URL.RawQuery)
+
+		sessions := []*UserSession{{ID: String("1")}, {ID: String("2")}}
+		if r.URL.Query().Get("first") == "2" {
+			sessions = sessions[:1]
+		}
+		json.NewEncoder(w).Encode(sessions)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessions, err := k.Sessions.ListAllOfflineSessions(context.Background(), "first", "id", &Options{Max: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sessions) != 3 {
+		t.Errorf("got: %d, want: %d", len(sessions), 3)
+	}
+
+	want := []string{"max=2", "first=2&max=2"}
+	if len(pages) != len(want) {
+		t.Fatalf("got: %v, want: %v", pages, want)
+	}
+	for i := range want {
+		if pages[i] != want[i] {
+			t.Errorf("got: %s, want: %s", pages[i], want[i])
+		}
+	}
+}
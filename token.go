@@ -0,0 +1,172 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// DefaultExpiryDelta is how long before its expiry a token is refreshed when
+// TokenConfig.ExpiryDelta is not set.
+const DefaultExpiryDelta = 30 * time.Second
+
+// TokenConfig describes how to obtain access tokens for the admin API from
+// the token endpoint of a realm.
+//
+// The password grant is used when Username is set, the client credentials
+// grant otherwise.
+type TokenConfig struct {
+	// BaseURL of the Keycloak server, e.g. "http://localhost:8080/".
+	BaseURL string
+
+	// Realm the client belongs to, usually "master".
+	Realm string
+
+	ClientID     string
+	ClientSecret string
+
+	Username string
+	Password string
+
+	// Scopes requested in addition to the client's default scopes.
+	Scopes []string
+
+	// ExpiryDelta is how long before its expiry a token is refreshed.
+	// Defaults to DefaultExpiryDelta.
+	ExpiryDelta time.Duration
+
+	// HTTPClient is used for token requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// TokenURL returns the token endpoint of the configured realm following the
+// Keycloak conventions. The token source uses the endpoint from the discovery
+// document instead, which is the same unless a proxy changes the paths.
+func (c *TokenConfig) TokenURL() string {
+	return c.Issuer() + "/protocol/openid-connect/token"
+}
+
+// Issuer returns the issuer URL of the configured realm. The token endpoint
+// is taken from its discovery document.
+func (c *TokenConfig) Issuer() string {
+	return strings.TrimSuffix(c.BaseURL, "/") + "/realms/" + url.PathEscape(c.Realm)
+}
+
+// TokenSource returns a token source that obtains a token on first use,
+// caches it and refreshes it shortly before it expires. A refresh token is
+// used when the server issued one; if refreshing fails the grant is
+// repeated. The returned token source is safe for concurrent use.
+func (c *TokenConfig) TokenSource(ctx context.Context) oauth2.TokenSource {
+	if c.HTTPClient != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, c.HTTPClient)
+	}
+	return &tokenSource{ctx: ctx, conf: c}
+}
+
+// Client returns an HTTP client that adds a valid access token to every
+// request. Pass it to NewKeycloak.
+func (c *TokenConfig) Client(ctx context.Context) *http.Client {
+	transport := &oauth2.Transport{
+		Source: c.TokenSource(ctx),
+	}
+	client := &http.Client{
+		Transport: transport,
+	}
+	if c.HTTPClient != nil {
+		transport.Base = c.HTTPClient.Transport
+		client.Timeout = c.HTTPClient.Timeout
+	}
+	return client
+}
+
+func (c *TokenConfig) expiryDelta() time.Duration {
+	if c.ExpiryDelta > 0 {
+		return c.ExpiryDelta
+	}
+	return DefaultExpiryDelta
+}
+
+func (c *TokenConfig) oauth2Config(tokenURL string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			TokenURL: tokenURL,
+		},
+		Scopes: c.Scopes,
+	}
+}
+
+// grant obtains a new token with the configured grant type.
+func (c *TokenConfig) grant(ctx context.Context, tokenURL string) (*oauth2.Token, error) {
+	if c.Username != "" {
+		return c.oauth2Config(tokenURL).PasswordCredentialsToken(ctx, c.Username, c.Password)
+	}
+
+	conf := &clientcredentials.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		TokenURL:     tokenURL,
+		Scopes:       c.Scopes,
+	}
+	return conf.Token(ctx)
+}
+
+type tokenSource struct {
+	ctx  context.Context
+	conf *TokenConfig
+
+	mu       sync.Mutex
+	t        *oauth2.Token
+	tokenURL string
+}
+
+func (s *tokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.valid() {
+		return s.t, nil
+	}
+
+	if s.tokenURL == "" {
+		conf, err := discover(s.ctx, s.conf.HTTPClient, s.conf.Issuer())
+		if err != nil {
+			return nil, err
+		}
+		s.tokenURL = conf.TokenEndpoint
+	}
+
+	var t *oauth2.Token
+	var err error
+	if s.t != nil && s.t.RefreshToken != "" {
+		t, err = s.conf.oauth2Config(s.tokenURL).TokenSource(s.ctx, &oauth2.Token{RefreshToken: s.t.RefreshToken}).Token()
+	}
+
+	if t == nil || err != nil {
+		t, err = s.conf.grant(s.ctx, s.tokenURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.t = t
+	return t, nil
+}
+
+// valid reports whether the cached token is usable for at least the expiry delta.
+func (s *tokenSource) valid() bool {
+	if s.t == nil || s.t.AccessToken == "" {
+		return false
+	}
+	if s.t.Expiry.IsZero() {
+		return true
+	}
+	return time.Until(s.t.Expiry) > s.conf.expiryDelta()
+}
@@ -0,0 +1,115 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTokenConfig_TokenSource(t *testing.T) {
+	var grants []string
+	server := httptest.NewServer(withDiscovery(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/realms/master/protocol/openid-connect/token" {
+			t.Errorf("got: %s, want: %s", r.URL.Path, "/realms/master/protocol/openid-connect/token")
+		}
+		r.ParseForm()
+		grants = append(grants, r.Form.Get("grant_type"))
+
+		w.Header().Set("Content-Type", "application/json")
+		// expires within the expiry delta so that every call refreshes
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":10,"refresh_token":"refresh"}`, len(grants))
+	}))
+	defer server.Close()
+
+	conf := &TokenConfig{
+		BaseURL:  server.URL,
+		Realm:    "master",
+		ClientID: "admin-cli",
+		Username: "admin",
+		Password: "admin",
+	}
+
+	ts := conf.TokenSource(context.Background())
+
+	token, err := ts.Token()
+	if err != nil {
+		t.Fatalf("TokenSource.Token returned error: %v", err)
+	}
+
+	if token.AccessToken != "token-1" {
+		t.Errorf("got: %s, want: %s", token.AccessToken, "token-1")
+	}
+
+	token, err = ts.Token()
+	if err != nil {
+		t.Fatalf("TokenSource.Token returned error: %v", err)
+	}
+
+	if token.AccessToken != "token-2" {
+		t.Errorf("got: %s, want: %s", token.AccessToken, "token-2")
+	}
+
+	want := []string{"password", "refresh_token"}
+	if len(grants) != len(want) || grants[0] != want[0] || grants[1] != want[1] {
+		t.Errorf("got: %v, want: %v", grants, want)
+	}
+}
+
+func TestTokenConfig_Client(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(withDiscovery(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/realms/master/protocol/openid-connect/token" {
+			requests++
+			r.ParseForm()
+			if r.Form.Get("grant_type") != "client_credentials" {
+				t.Errorf("got: %s, want: %s", r.Form.Get("grant_type"), "client_credentials")
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":300}`))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("got: %s, want: %s", r.Header.Get("Authorization"), "Bearer token")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	conf := &TokenConfig{
+		BaseURL:      server.URL + "/",
+		Realm:        "master",
+		ClientID:     "admin",
+		ClientSecret: "secret",
+	}
+
+	ctx := context.Background()
+
+	k, err := NewKeycloak(conf.Client(ctx), server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := k.Realms.List(ctx); err != nil {
+			t.Errorf("Realms.List returned error: %v", err)
+		}
+	}
+
+	// the token is cached
+	if requests != 1 {
+		t.Errorf("got: %d, want: %d", requests, 1)
+	}
+}
+
+func TestTokenConfig_TokenURL(t *testing.T) {
+	conf := &TokenConfig{BaseURL: "http://localhost:8080/", Realm: "master"}
+
+	want := "http://localhost:8080/realms/master/protocol/openid-connect/token"
+	if got := conf.TokenURL(); got != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+}
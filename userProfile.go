@@ -0,0 +1,113 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+)
+
+// UserProfileService handles communication with the declarative user profile
+// related methods of the Keycloak API.
+type UserProfileService service
+
+// Policies for attributes that are not part of the user profile configuration.
+// Unmanaged attributes are disabled if the policy is not set.
+const (
+	UnmanagedAttributePolicyEnabled   = "ENABLED"
+	UnmanagedAttributePolicyAdminView = "ADMIN_VIEW"
+	UnmanagedAttributePolicyAdminEdit = "ADMIN_EDIT"
+)
+
+// UPConfig is the user profile configuration of a realm.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/userprofile/config/UPConfig.java
+type UPConfig struct {
+	Attributes               []*UPAttribute `json:"attributes,omitempty"`
+	Groups                   []*UPGroup     `json:"groups,omitempty"`
+	UnmanagedAttributePolicy *string        `json:"unmanagedAttributePolicy,omitempty"`
+}
+
+// UPAttribute configures a single user attribute. Validations maps the name
+// of a validator, e.g. "length" or "email", to its configuration.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/userprofile/config/UPAttribute.java
+type UPAttribute struct {
+	Name        *string                           `json:"name,omitempty"`
+	DisplayName *string                           `json:"displayName,omitempty"`
+	Validations map[string]map[string]interface{} `json:"validations,omitempty"`
+	Annotations map[string]interface{}            `json:"annotations,omitempty"`
+	Required    *UPAttributeRequired              `json:"required,omitempty"`
+	Permissions *UPAttributePermissions           `json:"permissions,omitempty"`
+	Selector    *UPAttributeSelector              `json:"selector,omitempty"`
+	Group       *string                           `json:"group,omitempty"`
+	Multivalued *bool                             `json:"multivalued,omitempty"`
+}
+
+// UPAttributeRequired makes an attribute required. The attribute is always
+// required if neither Roles nor Scopes are set.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/userprofile/config/UPAttributeRequired.java
+type UPAttributeRequired struct {
+	Roles  []string `json:"roles,omitempty"`
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// UPAttributePermissions lists the roles, "admin" or "user", allowed to view
+// and edit an attribute.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/userprofile/config/UPAttributePermissions.java
+type UPAttributePermissions struct {
+	View []string `json:"view,omitempty"`
+	Edit []string `json:"edit,omitempty"`
+}
+
+// UPAttributeSelector limits an attribute to the requested client scopes.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/userprofile/config/UPAttributeSelector.java
+type UPAttributeSelector struct {
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// UPGroup groups attributes when they are rendered.
+//
+// https://github.com/keycloak/keycloak/blob/main/core/src/main/java/org/keycloak/representations/userprofile/config/UPGroup.java
+type UPGroup struct {
+	Name               *string                `json:"name,omitempty"`
+	DisplayHeader      *string                `json:"displayHeader,omitempty"`
+	DisplayDescription *string                `json:"displayDescription,omitempty"`
+	Annotations        map[string]interface{} `json:"annotations,omitempty"`
+}
+
+// GetConfig returns the user profile configuration of the realm.
+func (s *UserProfileService) GetConfig(ctx context.Context, realm string) (*UPConfig, *Response, error) {
+	u := pathf("admin/realms/%s/users/profile", realm)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var config UPConfig
+	res, err := s.keycloak.Do(ctx, req, &config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &config, res, nil
+}
+
+// UpdateConfig replaces the user profile configuration of the realm.
+// Attributes missing from config are removed from the user profile.
+func (s *UserProfileService) UpdateConfig(ctx context.Context, realm string, config *UPConfig) (*UPConfig, *Response, error) {
+	u := pathf("admin/realms/%s/users/profile", realm)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated UPConfig
+	res, err := s.keycloak.Do(ctx, req, &updated)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &updated, res, nil
+}
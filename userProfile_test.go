@@ -0,0 +1,70 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestUserProfileService_GetConfig(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+
+	config, res, err := k.UserProfile.GetConfig(context.Background(), "first")
+	if err != nil {
+		t.Errorf("UserProfile.GetConfig returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	found := false
+	for _, attribute := range config.Attributes {
+		if *attribute.Name == "username" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("username is missing from the user profile")
+	}
+}
+
+func TestUserProfileService_UpdateConfig(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+
+	ctx := context.Background()
+
+	config, _, err := k.UserProfile.GetConfig(ctx, "first")
+	if err != nil {
+		t.Errorf("UserProfile.GetConfig returned error: %v", err)
+	}
+
+	config.Attributes = append(config.Attributes, &UPAttribute{
+		Name:        String("department"),
+		DisplayName: String("Department"),
+		Validations: map[string]map[string]interface{}{
+			"length": {"max": 255},
+		},
+		Permissions: &UPAttributePermissions{
+			View: []string{"admin", "user"},
+			Edit: []string{"admin"},
+		},
+	})
+
+	updated, res, err := k.UserProfile.UpdateConfig(ctx, "first", config)
+	if err != nil {
+		t.Errorf("UserProfile.UpdateConfig returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(updated.Attributes) != len(config.Attributes) {
+		t.Errorf("got: %d, want: %d", len(updated.Attributes), len(config.Attributes))
+	}
+}
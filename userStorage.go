@@ -0,0 +1,107 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+)
+
+// UserStorageService handles communication with the user storage provider related methods of the Keycloak API.
+//
+// User storage providers are configured as components, see ComponentsService.
+type UserStorageService service
+
+// Synchronization actions accepted by UserStorageService.SyncUsers.
+const (
+	SyncActionFull    = "triggerFullSync"
+	SyncActionChanged = "triggerChangedUsersSync"
+)
+
+// Synchronization directions accepted by UserStorageService.SyncMapper.
+const (
+	SyncDirectionFedToKeycloak = "fedToKeycloak"
+	SyncDirectionKeycloakToFed = "keycloakToFed"
+)
+
+// SynchronizationResult is the outcome of a user storage synchronization.
+//
+// https://github.com/keycloak/keycloak/blob/master/server-spi/src/main/java/org/keycloak/storage/user/SynchronizationResult.java
+type SynchronizationResult struct {
+	Ignored *bool   `json:"ignored,omitempty"`
+	Added   *int    `json:"added,omitempty"`
+	Updated *int    `json:"updated,omitempty"`
+	Removed *int    `json:"removed,omitempty"`
+	Failed  *int    `json:"failed,omitempty"`
+	Status  *string `json:"status,omitempty"`
+}
+
+// SyncUsers triggers a synchronization of the users of the user storage provider.
+// Action is either SyncActionFull or SyncActionChanged.
+func (s *UserStorageService) SyncUsers(ctx context.Context, realm, componentID, action string) (*SynchronizationResult, *Response, error) {
+	u := pathf("admin/realms/%s/user-storage/%s/sync", realm, componentID)
+	u, err := addOptions(u, &struct {
+		Action string `url:"action"`
+	}{action})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result SynchronizationResult
+	res, err := s.keycloak.Do(ctx, req, &result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &result, res, nil
+}
+
+// SyncMapper triggers a synchronization of the data of a user storage mapper, e.g. the groups of an LDAP group mapper.
+// Direction is either SyncDirectionFedToKeycloak or SyncDirectionKeycloakToFed.
+func (s *UserStorageService) SyncMapper(ctx context.Context, realm, componentID, mapperID, direction string) (*SynchronizationResult, *Response, error) {
+	u := pathf("admin/realms/%s/user-storage/%s/mappers/%s/sync", realm, componentID, mapperID)
+	u, err := addOptions(u, &struct {
+		Direction string `url:"direction"`
+	}{direction})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result SynchronizationResult
+	res, err := s.keycloak.Do(ctx, req, &result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &result, res, nil
+}
+
+// RemoveImportedUsers removes all users imported by the user storage provider.
+func (s *UserStorageService) RemoveImportedUsers(ctx context.Context, realm, componentID string) (*Response, error) {
+	u := pathf("admin/realms/%s/user-storage/%s/remove-imported-users", realm, componentID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// UnlinkUsers unlinks all imported users from the user storage provider, turning them into local users.
+func (s *UserStorageService) UnlinkUsers(ctx context.Context, realm, componentID string) (*Response, error) {
+	u := pathf("admin/realms/%s/user-storage/%s/unlink-users", realm, componentID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
@@ -0,0 +1,85 @@
+package keycloak
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// create a new ldap user storage provider. The provider is never connected to.
+func createUserStorage(t *testing.T, k *Keycloak, realm, name string) string {
+	t.Helper()
+
+	component := &Component{
+		Name:         String(name),
+		ProviderID:   String("ldap"),
+		ProviderType: String("org.keycloak.storage.UserStorageProvider"),
+		ParentID:     String(realm),
+		Config: &map[string][]string{
+			"enabled":                {"false"},
+			"vendor":                 {"other"},
+			"editMode":               {"READ_ONLY"},
+			"connectionUrl":          {"ldap://localhost:389"},
+			"usersDn":                {"ou=users,dc=example,dc=org"},
+			"usernameLDAPAttribute":  {"uid"},
+			"rdnLDAPAttribute":       {"uid"},
+			"uuidLDAPAttribute":      {"entryUUID"},
+			"userObjectClasses":      {"inetOrgPerson"},
+			"authType":               {"none"},
+			"searchScope":            {"1"},
+			"importEnabled":          {"true"},
+			"syncRegistrations":      {"false"},
+			"batchSizeForSync":       {"1000"},
+			"fullSyncPeriod":         {"-1"},
+			"changedSyncPeriod":      {"-1"},
+			"pagination":             {"false"},
+			"useTruststoreSpi":       {"ldapsOnly"},
+			"connectionPooling":      {"true"},
+			"trustEmail":             {"false"},
+			"validatePasswordPolicy": {"false"},
+		},
+	}
+
+	res, err := k.Components.Create(context.Background(), realm, component)
+	if err != nil {
+		t.Errorf("Components.Create returned error: %v", err)
+	}
+
+	parts := strings.Split(res.Header.Get("Location"), "/")
+	return parts[len(parts)-1]
+}
+
+func TestUserStorageService_RemoveImportedUsers(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	id := createUserStorage(t, k, realm, "ldap")
+
+	res, err := k.UserStorage.RemoveImportedUsers(context.Background(), realm, id)
+	if err != nil {
+		t.Errorf("UserStorage.RemoveImportedUsers returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestUserStorageService_UnlinkUsers(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	id := createUserStorage(t, k, realm, "ldap")
+
+	res, err := k.UserStorage.UnlinkUsers(context.Background(), realm, id)
+	if err != nil {
+		t.Errorf("UserStorage.UnlinkUsers returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
@@ -2,9 +2,16 @@ package keycloak
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"sort"
+	"strings"
+	"time"
 )
 
 // User representation.
@@ -25,23 +32,157 @@ type User struct {
 	NotBefore                  *int                 `json:"notBefore,omitempty"`
 	Access                     *map[string]bool     `json:"access,omitempty"`
 	Attributes                 *map[string][]string `json:"attributes,omitempty"`
+	Credentials                []*Credential        `json:"credentials,omitempty"`
+	Groups                     []string             `json:"groups,omitempty"`
+}
+
+// NewUser returns an empty user to be filled with the With methods, e.g.
+//
+//	user := keycloak.NewUser().
+//		WithUsername("john").
+//		WithEmail("john@example.com").
+//		WithPassword("secret", false)
+func NewUser() *User {
+	return &User{}
+}
+
+// WithUsername sets the username of the user.
+func (u *User) WithUsername(username string) *User {
+	u.Username = &username
+	return u
+}
+
+// WithEmail sets the email address of the user.
+func (u *User) WithEmail(email string) *User {
+	u.Email = &email
+	return u
+}
+
+// WithEmailVerified sets whether the email address of the user is verified.
+func (u *User) WithEmailVerified(verified bool) *User {
+	u.EmailVerified = &verified
+	return u
+}
+
+// WithFirstName sets the first name of the user.
+func (u *User) WithFirstName(firstName string) *User {
+	u.FirstName = &firstName
+	return u
+}
+
+// WithLastName sets the last name of the user.
+func (u *User) WithLastName(lastName string) *User {
+	u.LastName = &lastName
+	return u
+}
+
+// WithEnabled sets whether the user is enabled.
+func (u *User) WithEnabled(enabled bool) *User {
+	u.Enabled = &enabled
+	return u
+}
+
+// WithAttribute sets the values of a custom attribute of the user.
+func (u *User) WithAttribute(name string, values ...string) *User {
+	if u.Attributes == nil {
+		u.Attributes = &map[string][]string{}
+	}
+	(*u.Attributes)[name] = values
+	return u
+}
+
+// WithRequiredActions adds required actions, e.g. RequiredActionVerifyEmail.
+func (u *User) WithRequiredActions(actions ...RequiredActionType) *User {
+	for _, action := range actions {
+		u.RequiredActions = append(u.RequiredActions, string(action))
+	}
+	return u
+}
+
+// WithGroups adds the user to groups given by their path, e.g. "/org/team".
+// Groups are only applied when the user is created.
+func (u *User) WithGroups(paths ...string) *User {
+	u.Groups = append(u.Groups, paths...)
+	return u
+}
+
+// WithPassword adds a password credential. Credentials are only applied
+// when the user is created.
+func (u *User) WithPassword(password string, temporary bool) *User {
+	u.Credentials = append(u.Credentials, NewCredential().WithType("password").WithValue(password).WithTemporary(temporary))
+	return u
 }
 
 // Credential representation.
 //
 // https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/CredentialRepresentation.java
 type Credential struct {
-	Type      *string `json:"type,omitempty"`
-	Value     *string `json:"value,omitempty"`
-	Temporary *bool   `json:"temporary,omitempty"`
+	ID             *string `json:"id,omitempty"`
+	Type           *string `json:"type,omitempty"`
+	UserLabel      *string `json:"userLabel,omitempty"`
+	CreatedDate    *int64  `json:"createdDate,omitempty"`
+	SecretData     *string `json:"secretData,omitempty"`
+	CredentialData *string `json:"credentialData,omitempty"`
+	Priority       *int    `json:"priority,omitempty"`
+	Value          *string `json:"value,omitempty"`
+	Temporary      *bool   `json:"temporary,omitempty"`
+}
+
+// NewCredential returns an empty credential to be filled with the With methods.
+func NewCredential() *Credential {
+	return &Credential{}
+}
+
+// WithType sets the type of the credential, e.g. "password".
+func (c *Credential) WithType(typ string) *Credential {
+	c.Type = &typ
+	return c
+}
+
+// WithValue sets the secret value of the credential.
+func (c *Credential) WithValue(value string) *Credential {
+	c.Value = &value
+	return c
+}
+
+// WithTemporary sets whether the user has to change the credential on next login.
+func (c *Credential) WithTemporary(temporary bool) *Credential {
+	c.Temporary = &temporary
+	return c
+}
+
+// WithUserLabel sets the label of the credential.
+func (c *Credential) WithUserLabel(label string) *Credential {
+	c.UserLabel = &label
+	return c
+}
+
+// FederatedIdentity links a user to an account of a brokered identity provider.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/FederatedIdentityRepresentation.java
+type FederatedIdentity struct {
+	IdentityProvider *string `json:"identityProvider,omitempty"`
+	UserID           *string `json:"userId,omitempty"`
+	UserName         *string `json:"userName,omitempty"`
+}
+
+// UserConsent is a consent the user granted to a client. The granted client
+// scopes are referenced by name.
+//
+// https://github.com/keycloak/keycloak/blob/master/core/src/main/java/org/keycloak/representations/idm/UserConsentRepresentation.java
+type UserConsent struct {
+	ClientID            *string  `json:"clientId,omitempty"`
+	GrantedClientScopes []string `json:"grantedClientScopes,omitempty"`
+	CreatedDate         *int64   `json:"createdDate,omitempty"`
+	LastUpdatedDate     *int64   `json:"lastUpdatedDate,omitempty"`
 }
 
 // UsersService ...
 type UsersService service
 
 // Create a new user.
-func (s *UsersService) Create(ctx context.Context, realm string, user *User) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users", realm)
+func (s *UsersService) Create(ctx context.Context, realm string, user *User) (*Response, error) {
+	u := pathf("admin/realms/%s/users", realm)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, user)
 	if err != nil {
 		return nil, err
@@ -50,9 +191,31 @@ func (s *UsersService) Create(ctx context.Context, realm string, user *User) (*h
 	return s.keycloak.Do(ctx, req, nil)
 }
 
+// UserListOptions specifies the optional parameters to the UsersService.List method.
+type UserListOptions struct {
+	BriefRepresentation *bool  `url:"briefRepresentation,omitempty"`
+	Email               string `url:"email,omitempty"`
+	EmailVerified       *bool  `url:"emailVerified,omitempty"`
+	Enabled             *bool  `url:"enabled,omitempty"`
+	Exact               *bool  `url:"exact,omitempty"`
+	FirstName           string `url:"firstName,omitempty"`
+	IdpAlias            string `url:"idpAlias,omitempty"`
+	IdpUserID           string `url:"idpUserId,omitempty"`
+	LastName            string `url:"lastName,omitempty"`
+	Q                   string `url:"q,omitempty"`
+	Search              string `url:"search,omitempty"`
+	Username            string `url:"username,omitempty"`
+	Options
+}
+
 // List users.
-func (s *UsersService) List(ctx context.Context, realm string) ([]*User, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users", realm)
+func (s *UsersService) List(ctx context.Context, realm string, opts *UserListOptions) ([]*User, *Response, error) {
+	u := pathf("admin/realms/%s/users", realm)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -67,9 +230,68 @@ func (s *UsersService) List(ctx context.Context, realm string) ([]*User, *http.R
 	return users, res, nil
 }
 
+// UserCountOptions specifies the optional parameters to the UsersService.Count method.
+type UserCountOptions struct {
+	Email         string `url:"email,omitempty"`
+	EmailVerified *bool  `url:"emailVerified,omitempty"`
+	Enabled       *bool  `url:"enabled,omitempty"`
+	FirstName     string `url:"firstName,omitempty"`
+	LastName      string `url:"lastName,omitempty"`
+	Q             string `url:"q,omitempty"`
+	Search        string `url:"search,omitempty"`
+	Username      string `url:"username,omitempty"`
+}
+
+// Count returns the number of users in realm matching opts.
+func (s *UsersService) Count(ctx context.Context, realm string, opts *UserCountOptions) (int, *Response, error) {
+	u := pathf("admin/realms/%s/users/count", realm)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var count int
+	res, err := s.keycloak.Do(ctx, req, &count)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return count, res, nil
+}
+
+// ListAll lists all users matching opts by requesting one page after another
+// until the result set is exhausted.
+func (s *UsersService) ListAll(ctx context.Context, realm string, opts *UserListOptions) ([]*User, error) {
+	var o UserListOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	var users []*User
+	err := Paginate(ctx, o.Options, func(ctx context.Context, page Options) (int, error) {
+		o.Options = page
+		next, _, err := s.List(ctx, realm, &o)
+		if err != nil {
+			return 0, err
+		}
+		users = append(users, next...)
+		return len(next), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
 // GetByID get a single user by ID.
-func (s *UsersService) GetByID(ctx context.Context, realm, id string) (*User, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users/%s", realm, id)
+func (s *UsersService) GetByID(ctx context.Context, realm, id string) (*User, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s", realm, id)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -85,8 +307,13 @@ func (s *UsersService) GetByID(ctx context.Context, realm, id string) (*User, *h
 }
 
 // GetByUsername get a single user by username.
-func (s *UsersService) GetByUsername(ctx context.Context, realm, username string) ([]*User, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users?username=%s", realm, username)
+func (s *UsersService) GetByUsername(ctx context.Context, realm, username string) ([]*User, *Response, error) {
+	u := pathf("admin/realms/%s/users", realm)
+	u, err := addOptions(u, url.Values{"username": {username}})
+	if err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -101,26 +328,35 @@ func (s *UsersService) GetByUsername(ctx context.Context, realm, username string
 	return users, res, nil
 }
 
-// GetByUsername get a single user by attribute.
-func (s *UsersService) GetByAttribute(ctx context.Context, realm, attributeName string, value string) ([]*User, *http.Response, error) {
-	// Assume we are on a modern release first.
-	var ver string = "22"
-	var queryUrl string
+// GetByEmail lists the users whose email contains email or, if exact is
+// set, equals it. Keycloak stores emails in lower case and compares them
+// case-insensitively.
+func (s *UsersService) GetByEmail(ctx context.Context, realm, email string, exact bool) ([]*User, *Response, error) {
+	return s.List(ctx, realm, &UserListOptions{Email: email, Exact: Bool(exact)})
+}
 
-	if si, e := s.keycloak.GetServerInfo(); e == nil {
-		if si != nil {
-			ver = si.SystemInfo.Version
-		}
+// GetByAttribute lists the users having the attribute with the given value.
+// The query syntax depends on the server version, so an error looking up the
+// version is returned rather than guessing.
+func (s *UsersService) GetByAttribute(ctx context.Context, realm, attributeName string, value string) ([]*User, *Response, error) {
+	supported, err := s.keycloak.SupportsFeature(ctx, FeatureUserAttributeQuery)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// If we are on a version that doesn't support q=attr:val syntax:
-	//
-	if ver < "20" {
-		queryUrl = fmt.Sprintf("admin/realms/%s/users?filter=%s=%s", realm, url.PathEscape(attributeName), url.PathEscape(value))
-	} else {
-		queryUrl = fmt.Sprintf("admin/realms/%s/users?q=%s:%s", realm, url.PathEscape(attributeName), url.PathEscape("\""+value+"\""))
+	q := url.Values{"q": {AttributeQuery(map[string]string{attributeName: value})}}
+	if !supported {
+		// older releases don't support the q=attr:val syntax
+		q = url.Values{"filter": {attributeName + "=" + value}}
 	}
-	req, err := s.keycloak.NewRequest(http.MethodGet, queryUrl, nil)
+
+	u := pathf("admin/realms/%s/users", realm)
+	u, err = addOptions(u, q)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -134,9 +370,50 @@ func (s *UsersService) GetByAttribute(ctx context.Context, realm, attributeName
 	return users, res, nil
 }
 
+// GetByAttributes lists the users having all of the attributes with the
+// given values. They are combined into a single q parameter, see
+// AttributeQuery, which replaces opts.Q. Set opts.Exact to false to match
+// values as substrings. opts may be nil.
+func (s *UsersService) GetByAttributes(ctx context.Context, realm string, attributes map[string]string, opts *UserListOptions) ([]*User, *Response, error) {
+	var o UserListOptions
+	if opts != nil {
+		o = *opts
+	}
+	o.Q = AttributeQuery(attributes)
+
+	return s.List(ctx, realm, &o)
+}
+
+// AttributeQuery returns the q parameter of UserListOptions searching for
+// users with all of the attributes, e.g. `dept:sales "job title":"head of"`.
+// Names and values are quoted and escaped as needed.
+func AttributeQuery(attributes map[string]string) string {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	terms := make([]string, len(names))
+	for i, name := range names {
+		terms[i] = quoteQueryTerm(name) + ":" + quoteQueryTerm(attributes[name])
+	}
+	return strings.Join(terms, " ")
+}
+
+// quoteQueryTerm quotes s unless it is safe to use as is in a q parameter.
+// Inside quotes Keycloak unescapes every character following a backslash.
+func quoteQueryTerm(s string) string {
+	if s != "" && !strings.ContainsAny(s, " \t\":\\") {
+		return s
+	}
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + r.Replace(s) + `"`
+}
+
 // Update update a single user.
-func (s *UsersService) Update(ctx context.Context, realm string, user *User) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users/%s", realm, *user.ID)
+func (s *UsersService) Update(ctx context.Context, realm string, user *User) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s", realm, *user.ID)
 	req, err := s.keycloak.NewRequest(http.MethodPut, u, user)
 	if err != nil {
 		return nil, err
@@ -145,9 +422,115 @@ func (s *UsersService) Update(ctx context.Context, realm string, user *User) (*h
 	return s.keycloak.Do(ctx, req, nil)
 }
 
+// ErrConcurrentModification is returned by UsersService.Patch if the user
+// kept changing while the patch was applied.
+var ErrConcurrentModification = errors.New("keycloak: concurrent modification")
+
+// patchAttempts is the number of times Patch applies a modification before
+// it gives up on a user that keeps changing.
+const patchAttempts = 3
+
+// patchBackoff returns how long Patch waits before the given retry.
+var patchBackoff = ExponentialBackoff(100*time.Millisecond, time.Second)
+
+// Patch applies modify to the current representation of the user and
+// updates the user with the result. Keycloak replaces all fields on update,
+// most notably the attributes, so unlike Update this keeps the fields modify
+// does not touch.
+//
+// Keycloak has no versioning of users. Right before the update Patch reads
+// the user again and compares its hash with the one modify was applied to,
+// which narrows but does not close the window for lost updates. If they
+// differ, the fresh read is the base of the next attempt after a short
+// backoff. After repeated changes it gives up with
+// ErrConcurrentModification. The updated user is returned.
+func (s *UsersService) Patch(ctx context.Context, realm, userID string, modify func(*User) error) (*User, error) {
+	current, _, err := s.GetByID(ctx, realm, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		sum, err := userHash(current)
+		if err != nil {
+			return nil, err
+		}
+
+		// modify a deep copy so the hash of current stays valid
+		var user User
+		if err := clone(current, &user); err != nil {
+			return nil, err
+		}
+		if err := modify(&user); err != nil {
+			return nil, err
+		}
+		user.ID = current.ID
+
+		latest, _, err := s.GetByID(ctx, realm, userID)
+		if err != nil {
+			return nil, err
+		}
+		latestSum, err := userHash(latest)
+		if err != nil {
+			return nil, err
+		}
+		if latestSum == sum {
+			if _, err := s.Update(ctx, realm, &user); err != nil {
+				return nil, err
+			}
+			return &user, nil
+		}
+
+		if attempt >= patchAttempts {
+			return nil, ErrConcurrentModification
+		}
+		if err := sleep(ctx, patchBackoff(attempt)); err != nil {
+			return nil, err
+		}
+		current = latest
+	}
+}
+
+// MergeAttributes sets the given attributes of the user and keeps all other
+// attributes and fields, see Patch. An attribute with no values is removed.
+func (s *UsersService) MergeAttributes(ctx context.Context, realm, userID string, attributes map[string][]string) (*User, error) {
+	return s.Patch(ctx, realm, userID, func(user *User) error {
+		if user.Attributes == nil {
+			user.Attributes = &map[string][]string{}
+		}
+		for name, values := range attributes {
+			if len(values) == 0 {
+				delete(*user.Attributes, name)
+				continue
+			}
+			(*user.Attributes)[name] = values
+		}
+		return nil
+	})
+}
+
+// userHash returns a hash of the JSON representation of user. Maps are
+// encoded with sorted keys, so equal users have equal hashes.
+func userHash(user *User) ([sha256.Size]byte, error) {
+	b, err := json.Marshal(user)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(b), nil
+}
+
+// clone deep copies src into dst through JSON.
+func clone(src, dst interface{}) error {
+	b, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, dst)
+}
+
 // Delete user.
-func (s *UsersService) Delete(ctx context.Context, realm, userID string) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users/%s", realm, userID)
+func (s *UsersService) Delete(ctx context.Context, realm, userID string) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s", realm, userID)
 	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
 	if err != nil {
 		return nil, err
@@ -157,8 +540,8 @@ func (s *UsersService) Delete(ctx context.Context, realm, userID string) (*http.
 }
 
 // ResetPassword sets or resets the user's password.
-func (s *UsersService) ResetPassword(ctx context.Context, realm, userID string, credential *Credential) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users/%s/reset-password", realm, userID)
+func (s *UsersService) ResetPassword(ctx context.Context, realm, userID string, credential *Credential) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/reset-password", realm, userID)
 	req, err := s.keycloak.NewRequest(http.MethodPut, u, credential)
 	if err != nil {
 		return nil, err
@@ -170,8 +553,8 @@ func (s *UsersService) ResetPassword(ctx context.Context, realm, userID string,
 // Update user.
 
 // JoinGroup adds user to a group.
-func (s *UsersService) JoinGroup(ctx context.Context, realm, userID, groupID string) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users/%s/groups/%s", realm, userID, groupID)
+func (s *UsersService) JoinGroup(ctx context.Context, realm, userID, groupID string) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/groups/%s", realm, userID, groupID)
 	req, err := s.keycloak.NewRequest(http.MethodPut, u, nil)
 	if err != nil {
 		return nil, err
@@ -181,8 +564,8 @@ func (s *UsersService) JoinGroup(ctx context.Context, realm, userID, groupID str
 }
 
 // LeaveGroup removes a user from a group.
-func (s *UsersService) LeaveGroup(ctx context.Context, realm, userID, groupID string) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users/%s/groups/%s", realm, userID, groupID)
+func (s *UsersService) LeaveGroup(ctx context.Context, realm, userID, groupID string) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/groups/%s", realm, userID, groupID)
 	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
 	if err != nil {
 		return nil, err
@@ -192,8 +575,8 @@ func (s *UsersService) LeaveGroup(ctx context.Context, realm, userID, groupID st
 }
 
 // AddRealmRoles adds realm roles to user.
-func (s *UsersService) AddRealmRoles(ctx context.Context, realm, userID string, roles []*Role) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users/%s/role-mappings/realm", realm, userID)
+func (s *UsersService) AddRealmRoles(ctx context.Context, realm, userID string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/role-mappings/realm", realm, userID)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, roles)
 	if err != nil {
 		return nil, err
@@ -203,8 +586,8 @@ func (s *UsersService) AddRealmRoles(ctx context.Context, realm, userID string,
 }
 
 // RemoveRealmRoles removes assigned realm roles from user.
-func (s *UsersService) RemoveRealmRoles(ctx context.Context, realm, userID string, roles []*Role) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users/%s/role-mappings/realm", realm, userID)
+func (s *UsersService) RemoveRealmRoles(ctx context.Context, realm, userID string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/role-mappings/realm", realm, userID)
 	req, err := s.keycloak.NewRequest(http.MethodDelete, u, roles)
 	if err != nil {
 		return nil, err
@@ -214,8 +597,8 @@ func (s *UsersService) RemoveRealmRoles(ctx context.Context, realm, userID strin
 }
 
 // ListRealmRoles returns a list of realm roles assigned to user.
-func (s *UsersService) ListRealmRoles(ctx context.Context, realm, userID string) ([]*Role, *http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users/%s/role-mappings/realm", realm, userID)
+func (s *UsersService) ListRealmRoles(ctx context.Context, realm, userID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/role-mappings/realm", realm, userID)
 	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -231,8 +614,8 @@ func (s *UsersService) ListRealmRoles(ctx context.Context, realm, userID string)
 }
 
 // AddClientRoles adds client roles to user.
-func (s *UsersService) AddClientRoles(ctx context.Context, realm, userID, clientID string, roles []*Role) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users/%s/role-mappings/clients/%s", realm, userID, clientID)
+func (s *UsersService) AddClientRoles(ctx context.Context, realm, userID, clientID string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/role-mappings/clients/%s", realm, userID, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodPost, u, roles)
 	if err != nil {
 		return nil, err
@@ -242,8 +625,8 @@ func (s *UsersService) AddClientRoles(ctx context.Context, realm, userID, client
 }
 
 // RemoveClientRoles removes assigned client roles from user.
-func (s *UsersService) RemoveClientRoles(ctx context.Context, realm, userID, clientID string, roles []*Role) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users/%s/role-mappings/clients/%s", realm, userID, clientID)
+func (s *UsersService) RemoveClientRoles(ctx context.Context, realm, userID, clientID string, roles []*Role) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/role-mappings/clients/%s", realm, userID, clientID)
 	req, err := s.keycloak.NewRequest(http.MethodDelete, u, roles)
 	if err != nil {
 		return nil, err
@@ -252,6 +635,216 @@ func (s *UsersService) RemoveClientRoles(ctx context.Context, realm, userID, cli
 	return s.keycloak.Do(ctx, req, nil)
 }
 
+// ListClientRoles returns the roles of the client clientID directly assigned
+// to user.
+func (s *UsersService) ListClientRoles(ctx context.Context, realm, userID, clientID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/role-mappings/clients/%s", realm, userID, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// RoleSync reports the role mappings changed by SyncRealmRoles and
+// SyncClientRoles.
+type RoleSync struct {
+	Added   []*Role
+	Removed []*Role
+}
+
+// SyncRealmRoles makes roleNames the realm roles directly assigned to user.
+// Missing roles are added and other roles removed with at most one request
+// each. Roles inherited through groups or composites are not affected. Every
+// user has the default-roles-{realm} role, include it in roleNames to keep
+// it. An unknown role name returns an error matching ErrNotFound before
+// anything is changed.
+func (s *UsersService) SyncRealmRoles(ctx context.Context, realm, userID string, roleNames ...string) (*RoleSync, error) {
+	current, _, err := s.ListRealmRoles(ctx, realm, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return syncRoles(current, roleNames, roleMappingFuncs{
+		get: func(name string) (*Role, error) {
+			role, _, err := s.keycloak.RealmRoles.GetByName(ctx, realm, name)
+			return role, err
+		},
+		add: func(roles []*Role) error {
+			_, err := s.AddRealmRoles(ctx, realm, userID, roles)
+			return err
+		},
+		remove: func(roles []*Role) error {
+			_, err := s.RemoveRealmRoles(ctx, realm, userID, roles)
+			return err
+		},
+	})
+}
+
+// SyncClientRoles makes roleNames the roles of the client clientID, the id
+// of the client and not its client id, directly assigned to user. It works
+// like SyncRealmRoles.
+func (s *UsersService) SyncClientRoles(ctx context.Context, realm, userID, clientID string, roleNames ...string) (*RoleSync, error) {
+	current, _, err := s.ListClientRoles(ctx, realm, userID, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return syncRoles(current, roleNames, roleMappingFuncs{
+		get: func(name string) (*Role, error) {
+			role, _, err := s.keycloak.ClientRoles.Get(ctx, realm, clientID, name)
+			return role, err
+		},
+		add: func(roles []*Role) error {
+			_, err := s.AddClientRoles(ctx, realm, userID, clientID, roles)
+			return err
+		},
+		remove: func(roles []*Role) error {
+			_, err := s.RemoveClientRoles(ctx, realm, userID, clientID, roles)
+			return err
+		},
+	})
+}
+
+// roleMappingFuncs look up, add and remove the roles of a single container.
+type roleMappingFuncs struct {
+	get    func(name string) (*Role, error)
+	add    func(roles []*Role) error
+	remove func(roles []*Role) error
+}
+
+func syncRoles(current []*Role, roleNames []string, f roleMappingFuncs) (*RoleSync, error) {
+	want := make(map[string]bool, len(roleNames))
+	for _, name := range roleNames {
+		want[name] = true
+	}
+
+	sync := &RoleSync{}
+	have := make(map[string]bool, len(current))
+	for _, role := range current {
+		have[role.GetName()] = true
+		if !want[role.GetName()] {
+			sync.Removed = append(sync.Removed, role)
+		}
+	}
+	for _, name := range roleNames {
+		if have[name] {
+			continue
+		}
+		have[name] = true
+		role, err := f.get(name)
+		if err != nil {
+			return nil, err
+		}
+		sync.Added = append(sync.Added, role)
+	}
+
+	// add first so the user does not lose access in between
+	if len(sync.Added) > 0 {
+		if err := f.add(sync.Added); err != nil {
+			return nil, err
+		}
+	}
+	if len(sync.Removed) > 0 {
+		if err := f.remove(sync.Removed); err != nil {
+			return nil, err
+		}
+	}
+	return sync, nil
+}
+
+// ListRealmRolesComposite returns the effective realm roles of user, including roles inherited through composite roles and groups.
+func (s *UsersService) ListRealmRolesComposite(ctx context.Context, realm, userID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/role-mappings/realm/composite", realm, userID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// ListClientRolesComposite returns the effective client roles of user, including roles inherited through composite roles and groups.
+func (s *UsersService) ListClientRolesComposite(ctx context.Context, realm, userID, clientID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/role-mappings/clients/%s/composite", realm, userID, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// GetAllRoleMappings returns all realm and client roles directly mapped to user.
+func (s *UsersService) GetAllRoleMappings(ctx context.Context, realm, userID string) (*RoleMappings, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/role-mappings", realm, userID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mappings RoleMappings
+	res, err := s.keycloak.Do(ctx, req, &mappings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &mappings, res, nil
+}
+
+// ListAvailableRealmRoles returns the realm roles that can still be assigned to user.
+func (s *UsersService) ListAvailableRealmRoles(ctx context.Context, realm, userID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/role-mappings/realm/available", realm, userID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
+// ListAvailableClientRoles returns the client roles that can still be assigned to user.
+func (s *UsersService) ListAvailableClientRoles(ctx context.Context, realm, userID, clientID string) ([]*Role, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/role-mappings/clients/%s/available", realm, userID, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []*Role
+	res, err := s.keycloak.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return roles, res, nil
+}
+
 // VerifyEmailOptions ...
 type VerifyEmailOptions struct {
 	ClientID    string `url:"client_id,omitempty"`
@@ -260,8 +853,8 @@ type VerifyEmailOptions struct {
 
 // Send an email-verification email to the user.
 // An email contains a link the user can click to verify their email address.
-func (s *UsersService) SendVerifyEmail(ctx context.Context, realm, userID string, opts *VerifyEmailOptions) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users/%s/send-verify-email", realm, userID)
+func (s *UsersService) SendVerifyEmail(ctx context.Context, realm, userID string, opts *VerifyEmailOptions) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/send-verify-email", realm, userID)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, err
@@ -275,17 +868,59 @@ func (s *UsersService) SendVerifyEmail(ctx context.Context, realm, userID string
 	return s.keycloak.Do(ctx, req, nil)
 }
 
+// MaxActionsEmailLifespan is the longest lifespan in seconds accepted by
+// ExecuteActionsEmail. Keycloak stores the expiration of the link as 32-bit
+// Unix time, far longer lifespans overflow it.
+const MaxActionsEmailLifespan = 365 * 24 * 60 * 60
+
+// Errors reported as the Reason of an ActionsEmailError.
+var (
+	ErrEmailNotConfigured = errors.New("keycloak: realm has no email server configured")
+	ErrUserEmailMissing   = errors.New("keycloak: user has no email address")
+	ErrEmailNotSent       = errors.New("keycloak: email server failed to send the email")
+)
+
+// ActionsEmailError is returned by ExecuteActionsEmailChecked when the
+// email cannot be sent.
+type ActionsEmailError struct {
+	Realm  string
+	UserID string
+
+	// Reason is ErrEmailNotConfigured, ErrUserEmailMissing or
+	// ErrEmailNotSent.
+	Reason error
+
+	// Response is the error response of Keycloak, nil if the email was not
+	// attempted.
+	Response *ErrorResponse
+}
+
+func (e *ActionsEmailError) Error() string {
+	return fmt.Sprintf("%v: user %s in realm %s", e.Reason, e.UserID, e.Realm)
+}
+
+// Unwrap returns the reason, so errors.Is(err, ErrEmailNotConfigured)
+// works.
+func (e *ActionsEmailError) Unwrap() error { return e.Reason }
+
 // ExecuteActionsEmailOptions ...
 type ExecuteActionsEmailOptions struct {
 	ClientID    string `url:"client_id,omitempty"`
-	Lifespan    int    `url:"lifespan,omitempty"`
 	RedirectUri string `url:"redirect_uri,omitempty"`
+
+	// Lifespan of the link in seconds, 0 for the realm default of
+	// Realm.ActionTokenGeneratedByAdminLifespan.
+	Lifespan int `url:"lifespan,omitempty"`
 }
 
 // ExecuteActionsEmail sends an update account email to the user.
 // An email contains a link the user can click to perform a set of required actions.
-func (s *UsersService) ExecuteActionsEmail(ctx context.Context, realm, userID string, opts *ExecuteActionsEmailOptions, actions []string) (*http.Response, error) {
-	u := fmt.Sprintf("admin/realms/%s/users/%s/execute-actions-email", realm, userID)
+func (s *UsersService) ExecuteActionsEmail(ctx context.Context, realm, userID string, opts *ExecuteActionsEmailOptions, actions []RequiredActionType) (*Response, error) {
+	if opts != nil && (opts.Lifespan < 0 || opts.Lifespan > MaxActionsEmailLifespan) {
+		return nil, fmt.Errorf("keycloak: lifespan %d out of range 0 to %d", opts.Lifespan, MaxActionsEmailLifespan)
+	}
+
+	u := pathf("admin/realms/%s/users/%s/execute-actions-email", realm, userID)
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, err
@@ -298,3 +933,403 @@ func (s *UsersService) ExecuteActionsEmail(ctx context.Context, realm, userID st
 
 	return s.keycloak.Do(ctx, req, nil)
 }
+
+// ExecuteActionsEmailChecked is like ExecuteActionsEmail but returns an
+// *ActionsEmailError if the email cannot be sent. Keycloak answers with an
+// opaque 500 when the realm has no email server, so the realm and the user
+// are checked before the email is requested.
+func (s *UsersService) ExecuteActionsEmailChecked(ctx context.Context, realm, userID string, opts *ExecuteActionsEmailOptions, actions []RequiredActionType) (*Response, error) {
+	r, _, err := s.keycloak.Realms.Get(ctx, realm)
+	if err != nil {
+		return nil, err
+	}
+	if r.GetSMTPServer()["host"] == "" {
+		return nil, &ActionsEmailError{Realm: realm, UserID: userID, Reason: ErrEmailNotConfigured}
+	}
+
+	user, _, err := s.GetByID(ctx, realm, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.GetEmail() == "" {
+		return nil, &ActionsEmailError{Realm: realm, UserID: userID, Reason: ErrUserEmailMissing}
+	}
+
+	res, err := s.ExecuteActionsEmail(ctx, realm, userID, opts, actions)
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) && errorResponse.StatusCode() == http.StatusInternalServerError {
+		return res, &ActionsEmailError{Realm: realm, UserID: userID, Reason: ErrEmailNotSent, Response: errorResponse}
+	}
+
+	return res, err
+}
+
+// SendPasswordResetEmail sends an email to the user with a link to reset
+// their password.
+func (s *UsersService) SendPasswordResetEmail(ctx context.Context, realm, userID string, opts *ExecuteActionsEmailOptions) (*Response, error) {
+	return s.ExecuteActionsEmail(ctx, realm, userID, opts, []RequiredActionType{RequiredActionUpdatePassword})
+}
+
+// ListSessions lists the active sessions of the user.
+func (s *UsersService) ListSessions(ctx context.Context, realm, userID string) ([]*UserSession, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/sessions", realm, userID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sessions []*UserSession
+	res, err := s.keycloak.Do(ctx, req, &sessions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sessions, res, nil
+}
+
+// ListOfflineSessions lists the offline sessions of the user for the client.
+func (s *UsersService) ListOfflineSessions(ctx context.Context, realm, userID, clientID string) ([]*UserSession, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/offline-sessions/%s", realm, userID, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sessions []*UserSession
+	res, err := s.keycloak.Do(ctx, req, &sessions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sessions, res, nil
+}
+
+// Logout removes all sessions of the user.
+func (s *UsersService) Logout(ctx context.Context, realm, userID string) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/logout", realm, userID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// DisableCredentialTypes disables all credentials of the given types for the user, e.g. "otp" to force re-enrollment.
+func (s *UsersService) DisableCredentialTypes(ctx context.Context, realm, userID string, types []string) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/disable-credential-types", realm, userID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, types)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListCredentials lists the stored credentials of the user, ordered by priority.
+func (s *UsersService) ListCredentials(ctx context.Context, realm, userID string) ([]*Credential, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/credentials", realm, userID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var credentials []*Credential
+	res, err := s.keycloak.Do(ctx, req, &credentials)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return credentials, res, nil
+}
+
+// DeleteCredential removes a credential of the user.
+func (s *UsersService) DeleteCredential(ctx context.Context, realm, userID, credentialID string) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/credentials/%s", realm, userID, credentialID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// MoveCredentialToFirst moves a credential of the user to the first position in the credentials list.
+func (s *UsersService) MoveCredentialToFirst(ctx context.Context, realm, userID, credentialID string) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/credentials/%s/moveToFirst", realm, userID, credentialID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// MoveCredentialAfter moves a credential of the user to the position right after another credential.
+func (s *UsersService) MoveCredentialAfter(ctx context.Context, realm, userID, credentialID, previousCredentialID string) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/credentials/%s/moveAfter/%s", realm, userID, credentialID, previousCredentialID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// SetCredentialLabel updates the user label of a credential of the user.
+func (s *UsersService) SetCredentialLabel(ctx context.Context, realm, userID, credentialID, label string) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/credentials/%s/userLabel", realm, userID, credentialID)
+	req, err := s.keycloak.NewRequest(http.MethodPut, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the endpoint only accepts the label as plain text
+	req.ContentLength = int64(len(label))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(label)), nil
+	}
+	req.Body, _ = req.GetBody()
+	req.Header.Set("Content-Type", "text/plain")
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListFederatedIdentity lists the identity provider links of the user.
+func (s *UsersService) ListFederatedIdentity(ctx context.Context, realm, userID string) ([]*FederatedIdentity, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/federated-identity", realm, userID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var identities []*FederatedIdentity
+	res, err := s.keycloak.Do(ctx, req, &identities)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return identities, res, nil
+}
+
+// AddFederatedIdentity links the user to an account of the identity provider.
+func (s *UsersService) AddFederatedIdentity(ctx context.Context, realm, userID, provider string, identity *FederatedIdentity) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/federated-identity/%s", realm, userID, provider)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// RemoveFederatedIdentity removes the link between the user and the identity provider.
+func (s *UsersService) RemoveFederatedIdentity(ctx context.Context, realm, userID, provider string) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/federated-identity/%s", realm, userID, provider)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// ListConsents lists the consents the user granted to clients, including
+// the clients the user has offline tokens for.
+func (s *UsersService) ListConsents(ctx context.Context, realm, userID string) ([]*UserConsent, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/consents", realm, userID)
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var consents []*UserConsent
+	res, err := s.keycloak.Do(ctx, req, &consents)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return consents, res, nil
+}
+
+// RevokeConsent revokes the consent and the offline tokens the user granted
+// to the client. ClientID is the client id, e.g. "account-console", not the
+// id of the client.
+func (s *UsersService) RevokeConsent(ctx context.Context, realm, userID, clientID string) (*Response, error) {
+	u := pathf("admin/realms/%s/users/%s/consents/%s", realm, userID, clientID)
+	req, err := s.keycloak.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.keycloak.Do(ctx, req, nil)
+}
+
+// Impersonation is the result of impersonating a user.
+type Impersonation struct {
+	SameRealm *bool   `json:"sameRealm,omitempty"`
+	Redirect  *string `json:"redirect,omitempty"`
+
+	// Cookies holds the session cookies set by Keycloak for the impersonated
+	// session. They must be sent along when following Redirect.
+	Cookies []*http.Cookie `json:"-"`
+}
+
+// Impersonate opens a session as the user. Impersonation must be permitted for the authenticated admin.
+func (s *UsersService) Impersonate(ctx context.Context, realm, userID string) (*Impersonation, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/impersonation", realm, userID)
+	req, err := s.keycloak.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var impersonation Impersonation
+	res, err := s.keycloak.Do(ctx, req, &impersonation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	impersonation.Cookies = res.Cookies()
+
+	return &impersonation, res, nil
+}
+
+// UserGroupsListOptions specifies the optional parameters to the UsersService.ListGroups method.
+type UserGroupsListOptions struct {
+	BriefRepresentation *bool  `url:"briefRepresentation,omitempty"`
+	Search              string `url:"search,omitempty"`
+
+	Options
+}
+
+// ListGroups lists the groups the user is a member of.
+func (s *UsersService) ListGroups(ctx context.Context, realm, userID string, opts *UserGroupsListOptions) ([]*Group, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/groups", realm, userID)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groups []*Group
+	res, err := s.keycloak.Do(ctx, req, &groups)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return groups, res, nil
+}
+
+// CountGroups counts the groups the user is a member of, optionally filtered by search.
+func (s *UsersService) CountGroups(ctx context.Context, realm, userID, search string) (int, *Response, error) {
+	u := pathf("admin/realms/%s/users/%s/groups/count", realm, userID)
+	u, err := addOptions(u, &struct {
+		Search string `url:"search,omitempty"`
+	}{search})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req, err := s.keycloak.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var count struct {
+		Count int `json:"count"`
+	}
+	res, err := s.keycloak.Do(ctx, req, &count)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return count.Count, res, nil
+}
+
+// GroupSync reports the memberships changed by SyncGroups.
+type GroupSync struct {
+	Joined []*Group
+	Left   []*Group
+}
+
+// SyncGroups makes the groups at paths, e.g. "/org/team", the groups user is
+// a direct member of. Missing groups are joined and other groups left. An
+// unknown path returns an error matching ErrNotFound before anything is
+// changed.
+func (s *UsersService) SyncGroups(ctx context.Context, realm, userID string, paths []string) (*GroupSync, error) {
+	var current []*Group
+	opts := &UserGroupsListOptions{BriefRepresentation: Bool(true)}
+	err := Paginate(ctx, Options{}, func(ctx context.Context, page Options) (int, error) {
+		opts.Options = page
+		next, _, err := s.ListGroups(ctx, realm, userID, opts)
+		if err != nil {
+			return 0, err
+		}
+		current = append(current, next...)
+		return len(next), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	want := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		want[groupPath(path)] = true
+	}
+
+	sync := &GroupSync{}
+	have := make(map[string]bool, len(current))
+	for _, group := range current {
+		have[group.GetPath()] = true
+		if !want[group.GetPath()] {
+			sync.Left = append(sync.Left, group)
+		}
+	}
+	for _, path := range paths {
+		path = groupPath(path)
+		if have[path] {
+			continue
+		}
+		have[path] = true
+		group, _, err := s.keycloak.Groups.GetByPath(ctx, realm, path)
+		if err != nil {
+			return nil, err
+		}
+		sync.Joined = append(sync.Joined, group)
+	}
+
+	for _, group := range sync.Joined {
+		if _, err := s.JoinGroup(ctx, realm, userID, group.GetID()); err != nil {
+			return nil, err
+		}
+	}
+	for _, group := range sync.Left {
+		if _, err := s.LeaveGroup(ctx, realm, userID, group.GetID()); err != nil {
+			return nil, err
+		}
+	}
+	return sync, nil
+}
+
+// groupPath normalizes path to the form Keycloak reports, "/a/b".
+func groupPath(path string) string {
+	return "/" + strings.Trim(path, "/")
+}
+
+// GetManagementPermissions returns whether fine-grained admin permissions are
+// enabled for users.
+func (s *UsersService) GetManagementPermissions(ctx context.Context, realm string) (*ManagementPermissionReference, *Response, error) {
+	u := pathf("admin/realms/%s/users-management-permissions", realm)
+	return getManagementPermissions(ctx, s.keycloak, u)
+}
+
+// SetManagementPermissions enables or disables fine-grained admin permissions
+// for users.
+func (s *UsersService) SetManagementPermissions(ctx context.Context, realm string, ref *ManagementPermissionReference) (*ManagementPermissionReference, *Response, error) {
+	u := pathf("admin/realms/%s/users-management-permissions", realm)
+	return setManagementPermissions(ctx, s.keycloak, u, ref)
+}
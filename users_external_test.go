@@ -0,0 +1,173 @@
+package keycloak_test
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/zemirco/keycloak/v2"
+	"github.com/zemirco/keycloak/v2/keycloaktest"
+)
+
+func TestUsersService_SyncGroups(t *testing.T) {
+	server := keycloaktest.NewServer()
+	defer server.Close()
+	server.AddRealm("first")
+
+	k := server.Keycloak()
+	ctx := context.Background()
+
+	for _, path := range []string{"/org/dev", "/org/ops", "/admins"} {
+		if _, err := k.Groups.Ensure(ctx, "first", path, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	userID, err := k.Users.Ensure(ctx, "first", keycloak.NewUser().WithUsername("john").WithGroups("/admins"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sync, err := k.Users.SyncGroups(ctx, "first", userID, []string{"org/dev/", "/org/ops"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sync.Joined) != 2 || len(sync.Left) != 1 || sync.Left[0].GetPath() != "/admins" {
+		t.Errorf("got: %+v, want: 2 joined, /admins left", sync)
+	}
+
+	groups, _, err := k.Users.ListGroups(ctx, "first", userID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, group := range groups {
+		paths = append(paths, group.GetPath())
+	}
+	sort.Strings(paths)
+	if !reflect.DeepEqual(paths, []string{"/org/dev", "/org/ops"}) {
+		t.Errorf("got: %v, want: [/org/dev /org/ops]", paths)
+	}
+
+	sync, err = k.Users.SyncGroups(ctx, "first", userID, []string{"/org/dev", "/org/ops"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sync.Joined)+len(sync.Left) != 0 {
+		t.Errorf("got: %+v, want: no changes", sync)
+	}
+
+	if _, err := k.Users.SyncGroups(ctx, "first", userID, []string{"/unknown"}); !keycloak.IsNotFound(err) {
+		t.Errorf("got: %v, want: %v", err, keycloak.ErrNotFound)
+	}
+}
+
+func TestUsersService_MergeAttributes(t *testing.T) {
+	server := keycloaktest.NewServer()
+	defer server.Close()
+	server.AddRealm("first")
+
+	k := server.Keycloak()
+	ctx := context.Background()
+
+	user := keycloak.NewUser().
+		WithUsername("john").
+		WithFirstName("John").
+		WithAttribute("department", "sales").
+		WithAttribute("location", "berlin")
+	userID, err := k.Users.Ensure(ctx, "first", user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = k.Users.MergeAttributes(ctx, "first", userID, map[string][]string{
+		"department": {"engineering"},
+		"location":   nil,
+		"badge":      {"1234"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, _, err := k.Users.GetByID(ctx, "first", userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]string{"department": {"engineering"}, "badge": {"1234"}}
+	if !reflect.DeepEqual(got.GetAttributes(), want) {
+		t.Errorf("got: %v, want: %v", got.GetAttributes(), want)
+	}
+	if got.GetFirstName() != "John" {
+		t.Errorf("got: %s, want: %s", got.GetFirstName(), "John")
+	}
+}
+
+func TestUsersService_GetByAttributes(t *testing.T) {
+	server := keycloaktest.NewServer()
+	defer server.Close()
+	server.AddRealm("first")
+
+	k := server.Keycloak()
+	ctx := context.Background()
+
+	users := []*keycloak.User{
+		keycloak.NewUser().WithUsername("john").WithAttribute("dept", "sales").WithAttribute("job title", `head of "sales"`),
+		keycloak.NewUser().WithUsername("jane").WithAttribute("dept", "sales").WithAttribute("job title", "assistant"),
+		keycloak.NewUser().WithUsername("jim").WithAttribute("dept", "marketing").WithAttribute("job title", "head of marketing"),
+	}
+	for _, user := range users {
+		if _, err := k.Users.Ensure(ctx, "first", user); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, _, err := k.Users.GetByAttributes(ctx, "first", map[string]string{
+		"dept":      "sales",
+		"job title": `head of "sales"`,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].GetUsername() != "john" {
+		t.Errorf("got: %v, want: [john]", got)
+	}
+
+	got, _, err = k.Users.GetByAttributes(ctx, "first", map[string]string{"job title": "head of"}, &keycloak.UserListOptions{Exact: keycloak.Bool(false)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Errorf("got: %d, want: %d", len(got), 2)
+	}
+}
+
+func TestUsersService_GetByEmail(t *testing.T) {
+	server := keycloaktest.NewServer()
+	defer server.Close()
+	server.AddRealm("first")
+
+	k := server.Keycloak()
+	ctx := context.Background()
+
+	for _, email := range []string{"john@example.com", "big.john@example.com"} {
+		if _, err := k.Users.Ensure(ctx, "first", keycloak.NewUser().WithUsername(email).WithEmail(email)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	users, _, err := k.Users.GetByEmail(ctx, "first", "john@example.com", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 1 || users[0].GetEmail() != "john@example.com" {
+		t.Errorf("got: %v, want: [john@example.com]", users)
+	}
+
+	users, _, err = k.Users.GetByEmail(ctx, "first", "john@example.com", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 2 {
+		t.Errorf("got: %d, want: %d", len(users), 2)
+	}
+}
@@ -2,12 +2,48 @@ package keycloak
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 )
 
+func TestNewUser(t *testing.T) {
+	user := NewUser().
+		WithUsername("john").
+		WithEmail("john@example.com").
+		WithEnabled(true).
+		WithAttribute("department", "sales").
+		WithRequiredActions(RequiredActionVerifyEmail).
+		WithPassword("mypassword", true)
+
+	if user.GetUsername() != "john" {
+		t.Errorf("got: %s, want: %s", user.GetUsername(), "john")
+	}
+
+	if user.GetEmail() != "john@example.com" {
+		t.Errorf("got: %s, want: %s", user.GetEmail(), "john@example.com")
+	}
+
+	if !user.GetEnabled() {
+		t.Errorf("got: %t, want: %t", user.GetEnabled(), true)
+	}
+
+	if !reflect.DeepEqual(user.GetAttributes(), map[string][]string{"department": {"sales"}}) {
+		t.Errorf("got: %v, want department attribute", user.GetAttributes())
+	}
+
+	if len(user.Credentials) != 1 || user.Credentials[0].GetValue() != "mypassword" || !user.Credentials[0].GetTemporary() {
+		t.Errorf("got: %v, want temporary password credential", user.Credentials)
+	}
+}
+
 // create a new user.
 func createUser(t *testing.T, k *Keycloak, realm string, username string) string {
 	t.Helper()
@@ -153,7 +189,7 @@ func TestUsersService_List(t *testing.T) {
 	createUser(t, k, realm, "john")
 	createUser(t, k, realm, "mark")
 
-	users, res, err := k.Users.List(context.Background(), realm)
+	users, res, err := k.Users.List(context.Background(), realm, nil)
 	if err != nil {
 		t.Errorf("Users.List returned error: %v", err)
 	}
@@ -168,6 +204,77 @@ func TestUsersService_List(t *testing.T) {
 	}
 }
 
+func TestUsersService_List_Options(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	createUser(t, k, realm, "john")
+	createUser(t, k, realm, "mark")
+	createUser(t, k, realm, "paul")
+
+	opts := &UserListOptions{
+		BriefRepresentation: Bool(true),
+		Options: Options{
+			First: 1,
+			Max:   1,
+		},
+	}
+
+	users, res, err := k.Users.List(context.Background(), realm, opts)
+	if err != nil {
+		t.Errorf("Users.List returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(users) != 1 {
+		t.Errorf("got: %d, want: %d", len(users), 1)
+	}
+
+	opts = &UserListOptions{
+		Search: "mar",
+	}
+
+	users, _, err = k.Users.List(context.Background(), realm, opts)
+	if err != nil {
+		t.Errorf("Users.List returned error: %v", err)
+	}
+
+	if len(users) != 1 {
+		t.Errorf("got: %d, want: %d", len(users), 1)
+	}
+}
+
+func TestUsersService_ListAll(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	createUser(t, k, realm, "john")
+	createUser(t, k, realm, "mark")
+	createUser(t, k, realm, "paul")
+
+	opts := &UserListOptions{
+		Options: Options{
+			Max: 2,
+		},
+	}
+
+	users, err := k.Users.ListAll(context.Background(), realm, opts)
+	if err != nil {
+		t.Errorf("Users.ListAll returned error: %v", err)
+	}
+
+	if len(users) != 3 {
+		t.Errorf("got: %d, want: %d", len(users), 3)
+	}
+}
+
 func TestUsersService_GetByUsername(t *testing.T) {
 	k := client(t)
 
@@ -335,6 +442,289 @@ func TestUsersService_RemoveRealmRoles(t *testing.T) {
 	}
 }
 
+func TestUsersService_Patch(t *testing.T) {
+	defer func(b func(int) time.Duration) { patchBackoff = b }(patchBackoff)
+	patchBackoff = func(int) time.Duration { return time.Millisecond }
+
+	gets := 0
+	var updated User
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			// the user changes between the first read and the update
+			gets++
+			user := &User{ID: String("id"), FirstName: String("John")}
+			if gets > 1 {
+				user.Email = String("john@example.com")
+			}
+			json.NewEncoder(w).Encode(user)
+		case http.MethodPut:
+			json.NewDecoder(r.Body).Decode(&updated)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = k.Users.Patch(context.Background(), "first", "id", func(user *User) error {
+		user.LastName = String("Doe")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Users.Patch returned error: %v", err)
+	}
+
+	// the read verifying the first attempt is the base of the second one
+	if gets != 3 {
+		t.Errorf("got: %d, want: %d", gets, 3)
+	}
+
+	if updated.GetEmail() != "john@example.com" || updated.GetLastName() != "Doe" {
+		t.Errorf("got: %s %s, want: %s %s", updated.GetEmail(), updated.GetLastName(), "john@example.com", "Doe")
+	}
+}
+
+func TestUsersService_Patch_ConcurrentModification(t *testing.T) {
+	defer func(b func(int) time.Duration) { patchBackoff = b }(patchBackoff)
+	var backoffs []int
+	patchBackoff = func(retry int) time.Duration {
+		backoffs = append(backoffs, retry)
+		return time.Millisecond
+	}
+
+	gets := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			return
+		}
+		// the user changes between every read and the update
+		gets++
+		json.NewEncoder(w).Encode(&User{ID: String("id"), FirstName: String(strconv.Itoa(gets))})
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attempts := 0
+	_, err = k.Users.Patch(context.Background(), "first", "id", func(user *User) error {
+		attempts++
+		user.LastName = String("Doe")
+		return nil
+	})
+	if !errors.Is(err, ErrConcurrentModification) {
+		t.Errorf("got: %v, want: %v", err, ErrConcurrentModification)
+	}
+
+	if attempts != patchAttempts {
+		t.Errorf("got: %d, want: %d", attempts, patchAttempts)
+	}
+
+	if gets != patchAttempts+1 {
+		t.Errorf("got: %d, want: %d", gets, patchAttempts+1)
+	}
+
+	if len(backoffs) != patchAttempts-1 {
+		t.Errorf("got: %v, want: %d backoffs", backoffs, patchAttempts-1)
+	}
+}
+
+func TestUsersService_GetByAttributes_Quoted(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	ctx := context.Background()
+
+	users := []*User{
+		NewUser().WithUsername("john").WithAttribute("dept", "sales").WithAttribute("job title", `head of "sales"`),
+		NewUser().WithUsername("jane").WithAttribute("dept", "sales").WithAttribute("job title", "assistant"),
+	}
+	for _, user := range users {
+		if _, err := k.Users.Create(ctx, realm, user); err != nil {
+			t.Errorf("Users.Create returned error: %v", err)
+		}
+	}
+
+	got, res, err := k.Users.GetByAttributes(ctx, realm, map[string]string{
+		"dept":      "sales",
+		"job title": `head of "sales"`,
+	}, nil)
+	if err != nil {
+		t.Errorf("Users.GetByAttributes returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(got) != 1 || got[0].GetUsername() != "john" {
+		t.Errorf("got: %v, want: [john]", got)
+	}
+}
+
+func TestUsersService_GetByAttribute(t *testing.T) {
+	tests := []struct {
+		version string
+		query   string
+	}{
+		{"24.0.1", "q=dept%3Asales"},
+		{"19.0.3", "filter=dept%3Dsales"},
+	}
+
+	for _, tt := range tests {
+		var query string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/admin/serverinfo":
+				fmt.Fprint(w, strings.Replace(serverInfoJSON, `"24.0.1"`, strconv.Quote(tt.version), 1))
+			case "/admin/realms/first/users":
+				query = r.URL.RawQuery
+				fmt.Fprint(w, `[{"username":"john","attributes":{"dept":["sales"]}}]`)
+			default:
+				t.Errorf("unexpected request: %s", r.URL)
+			}
+		}))
+
+		k, err := NewKeycloak(nil, server.URL+"/")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		users, _, err := k.Users.GetByAttribute(context.Background(), "first", "dept", "sales")
+		if err != nil {
+			t.Errorf("Users.GetByAttribute returned error: %v", err)
+		}
+		if len(users) != 1 || users[0].GetUsername() != "john" {
+			t.Errorf("got: %v, want: [john]", users)
+		}
+		if query != tt.query {
+			t.Errorf("%s: got: %s, want: %s", tt.version, query, tt.query)
+		}
+
+		server.Close()
+	}
+}
+
+func TestUsersService_GetByAttribute_VersionError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/serverinfo" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := k.Users.GetByAttribute(context.Background(), "first", "dept", "sales"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestAttributeQuery(t *testing.T) {
+	got := AttributeQuery(map[string]string{
+		"dept":      "sales",
+		"job title": `head of "sales"`,
+		"path":      `C:\users`,
+		"empty":     "",
+	})
+	want := `dept:sales empty:"" "job title":"head of \"sales\"" path:"C:\\users"`
+	if got != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+}
+
+func TestSyncRoles(t *testing.T) {
+	current := []*Role{{Name: String("keep")}, {Name: String("drop")}}
+
+	var added, removed []string
+	sync, err := syncRoles(current, []string{"keep", "new", "new"}, roleMappingFuncs{
+		get: func(name string) (*Role, error) {
+			return &Role{Name: String(name)}, nil
+		},
+		add: func(roles []*Role) error {
+			for _, role := range roles {
+				added = append(added, role.GetName())
+			}
+			return nil
+		},
+		remove: func(roles []*Role) error {
+			for _, role := range roles {
+				removed = append(removed, role.GetName())
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(added, []string{"new"}) || !reflect.DeepEqual(removed, []string{"drop"}) {
+		t.Errorf("got: added %v removed %v, want: added [new] removed [drop]", added, removed)
+	}
+
+	if len(sync.Added) != 1 || len(sync.Removed) != 1 {
+		t.Errorf("got: %+v", sync)
+	}
+
+	// nothing changes when the roles are in sync
+	sync, err = syncRoles(current, []string{"keep", "drop"}, roleMappingFuncs{
+		add:    func([]*Role) error { t.Error("unexpected add"); return nil },
+		remove: func([]*Role) error { t.Error("unexpected remove"); return nil },
+	})
+	if err != nil || len(sync.Added)+len(sync.Removed) != 0 {
+		t.Errorf("got: %+v %v, want: no changes", sync, err)
+	}
+}
+
+func TestUsersService_SyncRealmRoles(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+	createRealmRole(t, k, realm, "reader")
+	createRealmRole(t, k, realm, "writer")
+	userID := createUser(t, k, realm, "user")
+
+	ctx := context.Background()
+
+	sync, err := k.Users.SyncRealmRoles(ctx, realm, userID, "reader", "writer")
+	if err != nil {
+		t.Errorf("Users.SyncRealmRoles returned error: %v", err)
+	}
+
+	// the default roles are removed, they are not in the desired set
+	if len(sync.Added) != 2 || len(sync.Removed) != 1 {
+		t.Errorf("got: %d added, %d removed, want: 2 added, 1 removed", len(sync.Added), len(sync.Removed))
+	}
+
+	sync, err = k.Users.SyncRealmRoles(ctx, realm, userID, "reader")
+	if err != nil {
+		t.Errorf("Users.SyncRealmRoles returned error: %v", err)
+	}
+
+	if len(sync.Added) != 0 || len(sync.Removed) != 1 || sync.Removed[0].GetName() != "writer" {
+		t.Errorf("got: %+v, want writer removed", sync)
+	}
+
+	if _, err := k.Users.SyncRealmRoles(ctx, realm, userID, "unknown"); !IsNotFound(err) {
+		t.Errorf("got: %v, want: %v", err, ErrNotFound)
+	}
+}
+
 func TestUsersService_ListRealmRoles(t *testing.T) {
 	k := client(t)
 
@@ -438,7 +828,7 @@ func TestUsersService_ExecuteActionsEmail(t *testing.T) {
 		Lifespan: 1000,
 	}
 
-	res, err := k.Users.ExecuteActionsEmail(context.Background(), realm, userID, opts, []string{"UPDATE_PROFILE"})
+	res, err := k.Users.ExecuteActionsEmail(context.Background(), realm, userID, opts, []RequiredActionType{RequiredActionUpdateProfile})
 	if err != nil {
 		t.Errorf("Users.ExecuteActionsEmail returned error: %v", err)
 	}
@@ -447,3 +837,434 @@ func TestUsersService_ExecuteActionsEmail(t *testing.T) {
 		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
 	}
 }
+
+func TestUsersService_ExecuteActionsEmail_Lifespan(t *testing.T) {
+	k, err := NewKeycloak(nil, "http://localhost/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, lifespan := range []int{-1, MaxActionsEmailLifespan + 1} {
+		opts := &ExecuteActionsEmailOptions{Lifespan: lifespan}
+		_, err := k.Users.ExecuteActionsEmail(context.Background(), "first", "id", opts, []RequiredActionType{RequiredActionVerifyEmail})
+		if err == nil || !strings.Contains(err.Error(), "out of range") {
+			t.Errorf("%d: got: %v, want: out of range", lifespan, err)
+		}
+	}
+}
+
+func TestUsersService_ExecuteActionsEmailChecked(t *testing.T) {
+	smtp := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/admin/realms/first":
+			json.NewEncoder(w).Encode(&Realm{SMTPServer: &smtp})
+		case "/admin/realms/first/users/id":
+			json.NewEncoder(w).Encode(&User{ID: String("id"), Email: String("john@example.com")})
+		case "/admin/realms/first/users/id/execute-actions-email":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"errorMessage":"Failed to send execute actions email"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	actions := []RequiredActionType{RequiredActionUpdatePassword}
+
+	_, err = k.Users.ExecuteActionsEmailChecked(ctx, "first", "id", nil, actions)
+	if !errors.Is(err, ErrEmailNotConfigured) {
+		t.Errorf("got: %v, want: %v", err, ErrEmailNotConfigured)
+	}
+
+	smtp["host"] = "mailhog"
+	_, err = k.Users.ExecuteActionsEmailChecked(ctx, "first", "id", nil, actions)
+	var emailErr *ActionsEmailError
+	if !errors.As(err, &emailErr) || emailErr.Reason != ErrEmailNotSent || emailErr.Response.StatusCode() != http.StatusInternalServerError {
+		t.Errorf("got: %v, want: %v", err, ErrEmailNotSent)
+	}
+}
+
+func TestUsersService_SendPasswordResetEmail(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+
+	createRealm(t, k, realm)
+	userID := createUser(t, k, realm, "user")
+
+	opts := &ExecuteActionsEmailOptions{
+		Lifespan: 1000,
+	}
+
+	res, err := k.Users.SendPasswordResetEmail(context.Background(), realm, userID, opts)
+	if err != nil {
+		t.Errorf("Users.SendPasswordResetEmail returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestUsersService_ListCredentials(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	userID := createUser(t, k, realm, "john")
+
+	ctx := context.Background()
+
+	credential := &Credential{
+		Type:      String("password"),
+		Value:     String("mypassword"),
+		Temporary: Bool(false),
+	}
+	if _, err := k.Users.ResetPassword(ctx, realm, userID, credential); err != nil {
+		t.Errorf("Users.ResetPassword returned error: %v", err)
+	}
+
+	credentials, res, err := k.Users.ListCredentials(ctx, realm, userID)
+	if err != nil {
+		t.Errorf("Users.ListCredentials returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(credentials) != 1 {
+		t.Fatalf("got: %d, want: %d", len(credentials), 1)
+	}
+
+	if *credentials[0].Type != "password" {
+		t.Errorf("got: %s, want: %s", *credentials[0].Type, "password")
+	}
+
+	res, err = k.Users.SetCredentialLabel(ctx, realm, userID, *credentials[0].ID, "my password")
+	if err != nil {
+		t.Errorf("Users.SetCredentialLabel returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+
+	res, err = k.Users.DeleteCredential(ctx, realm, userID, *credentials[0].ID)
+	if err != nil {
+		t.Errorf("Users.DeleteCredential returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestUsersService_DisableCredentialTypes(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	userID := createUser(t, k, realm, "john")
+
+	res, err := k.Users.DisableCredentialTypes(context.Background(), realm, userID, []string{"otp"})
+	if err != nil {
+		t.Errorf("Users.DisableCredentialTypes returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestUsersService_ListFederatedIdentity(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	userID := createUser(t, k, realm, "john")
+
+	identities, res, err := k.Users.ListFederatedIdentity(context.Background(), realm, userID)
+	if err != nil {
+		t.Errorf("Users.ListFederatedIdentity returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(identities) != 0 {
+		t.Errorf("got: %d, want: %d", len(identities), 0)
+	}
+}
+
+func TestUsersService_ListConsents(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	userID := createUser(t, k, realm, "john")
+
+	consents, res, err := k.Users.ListConsents(context.Background(), realm, userID)
+	if err != nil {
+		t.Errorf("Users.ListConsents returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(consents) != 0 {
+		t.Errorf("got: %d, want: %d", len(consents), 0)
+	}
+}
+
+func TestUsersService_RevokeConsent(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	userID := createUser(t, k, realm, "john")
+
+	// the user never granted a consent to the client
+	_, err := k.Users.RevokeConsent(context.Background(), realm, userID, "account-console")
+	if !IsNotFound(err) {
+		t.Errorf("got: %v, want a not found error", err)
+	}
+}
+
+func TestUsersService_Impersonate(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	userID := createUser(t, k, realm, "john")
+
+	impersonation, res, err := k.Users.Impersonate(context.Background(), realm, userID)
+	if err != nil {
+		t.Errorf("Users.Impersonate returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if impersonation.Redirect == nil {
+		t.Errorf("got no redirect, want one")
+	}
+
+	if len(impersonation.Cookies) == 0 {
+		t.Errorf("got no cookies, want some")
+	}
+}
+
+func TestUsersService_ListGroups(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	userID := createUser(t, k, realm, "john")
+	groupID := createGroup(t, k, realm, "group")
+
+	ctx := context.Background()
+
+	if _, err := k.Users.JoinGroup(ctx, realm, userID, groupID); err != nil {
+		t.Errorf("Users.JoinGroup returned error: %v", err)
+	}
+
+	groups, res, err := k.Users.ListGroups(ctx, realm, userID, nil)
+	if err != nil {
+		t.Errorf("Users.ListGroups returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if len(groups) != 1 {
+		t.Errorf("got: %d, want: %d", len(groups), 1)
+	}
+
+	count, _, err := k.Users.CountGroups(ctx, realm, userID, "")
+	if err != nil {
+		t.Errorf("Users.CountGroups returned error: %v", err)
+	}
+
+	if count != 1 {
+		t.Errorf("got: %d, want: %d", count, 1)
+	}
+}
+
+func TestUsersService_ListRealmRolesComposite(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	roleName := "role"
+
+	createRealm(t, k, realm)
+	createRealmRole(t, k, realm, roleName)
+	userID := createUser(t, k, realm, "user")
+	groupID := createGroup(t, k, realm, "group")
+
+	ctx := context.Background()
+
+	role, _, err := k.RealmRoles.GetByName(ctx, realm, roleName)
+	if err != nil {
+		t.Errorf("RealmRoles.GetByName returned error: %v", err)
+	}
+
+	// assign the role to the group only
+	if _, err := k.Groups.AddRealmRoles(ctx, realm, groupID, []*Role{role}); err != nil {
+		t.Errorf("Groups.AddRealmRoles returned error: %v", err)
+	}
+
+	if _, err := k.Users.JoinGroup(ctx, realm, userID, groupID); err != nil {
+		t.Errorf("Users.JoinGroup returned error: %v", err)
+	}
+
+	roles, res, err := k.Users.ListRealmRolesComposite(ctx, realm, userID)
+	if err != nil {
+		t.Errorf("Users.ListRealmRolesComposite returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	found := false
+	for _, r := range roles {
+		if *r.Name == roleName {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("role %q not found in effective roles", roleName)
+	}
+
+	mappings, _, err := k.Users.GetAllRoleMappings(ctx, realm, userID)
+	if err != nil {
+		t.Errorf("Users.GetAllRoleMappings returned error: %v", err)
+	}
+
+	for _, r := range mappings.RealmMappings {
+		if *r.Name == roleName {
+			t.Errorf("role %q must not be mapped directly", roleName)
+		}
+	}
+}
+
+func TestUsersService_ListAvailableRealmRoles(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	roleName := "role"
+
+	createRealm(t, k, realm)
+	createRealmRole(t, k, realm, roleName)
+	userID := createUser(t, k, realm, "user")
+
+	ctx := context.Background()
+
+	roles, res, err := k.Users.ListAvailableRealmRoles(ctx, realm, userID)
+	if err != nil {
+		t.Errorf("Users.ListAvailableRealmRoles returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	var role *Role
+	for _, r := range roles {
+		if *r.Name == roleName {
+			role = r
+		}
+	}
+	if role == nil {
+		t.Fatalf("role %q not available", roleName)
+	}
+
+	if _, err := k.Users.AddRealmRoles(ctx, realm, userID, []*Role{role}); err != nil {
+		t.Errorf("Users.AddRealmRoles returned error: %v", err)
+	}
+
+	roles, _, err = k.Users.ListAvailableRealmRoles(ctx, realm, userID)
+	if err != nil {
+		t.Errorf("Users.ListAvailableRealmRoles returned error: %v", err)
+	}
+
+	for _, r := range roles {
+		if *r.Name == roleName {
+			t.Errorf("role %q must not be available once assigned", roleName)
+		}
+	}
+}
+
+func TestUsersService_SetManagementPermissions(t *testing.T) {
+	k := client(t)
+
+	createRealm(t, k, "first")
+
+	ref, res, err := k.Users.SetManagementPermissions(context.Background(), "first", &ManagementPermissionReference{
+		Enabled: Bool(true),
+	})
+	if err != nil {
+		t.Errorf("Users.SetManagementPermissions returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if !*ref.Enabled {
+		t.Errorf("got: %t, want: %t", *ref.Enabled, true)
+	}
+
+	ref, _, err = k.Users.GetManagementPermissions(context.Background(), "first")
+	if err != nil {
+		t.Errorf("Users.GetManagementPermissions returned error: %v", err)
+	}
+
+	if _, ok := (*ref.ScopePermissions)["impersonate"]; !ok {
+		t.Errorf("got: %v, want permission for scope impersonate", *ref.ScopePermissions)
+	}
+}
+
+func TestUsersService_Count(t *testing.T) {
+	k := client(t)
+
+	realm := "first"
+	createRealm(t, k, realm)
+
+	createUser(t, k, realm, "john")
+	createUser(t, k, realm, "jane")
+
+	ctx := context.Background()
+
+	count, res, err := k.Users.Count(ctx, realm, &UserCountOptions{Username: "john"})
+	if err != nil {
+		t.Errorf("Users.Count returned error: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got: %d, want: %d", res.StatusCode, http.StatusOK)
+	}
+
+	if count != 1 {
+		t.Errorf("got: %d, want: %d", count, 1)
+	}
+}
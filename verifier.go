@@ -0,0 +1,394 @@
+package keycloak
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256" // register SHA-256 for crypto.Hash
+	_ "crypto/sha512" // register SHA-384 and SHA-512 for crypto.Hash
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultKeyRefreshInterval is the minimum time between two fetches of the
+// signing keys when TokenVerifier.KeyRefreshInterval is not set.
+const DefaultKeyRefreshInterval = time.Minute
+
+// Errors returned by TokenVerifier.Verify.
+var (
+	ErrMalformedToken   = errors.New("keycloak: malformed token")
+	ErrUnknownKey       = errors.New("keycloak: token signed with unknown key")
+	ErrInvalidSignature = errors.New("keycloak: invalid token signature")
+	ErrTokenExpired     = errors.New("keycloak: token expired")
+	ErrTokenNotYetValid = errors.New("keycloak: token not yet valid")
+	ErrInvalidIssuer    = errors.New("keycloak: invalid token issuer")
+	ErrInvalidAudience  = errors.New("keycloak: invalid token audience")
+	ErrInvalidTokenType = errors.New("keycloak: invalid token type")
+)
+
+// Claims holds the claims of a token issued by Keycloak.
+type Claims struct {
+	Iss               string             `json:"iss"`
+	Sub               string             `json:"sub"`
+	Aud               Audience           `json:"aud,omitempty"`
+	Exp               int64              `json:"exp"`
+	Iat               int64              `json:"iat"`
+	Nbf               int64              `json:"nbf,omitempty"`
+	Jti               string             `json:"jti,omitempty"`
+	Typ               string             `json:"typ,omitempty"`
+	Azp               string             `json:"azp,omitempty"`
+	SessionState      string             `json:"session_state,omitempty"`
+	Scope             string             `json:"scope,omitempty"`
+	Name              string             `json:"name,omitempty"`
+	PreferredUsername string             `json:"preferred_username,omitempty"`
+	GivenName         string             `json:"given_name,omitempty"`
+	FamilyName        string             `json:"family_name,omitempty"`
+	Email             string             `json:"email,omitempty"`
+	EmailVerified     bool               `json:"email_verified,omitempty"`
+	RealmAccess       *Access            `json:"realm_access,omitempty"`
+	ResourceAccess    map[string]*Access `json:"resource_access,omitempty"`
+
+	// Raw holds all claims including custom ones added by protocol mappers.
+	Raw map[string]interface{} `json:"-"`
+}
+
+// TokenVerifier verifies tokens issued by a realm offline, i.e. without
+// asking Keycloak about every token. The signing keys of the realm are
+// fetched on first use and fetched again when a token is signed with an
+// unknown key. A TokenVerifier is safe for concurrent use.
+type TokenVerifier struct {
+	// BaseURL of the Keycloak server, e.g. "http://localhost:8080/".
+	BaseURL string
+
+	// Realm that issues the tokens.
+	Realm string
+
+	// Audience, if set, must be contained in the aud claim or be the azp
+	// claim of the token.
+	Audience string
+
+	// Type is the expected typ claim. Defaults to "Bearer", use "ID" to
+	// verify ID tokens.
+	Type string
+
+	// Leeway allowed when checking exp and nbf to account for clock skew.
+	Leeway time.Duration
+
+	// KeyRefreshInterval is the minimum time between two fetches of the
+	// signing keys. Defaults to DefaultKeyRefreshInterval.
+	KeyRefreshInterval time.Duration
+
+	// HTTPClient is used to fetch the signing keys. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	mu        sync.Mutex
+	config    *OpenIDConfiguration
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// Issuer returns the issuer URL of the configured realm. The expected iss
+// claim and the endpoint serving the signing keys are taken from its
+// discovery document.
+func (v *TokenVerifier) Issuer() string {
+	return strings.TrimSuffix(v.BaseURL, "/") + "/realms/" + url.PathEscape(v.Realm)
+}
+
+// Verify checks signature, issuer, audience, type and lifetime of the raw
+// token and returns its claims.
+func (v *TokenVerifier) Verify(ctx context.Context, raw string) (*Claims, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+
+	key, issuer, err := v.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifySignature(header.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	var payload json.RawMessage
+	if err := decodeSegment(parts[1], &payload); err != nil {
+		return nil, err
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrMalformedToken
+	}
+	if err := json.Unmarshal(payload, &claims.Raw); err != nil {
+		return nil, ErrMalformedToken
+	}
+
+	if err := v.validate(&claims, issuer); err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
+
+func (v *TokenVerifier) validate(claims *Claims, issuer string) error {
+	if claims.Iss != issuer {
+		return ErrInvalidIssuer
+	}
+
+	typ := v.Type
+	if typ == "" {
+		typ = "Bearer"
+	}
+	if claims.Typ != typ {
+		return ErrInvalidTokenType
+	}
+
+	if v.Audience != "" && !claims.Aud.Contains(v.Audience) && claims.Azp != v.Audience {
+		return ErrInvalidAudience
+	}
+
+	now := time.Now()
+	if v.now != nil {
+		now = v.now()
+	}
+	if claims.Exp == 0 || now.Add(-v.Leeway).After(time.Unix(claims.Exp, 0)) {
+		return ErrTokenExpired
+	}
+	if claims.Nbf != 0 && now.Add(v.Leeway).Before(time.Unix(claims.Nbf, 0)) {
+		return ErrTokenNotYetValid
+	}
+
+	return nil
+}
+
+// key returns the signing key with the key id and the issuer of the realm.
+// The discovery document and the keys of the realm are fetched if the key is
+// unknown.
+func (v *TokenVerifier) key(ctx context.Context, kid string) (crypto.PublicKey, string, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if key, ok := v.keys[kid]; ok {
+		return key, v.config.Issuer, nil
+	}
+
+	interval := v.KeyRefreshInterval
+	if interval == 0 {
+		interval = DefaultKeyRefreshInterval
+	}
+	if v.keys != nil && time.Since(v.fetchedAt) < interval {
+		return nil, "", ErrUnknownKey
+	}
+
+	if v.config == nil {
+		conf, err := discover(ctx, v.HTTPClient, v.Issuer())
+		if err != nil {
+			return nil, "", err
+		}
+		v.config = conf
+	}
+
+	keys, err := v.fetchKeys(ctx, v.config.JWKSURI)
+	if err != nil {
+		return nil, "", err
+	}
+	v.keys = keys
+	v.fetchedAt = time.Now()
+
+	if key, ok := v.keys[kid]; ok {
+		return key, v.config.Issuer, nil
+	}
+	return nil, "", ErrUnknownKey
+}
+
+// jwk is a JSON web key as served by the certs endpoint.
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (v *TokenVerifier) fetchKeys(ctx context.Context, jwksURI string) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := v.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if err := CheckResponse(res); err != nil {
+		return nil, err
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			// skip key types that are not supported
+			continue
+		}
+		keys[k.Kid] = key
+	}
+
+	return keys, nil
+}
+
+func (k *jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signed string, sig []byte) error {
+	if len(alg) != 5 {
+		return ErrInvalidSignature
+	}
+
+	var hash crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return ErrInvalidSignature
+	}
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	switch alg[:2] {
+	case "RS":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok || rsa.VerifyPKCS1v15(pub, hash, digest, sig) != nil {
+			return ErrInvalidSignature
+		}
+	case "PS":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok || rsa.VerifyPSS(pub, hash, digest, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) != nil {
+			return ErrInvalidSignature
+		}
+	case "ES":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return ErrInvalidSignature
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return ErrInvalidSignature
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return ErrInvalidSignature
+		}
+	default:
+		// none and symmetric algorithms cannot be verified with public keys
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func decodeSegment(seg string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return ErrMalformedToken
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return ErrMalformedToken
+	}
+	return nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,214 @@
+package keycloak
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testSigner struct {
+	kid string
+	alg string
+	key crypto.Signer
+}
+
+func (s *testSigner) sign(t *testing.T, claims map[string]interface{}) string {
+	t.Helper()
+
+	header, _ := json.Marshal(map[string]string{"alg": s.alg, "kid": s.kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	h := crypto.SHA256.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	var sig []byte
+	var err error
+	switch key := s.key.(type) {
+	case *rsa.PrivateKey:
+		if s.alg == "PS256" {
+			sig, err = rsa.SignPSS(rand.Reader, key, crypto.SHA256, digest, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		} else {
+			sig, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest)
+		}
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, key, digest)
+		sig = make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func (s *testSigner) jwk() map[string]string {
+	enc := base64.RawURLEncoding.EncodeToString
+	switch key := s.key.(type) {
+	case *rsa.PrivateKey:
+		return map[string]string{
+			"kid": s.kid, "kty": "RSA", "use": "sig",
+			"n": enc(key.N.Bytes()),
+			"e": enc(big.NewInt(int64(key.E)).Bytes()),
+		}
+	case *ecdsa.PrivateKey:
+		return map[string]string{
+			"kid": s.kid, "kty": "EC", "use": "sig", "crv": "P-256",
+			"x": enc(key.X.Bytes()),
+			"y": enc(key.Y.Bytes()),
+		}
+	}
+	return nil
+}
+
+func TestTokenVerifier_Verify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rs256 := &testSigner{kid: "rsa", alg: "RS256", key: rsaKey}
+	ps256 := &testSigner{kid: "rsa", alg: "PS256", key: rsaKey}
+	es256 := &testSigner{kid: "ec", alg: "ES256", key: ecKey}
+	unknown := &testSigner{kid: "unknown", alg: "RS256", key: rsaKey}
+
+	fetches := 0
+	server := httptest.NewServer(withDiscovery(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/realms/first/protocol/openid-connect/certs" {
+			t.Errorf("got: %s, want: %s", r.URL.Path, "/realms/first/protocol/openid-connect/certs")
+		}
+		fetches++
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{rs256.jwk(), es256.jwk()},
+		})
+	}))
+	defer server.Close()
+
+	now := time.Unix(1600000000, 0)
+	verifier := &TokenVerifier{
+		BaseURL:  server.URL + "/",
+		Realm:    "first",
+		Audience: "api",
+		now:      func() time.Time { return now },
+	}
+
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss": server.URL + "/realms/first",
+			"sub": "123",
+			"aud": []string{"api", "account"},
+			"typ": "Bearer",
+			"exp": now.Add(time.Minute).Unix(),
+			"iat": now.Unix(),
+		}
+	}
+	with := func(key string, value interface{}) map[string]interface{} {
+		claims := valid()
+		claims[key] = value
+		return claims
+	}
+
+	tests := []struct {
+		name   string
+		signer *testSigner
+		claims map[string]interface{}
+		err    error
+	}{
+		{"rs256", rs256, valid(), nil},
+		{"ps256", ps256, valid(), nil},
+		{"es256", es256, valid(), nil},
+		{"single audience", rs256, with("aud", "api"), nil},
+		{"authorized party", rs256, with("azp", "api"), nil},
+		{"expired", rs256, with("exp", now.Add(-time.Minute).Unix()), ErrTokenExpired},
+		{"not yet valid", rs256, with("nbf", now.Add(time.Minute).Unix()), ErrTokenNotYetValid},
+		{"issuer", rs256, with("iss", "http://evil/realms/first"), ErrInvalidIssuer},
+		{"audience", rs256, with("aud", "other"), ErrInvalidAudience},
+		{"type", rs256, with("typ", "Refresh"), ErrInvalidTokenType},
+		{"unknown key", unknown, valid(), ErrUnknownKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := verifier.Verify(context.Background(), tt.signer.sign(t, tt.claims))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("got: %v, want: %v", err, tt.err)
+			}
+			if err == nil && claims.Sub != "123" {
+				t.Errorf("got: %s, want: %s", claims.Sub, "123")
+			}
+		})
+	}
+
+	// the unknown key must not cause a fetch within the refresh interval
+	if fetches != 1 {
+		t.Errorf("got: %d fetches, want: %d", fetches, 1)
+	}
+}
+
+func TestTokenVerifier_Verify_Tampered(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := &testSigner{kid: "rsa", alg: "RS256", key: rsaKey}
+
+	server := httptest.NewServer(withDiscovery(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{signer.jwk()},
+		})
+	}))
+	defer server.Close()
+
+	verifier := &TokenVerifier{BaseURL: server.URL, Realm: "first"}
+
+	token := signer.sign(t, map[string]interface{}{
+		"iss": server.URL + "/realms/first",
+		"typ": "Bearer",
+		"exp": time.Now().Add(time.Minute).Unix(),
+	})
+
+	// replace the payload with one granting more
+	payload, _ := json.Marshal(map[string]interface{}{
+		"iss":          server.URL + "/realms/first",
+		"typ":          "Bearer",
+		"exp":          time.Now().Add(time.Hour).Unix(),
+		"realm_access": map[string][]string{"roles": {"admin"}},
+	})
+	parts := strings.Split(token, ".")
+	tampered := parts[0] + "." + base64.RawURLEncoding.EncodeToString(payload) + "." + parts[2]
+
+	if _, err := verifier.Verify(context.Background(), tampered); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("got: %v, want: %v", err, ErrInvalidSignature)
+	}
+
+	none := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","kid":"rsa"}`)) + "." + base64.RawURLEncoding.EncodeToString(payload) + "."
+	if _, err := verifier.Verify(context.Background(), none); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("got: %v, want: %v", err, ErrInvalidSignature)
+	}
+
+	if _, err := verifier.Verify(context.Background(), "not a token"); !errors.Is(err, ErrMalformedToken) {
+		t.Errorf("got: %v, want: %v", err, ErrMalformedToken)
+	}
+}
@@ -0,0 +1,115 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is the version of a Keycloak server.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+
+	// Qualifier is the part after the first dash, e.g. "SNAPSHOT" in
+	// "999.0.0-SNAPSHOT".
+	Qualifier string
+}
+
+// ParseVersion parses versions like "9.0.4", "24.0.1" or "26.0.0-SNAPSHOT".
+// Missing minor and patch versions are zero.
+func ParseVersion(s string) (Version, error) {
+	var v Version
+
+	s = strings.TrimSpace(s)
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		v.Qualifier = s[i+1:]
+		s = s[:i]
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 4 {
+		return Version{}, fmt.Errorf("keycloak: invalid version %q", s)
+	}
+	numbers := []*int{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range parts {
+		// ignore the suffix of versions like "12.0.4.Final"
+		if i == len(numbers) {
+			break
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("keycloak: invalid version %q", s)
+		}
+		*numbers[i] = n
+	}
+
+	return v, nil
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is lower, equal or
+// higher than o. The qualifier is ignored.
+func (v Version) Compare(o Version) int {
+	for _, d := range []int{v.Major - o.Major, v.Minor - o.Minor, v.Patch - o.Patch} {
+		if d < 0 {
+			return -1
+		}
+		if d > 0 {
+			return 1
+		}
+	}
+	return 0
+}
+
+// AtLeast reports whether v is equal to or higher than major.minor.patch.
+func (v Version) AtLeast(major, minor, patch int) bool {
+	return v.Compare(Version{Major: major, Minor: minor, Patch: patch}) >= 0
+}
+
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Qualifier != "" {
+		s += "-" + v.Qualifier
+	}
+	return s
+}
+
+// Features whose availability depends on the server version, see
+// Keycloak.SupportsFeature.
+const (
+	// FeatureUserAttributeQuery is the q=name:value parameter to search
+	// users by attribute.
+	FeatureUserAttributeQuery = "user-attribute-query"
+
+	// FeatureUserProfile is the declarative user profile, which is always
+	// enabled from Keycloak 24 on.
+	FeatureUserProfile = "user-profile"
+
+	// FeatureOrganizations is the organizations API.
+	FeatureOrganizations = "organizations"
+)
+
+// featureVersions maps features to the first server version supporting them.
+var featureVersions = map[string]Version{
+	FeatureUserAttributeQuery: {Major: 20},
+	FeatureUserProfile:        {Major: 24},
+	FeatureOrganizations:      {Major: 25},
+}
+
+// SupportsFeature reports whether the server version supports the feature.
+// The version is looked up with ServerInfoService.Version and cached.
+func (k *Keycloak) SupportsFeature(ctx context.Context, feature string) (bool, error) {
+	min, ok := featureVersions[feature]
+	if !ok {
+		return false, fmt.Errorf("keycloak: unknown feature %q", feature)
+	}
+
+	v, err := k.ServerInfo.Version(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return v.Compare(min) >= 0, nil
+}
@@ -0,0 +1,95 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Version
+	}{
+		{"9.0.4", Version{Major: 9, Minor: 0, Patch: 4}},
+		{"19.0.3", Version{Major: 19, Minor: 0, Patch: 3}},
+		{"24.0.1", Version{Major: 24, Minor: 0, Patch: 1}},
+		{"12.0.4.Final", Version{Major: 12, Minor: 0, Patch: 4}},
+		{"999.0.0-SNAPSHOT", Version{Major: 999, Qualifier: "SNAPSHOT"}},
+		{"25", Version{Major: 25}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseVersion(tt.in)
+		if err != nil {
+			t.Errorf("ParseVersion(%q) returned error: %v", tt.in, err)
+		}
+		if got != tt.want {
+			t.Errorf("got: %+v, want: %+v", got, tt.want)
+		}
+	}
+
+	if _, err := ParseVersion("latest"); err == nil {
+		t.Error("expected error for invalid version")
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	v9, _ := ParseVersion("9.0.4")
+	v19, _ := ParseVersion("19.0.0")
+	v20, _ := ParseVersion("20.0.0")
+
+	// a string comparison gets these wrong
+	if v9.Compare(v19) != -1 {
+		t.Errorf("got: %d, want: %d", v9.Compare(v19), -1)
+	}
+	if v20.Compare(v19) != 1 {
+		t.Errorf("got: %d, want: %d", v20.Compare(v19), 1)
+	}
+	if v20.Compare(v20) != 0 {
+		t.Errorf("got: %d, want: %d", v20.Compare(v20), 0)
+	}
+	if !v20.AtLeast(20, 0, 0) || v19.AtLeast(20, 0, 0) {
+		t.Error("AtLeast returned wrong result")
+	}
+}
+
+func TestKeycloak_SupportsFeature(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, serverInfoJSON)
+	}))
+	defer server.Close()
+
+	k, err := NewKeycloak(nil, server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	tests := []struct {
+		feature string
+		want    bool
+	}{
+		{FeatureUserAttributeQuery, true},
+		{FeatureUserProfile, true},
+		{FeatureOrganizations, false},
+	}
+
+	for _, tt := range tests {
+		got, err := k.SupportsFeature(ctx, tt.feature)
+		if err != nil {
+			t.Errorf("SupportsFeature(%q) returned error: %v", tt.feature, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: got: %t, want: %t", tt.feature, got, tt.want)
+		}
+	}
+
+	if _, err := k.SupportsFeature(ctx, "unknown"); err == nil {
+		t.Error("expected error for unknown feature")
+	}
+}
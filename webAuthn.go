@@ -0,0 +1,187 @@
+package keycloak
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+)
+
+// WebAuthnNotSpecified leaves a WebAuthn policy setting to the
+// authenticator. It is the Keycloak default of the string settings.
+const WebAuthnNotSpecified = "not specified"
+
+// Attestation conveyance preferences of WebAuthn policies.
+const (
+	WebAuthnAttestationNone     = "none"
+	WebAuthnAttestationIndirect = "indirect"
+	WebAuthnAttestationDirect   = "direct"
+)
+
+// Authenticator attachments of WebAuthn policies.
+const (
+	WebAuthnAttachmentPlatform      = "platform"
+	WebAuthnAttachmentCrossPlatform = "cross-platform"
+)
+
+// Resident key requirements of WebAuthn policies. Passkeys require
+// WebAuthnResidentKeyYes.
+const (
+	WebAuthnResidentKeyYes = "Yes"
+	WebAuthnResidentKeyNo  = "No"
+)
+
+// User verification requirements of WebAuthn policies.
+const (
+	WebAuthnUserVerificationRequired    = "required"
+	WebAuthnUserVerificationPreferred   = "preferred"
+	WebAuthnUserVerificationDiscouraged = "discouraged"
+)
+
+// webAuthnSignatureAlgorithms are the algorithms Keycloak accepts for
+// WebAuthn credentials.
+var webAuthnSignatureAlgorithms = []string{"ES256", "ES384", "ES512", "RS256", "RS384", "RS512", "RS1", "EdDSA"}
+
+// webAuthnMaxCreateTimeout is the maximum registration timeout in seconds
+// accepted by Keycloak.
+const webAuthnMaxCreateTimeout = 31536
+
+var aaguidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// WebAuthnPolicy is the WebAuthn or WebAuthn passwordless policy of a realm,
+// which Keycloak stores in the WebAuthnPolicy and WebAuthnPolicyPasswordless
+// fields of the realm. Unset fields are left unchanged by
+// Realm.SetWebAuthnPolicy and Realm.SetWebAuthnPasswordlessPolicy.
+type WebAuthnPolicy struct {
+	// RpEntityName is the name of the relying party shown by authenticators.
+	RpEntityName *string
+
+	// SignatureAlgorithms are e.g. ES256 and RS256.
+	SignatureAlgorithms []string
+
+	// RpID is the domain of the relying party, the host of the Keycloak
+	// frontend URL by default.
+	RpID *string
+
+	// AttestationConveyancePreference is e.g. WebAuthnAttestationDirect.
+	AttestationConveyancePreference *string
+
+	// AuthenticatorAttachment is e.g. WebAuthnAttachmentPlatform.
+	AuthenticatorAttachment *string
+
+	// RequireResidentKey is WebAuthnResidentKeyYes, WebAuthnResidentKeyNo or
+	// WebAuthnNotSpecified.
+	RequireResidentKey *string
+
+	// UserVerificationRequirement is e.g. WebAuthnUserVerificationRequired.
+	UserVerificationRequirement *string
+
+	// CreateTimeout is the registration timeout in seconds, 0 for no timeout.
+	CreateTimeout *int
+
+	AvoidSameAuthenticatorRegister *bool
+
+	// AcceptableAaguids restricts registration to the authenticator models
+	// with these AAGUIDs.
+	AcceptableAaguids []string
+
+	// ExtraOrigins are origins besides the Keycloak one, e.g. of Android
+	// apps.
+	ExtraOrigins []string
+}
+
+// Validate checks the policy against the values Keycloak accepts. Unset
+// fields are not checked.
+func (p *WebAuthnPolicy) Validate() error {
+	fields := map[string][]string{}
+	if p.AttestationConveyancePreference != nil {
+		fields["attestation conveyance preference"] = []string{*p.AttestationConveyancePreference,
+			WebAuthnNotSpecified, WebAuthnAttestationNone, WebAuthnAttestationIndirect, WebAuthnAttestationDirect}
+	}
+	if p.AuthenticatorAttachment != nil {
+		fields["authenticator attachment"] = []string{*p.AuthenticatorAttachment,
+			WebAuthnNotSpecified, WebAuthnAttachmentPlatform, WebAuthnAttachmentCrossPlatform}
+	}
+	if p.RequireResidentKey != nil {
+		fields["resident key requirement"] = []string{*p.RequireResidentKey,
+			WebAuthnNotSpecified, WebAuthnResidentKeyYes, WebAuthnResidentKeyNo}
+	}
+	if p.UserVerificationRequirement != nil {
+		fields["user verification requirement"] = []string{*p.UserVerificationRequirement,
+			WebAuthnNotSpecified, WebAuthnUserVerificationRequired, WebAuthnUserVerificationPreferred, WebAuthnUserVerificationDiscouraged}
+	}
+	if err := oneOf("webauthn policy", fields); err != nil {
+		return err
+	}
+	for _, algorithm := range p.SignatureAlgorithms {
+		err := oneOf("webauthn policy", map[string][]string{
+			"signature algorithm": append([]string{algorithm}, webAuthnSignatureAlgorithms...),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if p.CreateTimeout != nil && (*p.CreateTimeout < 0 || *p.CreateTimeout > webAuthnMaxCreateTimeout) {
+		return fmt.Errorf("keycloak: webauthn policy: create timeout %d out of range 0 to %d", *p.CreateTimeout, webAuthnMaxCreateTimeout)
+	}
+	for _, aaguid := range p.AcceptableAaguids {
+		if !aaguidPattern.MatchString(aaguid) {
+			return fmt.Errorf("keycloak: webauthn policy: invalid AAGUID %q", aaguid)
+		}
+	}
+	for _, origin := range p.ExtraOrigins {
+		u, err := url.Parse(origin)
+		if err != nil || u.Scheme == "" || u.Host == "" || u.Path != "" || u.RawQuery != "" || u.Fragment != "" {
+			return fmt.Errorf("keycloak: webauthn policy: invalid origin %q", origin)
+		}
+	}
+	return nil
+}
+
+// WebAuthnPolicy returns the WebAuthn policy of the realm, used for two
+// factor authentication.
+func (r *Realm) WebAuthnPolicy() *WebAuthnPolicy {
+	return r.webAuthnPolicy("WebAuthnPolicy")
+}
+
+// WebAuthnPasswordlessPolicy returns the WebAuthn passwordless policy of the
+// realm, used for passkeys.
+func (r *Realm) WebAuthnPasswordlessPolicy() *WebAuthnPolicy {
+	return r.webAuthnPolicy("WebAuthnPolicyPasswordless")
+}
+
+// SetWebAuthnPolicy stores the set fields of p in the WebAuthn policy of the
+// realm.
+func (r *Realm) SetWebAuthnPolicy(p *WebAuthnPolicy) {
+	r.setWebAuthnPolicy("WebAuthnPolicy", p)
+}
+
+// SetWebAuthnPasswordlessPolicy stores the set fields of p in the WebAuthn
+// passwordless policy of the realm.
+func (r *Realm) SetWebAuthnPasswordlessPolicy(p *WebAuthnPolicy) {
+	r.setWebAuthnPolicy("WebAuthnPolicyPasswordless", p)
+}
+
+// webAuthnPolicy copies the realm fields named prefix plus the name of a
+// policy field.
+func (r *Realm) webAuthnPolicy(prefix string) *WebAuthnPolicy {
+	p := &WebAuthnPolicy{}
+	realm := reflect.ValueOf(r).Elem()
+	v := reflect.ValueOf(p).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		v.Field(i).Set(realm.FieldByName(prefix + v.Type().Field(i).Name))
+	}
+	return p
+}
+
+func (r *Realm) setWebAuthnPolicy(prefix string, p *WebAuthnPolicy) {
+	realm := reflect.ValueOf(r).Elem()
+	v := reflect.ValueOf(p).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).IsNil() {
+			continue
+		}
+		realm.FieldByName(prefix + v.Type().Field(i).Name).Set(v.Field(i))
+	}
+}
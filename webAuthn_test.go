@@ -0,0 +1,75 @@
+package keycloak
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRealm_WebAuthnPolicy(t *testing.T) {
+	realm := &Realm{
+		WebAuthnPolicyRpEntityName:             String("keycloak"),
+		WebAuthnPolicyPasswordlessRpEntityName: String("passkeys"),
+	}
+
+	realm.SetWebAuthnPasswordlessPolicy(&WebAuthnPolicy{
+		SignatureAlgorithms:     []string{"ES256", "RS256"},
+		RequireResidentKey:      String(WebAuthnResidentKeyYes),
+		AuthenticatorAttachment: String(WebAuthnAttachmentPlatform),
+		CreateTimeout:           Int(60),
+		ExtraOrigins:            []string{"android:apk-key-hash:abc"},
+	})
+
+	got := realm.WebAuthnPasswordlessPolicy()
+	want := &WebAuthnPolicy{
+		RpEntityName:            String("passkeys"),
+		SignatureAlgorithms:     []string{"ES256", "RS256"},
+		RequireResidentKey:      String(WebAuthnResidentKeyYes),
+		AuthenticatorAttachment: String(WebAuthnAttachmentPlatform),
+		CreateTimeout:           Int(60),
+		ExtraOrigins:            []string{"android:apk-key-hash:abc"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %+v, want: %+v", got, want)
+	}
+
+	// the two factor policy is untouched
+	if got := realm.WebAuthnPolicy(); !reflect.DeepEqual(got, &WebAuthnPolicy{RpEntityName: String("keycloak")}) {
+		t.Errorf("got: %+v", got)
+	}
+}
+
+func TestWebAuthnPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		policy *WebAuthnPolicy
+		err    string
+	}{
+		{&WebAuthnPolicy{}, ""},
+		{&WebAuthnPolicy{
+			SignatureAlgorithms:             []string{"ES256", "EdDSA"},
+			AttestationConveyancePreference: String(WebAuthnNotSpecified),
+			UserVerificationRequirement:     String(WebAuthnUserVerificationRequired),
+			CreateTimeout:                   Int(0),
+			AcceptableAaguids:               []string{"08987058-cadc-4b81-b6e1-30de50dcbe96"},
+			ExtraOrigins:                    []string{"https://app.example.com"},
+		}, ""},
+		{&WebAuthnPolicy{RequireResidentKey: String("yes")}, `invalid resident key requirement "yes"`},
+		{&WebAuthnPolicy{SignatureAlgorithms: []string{"HS256"}}, `invalid signature algorithm "HS256"`},
+		{&WebAuthnPolicy{CreateTimeout: Int(-1)}, "create timeout -1 out of range"},
+		{&WebAuthnPolicy{AcceptableAaguids: []string{"yubikey"}}, `invalid AAGUID "yubikey"`},
+		{&WebAuthnPolicy{ExtraOrigins: []string{"https://app.example.com/login"}}, "invalid origin"},
+	}
+
+	for _, tt := range tests {
+		err := tt.policy.Validate()
+		if tt.err == "" {
+			if err != nil {
+				t.Errorf("got: %v, want: nil", err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("got: %v, want: %s", err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+// Headers of webhook requests.
+const (
+	// HeaderDelivery identifies the event. It is the same for every attempt
+	// and restart, so receivers can deduplicate deliveries.
+	HeaderDelivery = "X-Keycloak-Delivery"
+	// HeaderSignature is the hex encoded HMAC-SHA256 of the body with the
+	// secret of the target, prefixed with "sha256=".
+	HeaderSignature = "X-Keycloak-Signature"
+)
+
+// Kinds of a Payload.
+const (
+	KindEvent      = "event"
+	KindAdminEvent = "admin_event"
+)
+
+// Payload is the JSON body posted by a Target.
+type Payload struct {
+	Kind       string               `json:"kind"`
+	Event      *keycloak.Event      `json:"event,omitempty"`
+	AdminEvent *keycloak.AdminEvent `json:"adminEvent,omitempty"`
+}
+
+// Target is a Handler posting events as Payload to an HTTP endpoint. Any
+// response outside the 200 range fails the delivery.
+type Target struct {
+	URL string
+
+	// Secret signs the requests, see HeaderSignature.
+	Secret string
+
+	// Header is added to every request, e.g. for authorization.
+	Header http.Header
+
+	// Client sends the requests. Defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// Handle posts e to the target.
+func (t *Target) Handle(ctx context.Context, e *keycloak.WatchEvent) error {
+	payload := &Payload{Kind: KindEvent, Event: e.Event}
+	id := delivery(e.Event.GetID(), e.Event)
+	if e.AdminEvent != nil {
+		payload = &Payload{Kind: KindAdminEvent, AdminEvent: e.AdminEvent}
+		id = delivery(e.AdminEvent.GetID(), e.AdminEvent)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for name, values := range t.Header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderDelivery, id)
+	if t.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(t.Secret, body))
+	}
+
+	client := t.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook: POST %s: %s", t.URL, res.Status)
+	}
+	return nil
+}
+
+// delivery returns the delivery id of event v. Keycloak versions without
+// event ids get a hash of the event.
+func delivery(id string, v interface{}) string {
+	if id != "" {
+		return id
+	}
+	b, _ := json.Marshal(v)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:16])
+}
+
+// Sign returns the value of HeaderSignature for body, e.g. to verify
+// requests in a receiver with hmac.Equal.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
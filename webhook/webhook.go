@@ -0,0 +1,229 @@
+// Package webhook dispatches Keycloak events to Go handlers and HTTP
+// webhooks, e.g. to provision accounts in other systems when users are
+// created in Keycloak.
+//
+//	d := &webhook.Dispatcher{}
+//	d.Handle(&webhook.Target{URL: "https://example.com/hooks/keycloak", Secret: secret},
+//		webhook.ResourceTypes("USER"))
+//	d.HandleFunc(func(ctx context.Context, e *keycloak.WatchEvent) error {
+//		log.Println("login", e.Event.GetUserID())
+//		return nil
+//	}, webhook.EventTypes(keycloak.EventTypeLogin))
+//
+//	err := d.Watch(ctx, k, "myrealm", nil)
+//
+// Delivery is at least once: failed handlers are retried until they
+// succeed, and after a restart events since the last checkpoint are
+// delivered again. Receivers should deduplicate by the delivery id.
+package webhook
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+// Handler handles an event. Returning an error makes the dispatcher retry
+// the event later.
+type Handler interface {
+	Handle(ctx context.Context, e *keycloak.WatchEvent) error
+}
+
+// HandlerFunc adapts a function to a Handler.
+type HandlerFunc func(ctx context.Context, e *keycloak.WatchEvent) error
+
+// Handle calls f(ctx, e).
+func (f HandlerFunc) Handle(ctx context.Context, e *keycloak.WatchEvent) error {
+	return f(ctx, e)
+}
+
+// Match reports whether a handler is interested in an event.
+type Match func(e *keycloak.WatchEvent) bool
+
+// EventTypes matches login events of the given types, e.g.
+// keycloak.EventTypeLogin, or all login events if none are given.
+func EventTypes(types ...string) Match {
+	return func(e *keycloak.WatchEvent) bool {
+		return e.Event != nil && (len(types) == 0 || contains(types, e.Event.GetType()))
+	}
+}
+
+// ResourceTypes matches admin events on the given resource types, e.g.
+// "USER" or "REALM_ROLE_MAPPING", or all admin events if none are given.
+func ResourceTypes(types ...string) Match {
+	return func(e *keycloak.WatchEvent) bool {
+		return e.AdminEvent != nil && (len(types) == 0 || contains(types, e.AdminEvent.GetResourceType()))
+	}
+}
+
+// OperationTypes matches admin events of the given operations, e.g.
+// keycloak.OperationTypeCreate, or all admin events if none are given.
+func OperationTypes(types ...string) Match {
+	return func(e *keycloak.WatchEvent) bool {
+		return e.AdminEvent != nil && (len(types) == 0 || contains(types, e.AdminEvent.GetOperationType()))
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+type route struct {
+	handler Handler
+	match   []Match
+}
+
+func (r route) matches(e *keycloak.WatchEvent) bool {
+	for _, m := range r.match {
+		if !m(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// Dispatcher fans out events to the registered handlers. Events are
+// dispatched one after another in the order received; the handlers of an
+// event run concurrently. The zero value is ready to use, handlers must be
+// registered before Run is called.
+type Dispatcher struct {
+	// MaxAttempts is the number of times a handler is called for an event
+	// before it is skipped. Defaults to 0, which retries until the handler
+	// succeeds or the context is done.
+	MaxAttempts int
+
+	// Backoff returns how long to wait before the given retry of a handler.
+	// Defaults to keycloak.ExponentialBackoff(time.Second, time.Minute).
+	Backoff func(retry int) time.Duration
+
+	// OnError is called for every failed attempt of a handler and for
+	// failed polls of Watch.
+	OnError func(e *keycloak.WatchEvent, err error)
+
+	// MaxPollErrors is the number of failed polls in a row, without an
+	// event received in between, after which Run gives up and returns the
+	// last poll error. Defaults to 0, which keeps polling until ctx is
+	// done.
+	MaxPollErrors int
+
+	// Checkpoint is called with the time of every event once all its
+	// handlers are done. Persist it and pass it as WatchOptions.Since to
+	// resume after a restart.
+	Checkpoint func(t time.Time)
+
+	routes []route
+}
+
+// Handle registers h for the events matching all of match, or for all
+// events if match is empty.
+func (d *Dispatcher) Handle(h Handler, match ...Match) {
+	d.routes = append(d.routes, route{handler: h, match: match})
+}
+
+// HandleFunc registers f like Handle.
+func (d *Dispatcher) HandleFunc(f func(ctx context.Context, e *keycloak.WatchEvent) error, match ...Match) {
+	d.Handle(HandlerFunc(f), match...)
+}
+
+// Watch watches the events of realm with opts and dispatches them until
+// ctx is done.
+func (d *Dispatcher) Watch(ctx context.Context, k *keycloak.Keycloak, realm string, opts *keycloak.WatchOptions) error {
+	return d.Run(ctx, k.Events.Watch(ctx, realm, opts))
+}
+
+// Run dispatches the events received from events until the channel is
+// closed or ctx is done, in which case it returns the context's error.
+// Failed polls, i.e. events with Err set, are passed to OnError and
+// otherwise skipped, as Watch retries them with backoff, until
+// MaxPollErrors of them arrive in a row.
+func (d *Dispatcher) Run(ctx context.Context, events <-chan *keycloak.WatchEvent) error {
+	pollErrors := 0
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if e.Err != nil {
+				d.onError(e, e.Err)
+				pollErrors++
+				if d.MaxPollErrors > 0 && pollErrors >= d.MaxPollErrors {
+					return e.Err
+				}
+				continue
+			}
+			pollErrors = 0
+			if err := d.Dispatch(ctx, e); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Dispatch calls the handlers matching e and waits until all of them
+// succeeded or ran out of attempts. It only returns an error if ctx is done
+// before, in which case the checkpoint isn't advanced.
+func (d *Dispatcher) Dispatch(ctx context.Context, e *keycloak.WatchEvent) error {
+	var wg sync.WaitGroup
+	for _, r := range d.routes {
+		if !r.matches(e) {
+			continue
+		}
+		wg.Add(1)
+		go func(h Handler) {
+			defer wg.Done()
+			d.deliver(ctx, h, e)
+		}(r.handler)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if d.Checkpoint != nil {
+		d.Checkpoint(e.Time())
+	}
+	return nil
+}
+
+// deliver calls h until it succeeds, runs out of attempts or ctx is done.
+func (d *Dispatcher) deliver(ctx context.Context, h Handler, e *keycloak.WatchEvent) {
+	backoff := d.Backoff
+	if backoff == nil {
+		backoff = keycloak.ExponentialBackoff(time.Second, time.Minute)
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := h.Handle(ctx, e)
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+		d.onError(e, err)
+		if d.MaxAttempts > 0 && attempt >= d.MaxAttempts {
+			return
+		}
+
+		timer := time.NewTimer(backoff(attempt))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) onError(e *keycloak.WatchEvent, err error) {
+	if d.OnError != nil {
+		d.OnError(e, err)
+	}
+}
@@ -0,0 +1,205 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zemirco/keycloak/v2"
+)
+
+func TestDispatcher(t *testing.T) {
+	var (
+		mu          sync.Mutex
+		logins      []string
+		users       int
+		errs        int
+		checkpoints []time.Time
+	)
+
+	d := &Dispatcher{
+		Backoff: func(int) time.Duration { return time.Millisecond },
+		OnError: func(e *keycloak.WatchEvent, err error) {
+			mu.Lock()
+			errs++
+			mu.Unlock()
+		},
+		Checkpoint: func(t time.Time) {
+			checkpoints = append(checkpoints, t)
+		},
+	}
+	d.HandleFunc(func(ctx context.Context, e *keycloak.WatchEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		logins = append(logins, e.Event.GetID())
+		return nil
+	}, EventTypes(keycloak.EventTypeLogin))
+
+	failed := false
+	d.HandleFunc(func(ctx context.Context, e *keycloak.WatchEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if !failed {
+			failed = true
+			return errors.New("unavailable")
+		}
+		users++
+		return nil
+	}, ResourceTypes("USER"), OperationTypes(keycloak.OperationTypeCreate))
+
+	events := make(chan *keycloak.WatchEvent, 5)
+	events <- &keycloak.WatchEvent{Event: &keycloak.Event{ID: keycloak.String("1"), Type: keycloak.String(keycloak.EventTypeLogin), Time: keycloak.Int64(1000)}}
+	events <- &keycloak.WatchEvent{Event: &keycloak.Event{ID: keycloak.String("2"), Type: keycloak.String(keycloak.EventTypeLogout), Time: keycloak.Int64(2000)}}
+	events <- &keycloak.WatchEvent{Err: errors.New("poll failed")}
+	events <- &keycloak.WatchEvent{AdminEvent: &keycloak.AdminEvent{ResourceType: keycloak.String("USER"), OperationType: keycloak.String(keycloak.OperationTypeCreate), Time: keycloak.Int64(3000)}}
+	events <- &keycloak.WatchEvent{AdminEvent: &keycloak.AdminEvent{ResourceType: keycloak.String("USER"), OperationType: keycloak.String(keycloak.OperationTypeDelete), Time: keycloak.Int64(4000)}}
+	close(events)
+
+	if err := d.Run(context.Background(), events); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(logins) != 1 || logins[0] != "1" {
+		t.Errorf("got: %v, want: [1]", logins)
+	}
+	if users != 1 {
+		t.Errorf("got: %d, want: %d", users, 1)
+	}
+	if errs != 2 {
+		t.Errorf("got: %d, want: %d", errs, 2)
+	}
+	if len(checkpoints) != 4 || checkpoints[3].UnixNano() != int64(4*time.Second) {
+		t.Errorf("got: %v", checkpoints)
+	}
+}
+
+func TestDispatcher_Canceled(t *testing.T) {
+	d := &Dispatcher{
+		Backoff:    func(int) time.Duration { return time.Millisecond },
+		Checkpoint: func(time.Time) { t.Error("unexpected checkpoint") },
+	}
+	d.HandleFunc(func(ctx context.Context, e *keycloak.WatchEvent) error {
+		return errors.New("down")
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := d.Dispatch(ctx, &keycloak.WatchEvent{Event: &keycloak.Event{}})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got: %v, want: %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestDispatcher_MaxPollErrors(t *testing.T) {
+	errs := 0
+	d := &Dispatcher{
+		MaxPollErrors: 2,
+		OnError:       func(e *keycloak.WatchEvent, err error) { errs++ },
+	}
+
+	down := errors.New("down")
+	events := make(chan *keycloak.WatchEvent, 5)
+	events <- &keycloak.WatchEvent{Err: errors.New("poll failed")}
+	events <- &keycloak.WatchEvent{Event: &keycloak.Event{Time: keycloak.Int64(1000)}}
+	events <- &keycloak.WatchEvent{Err: errors.New("poll failed")}
+	events <- &keycloak.WatchEvent{Err: down}
+	events <- &keycloak.WatchEvent{Event: &keycloak.Event{Time: keycloak.Int64(2000)}}
+
+	if err := d.Run(context.Background(), events); err != down {
+		t.Errorf("got: %v, want: %v", err, down)
+	}
+	if errs != 3 {
+		t.Errorf("got: %d, want: %d", errs, 3)
+	}
+	if len(events) != 1 {
+		t.Errorf("got: %d, want: %d", len(events), 1)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	login := &keycloak.WatchEvent{Event: &keycloak.Event{Type: keycloak.String(keycloak.EventTypeLogin)}}
+	create := &keycloak.WatchEvent{AdminEvent: &keycloak.AdminEvent{
+		ResourceType:  keycloak.String("USER"),
+		OperationType: keycloak.String(keycloak.OperationTypeCreate),
+	}}
+
+	tests := []struct {
+		name  string
+		match Match
+		event *keycloak.WatchEvent
+		want  bool
+	}{
+		{"all event types", EventTypes(), login, true},
+		{"event type", EventTypes(keycloak.EventTypeLogin), login, true},
+		{"event type of admin event", EventTypes(), create, false},
+		{"all resource types", ResourceTypes(), create, true},
+		{"other resource type", ResourceTypes("GROUP"), create, false},
+		{"all operation types", OperationTypes(), create, true},
+		{"operation type", OperationTypes(keycloak.OperationTypeCreate), create, true},
+		{"other operation type", OperationTypes(keycloak.OperationTypeDelete), create, false},
+		{"operation type of login event", OperationTypes(), login, false},
+	}
+	for _, tt := range tests {
+		if got := tt.match(tt.event); got != tt.want {
+			t.Errorf("%s: got: %t, want: %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTarget(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		if got, want := r.Header.Get(HeaderSignature), Sign("secret", body); got != want {
+			t.Errorf("got: %s, want: %s", got, want)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("got: %s, want: %s", got, "Bearer token")
+		}
+		if got := r.Header.Get(HeaderDelivery); got != "event-id" {
+			t.Errorf("got: %s, want: %s", got, "event-id")
+		}
+
+		var payload Payload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload.Kind != KindAdminEvent || payload.AdminEvent.GetResourcePath() != "users/1" {
+			t.Errorf("got: %+v", payload)
+		}
+
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	target := &Target{
+		URL:    server.URL,
+		Secret: "secret",
+		Header: http.Header{"Authorization": {"Bearer token"}},
+	}
+	e := &keycloak.WatchEvent{AdminEvent: &keycloak.AdminEvent{ID: keycloak.String("event-id"), ResourcePath: keycloak.String("users/1")}}
+
+	if err := target.Handle(context.Background(), e); err == nil {
+		t.Error("expected error for 502 response")
+	}
+	if err := target.Handle(context.Background(), e); err != nil {
+		t.Error(err)
+	}
+
+	// events without id get a stable delivery id
+	a := delivery("", &keycloak.Event{Time: keycloak.Int64(1)})
+	b := delivery("", &keycloak.Event{Time: keycloak.Int64(1)})
+	if a == "" || a != b {
+		t.Errorf("got: %q and %q", a, b)
+	}
+}